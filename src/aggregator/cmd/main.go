@@ -58,7 +58,8 @@ func main() {
 	}
 
 	uc := v1.NewAggregatorUseCase(userSvc, authSvc, todoSvc, logger)
-	handler := h.NewAggregatorHandler(uc)
+	heartbeat := time.Duration(config.Aggregator.HeartbeatSec) * time.Second
+	handler := h.NewAggregatorHandler(uc, heartbeat)
 
 	router := mux.NewRouter()
 	loggingMiddleware := middleware.NewLoggingMiddleware(logger)
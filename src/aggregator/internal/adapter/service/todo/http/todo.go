@@ -3,42 +3,127 @@ package http
 import (
 	"aggregator/internal/common/logger"
 	"aggregator/internal/dto"
+	"aggregator/internal/middleware"
 	"aggregator/internal/service/todo"
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const layout string = "02-01-2006"
 
+const (
+	userIDHeader = "X-User-ID"
+	roleHeader   = "X-User-Role"
+)
+
 var (
 	ErrDecodeResponse func(error) error = func(err error) error {
 		return fmt.Errorf("Failed to decode response: %w", err)
 	}
-	ErrGetNewCards  error = errors.New("failed to get new cards")
-	ErrGetBoards    error = errors.New("failed to get boards")
-	ErrGetColumns   error = errors.New("failed to get columns")
-	ErrGetCards     error = errors.New("failed to get cards")
-	ErrGetCard      error = errors.New("failed to get card")
-	ErrCreateBoard  error = errors.New("failed to create board")
-	ErrCreateColumn error = errors.New("failed to create column")
-	ErrCreateCard   error = errors.New("failed to create card")
-	ErrUpdateBoard  error = errors.New("failed to update board")
-	ErrUpdateColumn error = errors.New("failed to update column")
-	ErrUpdateCard   error = errors.New("failed to update card")
-	ErrDeleteBoard  error = errors.New("failed to delete board")
-	ErrDeleteColumn error = errors.New("failed to delete column")
-	ErrDeleteCard   error = errors.New("failed to delete card")
+	ErrGetNewCards   error = errors.New("failed to get new cards")
+	ErrGetBoards     error = errors.New("failed to get boards")
+	ErrGetColumns    error = errors.New("failed to get columns")
+	ErrGetCards      error = errors.New("failed to get cards")
+	ErrGetCard       error = errors.New("failed to get card")
+	ErrGetComments   error = errors.New("failed to get comments")
+	ErrGetActivity   error = errors.New("failed to get activity")
+	ErrStreamEvents  error = errors.New("failed to stream events")
+	ErrCreateBoard   error = errors.New("failed to create board")
+	ErrCreateColumn  error = errors.New("failed to create column")
+	ErrCreateCard    error = errors.New("failed to create card")
+	ErrCreateComment error = errors.New("failed to create comment")
+	ErrUpdateComment error = errors.New("failed to update comment")
+	ErrDeleteComment error = errors.New("failed to delete comment")
+	ErrUpdateBoard   error = errors.New("failed to update board")
+	ErrUpdateColumn  error = errors.New("failed to update column")
+	ErrUpdateCard    error = errors.New("failed to update card")
+	ErrDeleteBoard   error = errors.New("failed to delete board")
+	ErrDeleteColumn  error = errors.New("failed to delete column")
+	ErrDeleteCard    error = errors.New("failed to delete card")
+
+	ErrRepositionColumn error = errors.New("failed to reposition column")
+	ErrRepositionCard   error = errors.New("failed to reposition card")
+	ErrMoveCard         error = errors.New("failed to move card")
+
+	ErrSetCardDates    error = errors.New("failed to set card dates")
+	ErrGetOverdueCards error = errors.New("failed to get overdue cards")
+	ErrGetDueCards     error = errors.New("failed to get due cards")
+
+	ErrAssignCard       error = errors.New("failed to assign card")
+	ErrUnassignCard     error = errors.New("failed to unassign card")
+	ErrGetAssignedCards error = errors.New("failed to get assigned cards")
+
+	ErrUploadAttachment error = errors.New("failed to upload attachment")
+	ErrGetAttachment    error = errors.New("failed to get attachment")
+	ErrDeleteAttachment error = errors.New("failed to delete attachment")
+
+	ErrCreateLabel error = errors.New("failed to create label")
+	ErrGetLabels   error = errors.New("failed to get labels")
+	ErrUpdateLabel error = errors.New("failed to update label")
+	ErrDeleteLabel error = errors.New("failed to delete label")
+	ErrAttachLabel error = errors.New("failed to attach label")
+	ErrDetachLabel error = errors.New("failed to detach label")
+
+	ErrGetChecklists       error = errors.New("failed to get checklists")
+	ErrCreateChecklist     error = errors.New("failed to create checklist")
+	ErrCreateChecklistItem error = errors.New("failed to create checklist item")
+	ErrUpdateChecklistItem error = errors.New("failed to update checklist item")
+
+	ErrSetBoardPublic   error = errors.New("failed to set board visibility")
+	ErrCreateShareToken error = errors.New("failed to create share token")
+	ErrGetShareTokens   error = errors.New("failed to get share tokens")
+	ErrRevokeShareToken error = errors.New("failed to revoke share token")
+	ErrGetSharedBoard   error = errors.New("failed to get shared board")
+	ErrGetSharedCard    error = errors.New("failed to get shared card")
+
+	ErrInviteMember     error = errors.New("failed to invite member")
+	ErrAcceptInvitation error = errors.New("failed to accept invitation")
+	ErrUpdateMemberRole error = errors.New("failed to update member role")
+	ErrRemoveMember     error = errors.New("failed to remove member")
+	ErrGetBoardMembers  error = errors.New("failed to get board members")
+	ErrGetInvitations   error = errors.New("failed to get invitations")
+
+	ErrGetTrash    error = errors.New("failed to get trash")
+	ErrRestoreItem error = errors.New("failed to restore item")
+
+	ErrArchiveBoard    error = errors.New("failed to archive board")
+	ErrUnarchiveBoard  error = errors.New("failed to unarchive board")
+	ErrArchiveColumn   error = errors.New("failed to archive column")
+	ErrUnarchiveColumn error = errors.New("failed to unarchive column")
+
+	ErrSetColumnWIPLimit error = errors.New("failed to set wip limit")
+
+	ErrGetRevisions  error = errors.New("failed to get revisions")
+	ErrDiffRevisions error = errors.New("failed to compare revisions")
+	ErrRevertCard    error = errors.New("failed to revert card")
+
+	ErrCloneBoard   error = errors.New("failed to clone board")
+	ErrSearchCards  error = errors.New("failed to search cards")
+	ErrGetTemplates error = errors.New("failed to get templates")
+	ErrExportBoard  error = errors.New("failed to export board")
+	ErrImportBoard  error = errors.New("failed to import board")
+	ErrImportTrello error = errors.New("failed to import trello board")
+	ErrRunBatch     error = errors.New("failed to run batch")
 )
 
 type TodoService struct {
 	baseURL    string
 	httpClient *http.Client
-	log        logger.Logger
+	// streamClient carries attachments; the timeout of httpClient would also
+	// cover streaming the body and cut large files short
+	streamClient *http.Client
+	log          logger.Logger
 }
 
 func NewTodoService(baseURL string, timeout time.Duration, logger logger.Logger) todo.TodoService {
@@ -47,7 +132,8 @@ func NewTodoService(baseURL string, timeout time.Duration, logger logger.Logger)
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		log: logger,
+		streamClient: &http.Client{},
+		log:          logger,
 	}
 }
 
@@ -65,7 +151,7 @@ func (s *TodoService) GetNewCards(ctx context.Context, from, to time.Time) ([]dt
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetNewCards
+		err = statusError(resp.StatusCode, ErrGetNewCards)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
@@ -80,8 +166,11 @@ func (s *TodoService) GetNewCards(ctx context.Context, from, to time.Time) ([]dt
 	return cards, nil
 }
 
-func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
+func (s *TodoService) GetBoards(ctx context.Context, userID string, archived bool) ([]dto.Board, error) {
 	url := fmt.Sprintf("%s/boards?user_id=%s", s.baseURL, userID)
+	if archived {
+		url += "&archived=true"
+	}
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -92,7 +181,7 @@ func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetBoards
+		err = statusError(resp.StatusCode, ErrGetBoards)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
@@ -107,8 +196,11 @@ func (s *TodoService) GetBoards(ctx context.Context, userID string) ([]dto.Board
 	return boards, nil
 }
 
-func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
+func (s *TodoService) GetColumns(ctx context.Context, boardID string, archived bool) ([]dto.Column, error) {
 	url := fmt.Sprintf("%s/columns?board_id=%s", s.baseURL, boardID)
+	if archived {
+		url += "&archived=true"
+	}
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -119,7 +211,7 @@ func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Col
 
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetColumns
+		err = statusError(resp.StatusCode, ErrGetColumns)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
@@ -134,8 +226,11 @@ func (s *TodoService) GetColumns(ctx context.Context, boardID string) ([]dto.Col
 	return columns, nil
 }
 
-func (s *TodoService) GetCards(ctx context.Context, columnID string) ([]dto.Card, error) {
+func (s *TodoService) GetCards(ctx context.Context, columnID, labelID string) ([]dto.Card, error) {
 	url := fmt.Sprintf("%s/cards?column_id=%s", s.baseURL, columnID)
+	if labelID != "" {
+		url += "&label_id=" + labelID
+	}
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -143,9 +238,10 @@ func (s *TodoService) GetCards(ctx context.Context, columnID string) ([]dto.Card
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetCards
+		err = statusError(resp.StatusCode, ErrGetCards)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
@@ -160,8 +256,26 @@ func (s *TodoService) GetCards(ctx context.Context, columnID string) ([]dto.Card
 	return cards, nil
 }
 
-func (s *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error) {
-	url := fmt.Sprintf("%s/cards/%s", s.baseURL, id)
+// pageQuery always carries the cursor, even an empty one, since that is what
+// makes the todo service answer with a page instead of a plain list
+func pageQuery(page dto.PageRequest) url.Values {
+	query := url.Values{}
+	query.Set("cursor", page.Cursor)
+	if page.Limit > 0 {
+		query.Set("limit", strconv.Itoa(page.Limit))
+	}
+
+	return query
+}
+
+func (s *TodoService) GetBoardsPage(ctx context.Context, userID string, archived bool, page dto.PageRequest) (*dto.BoardPage, error) {
+	query := pageQuery(page)
+	query.Set("user_id", userID)
+	if archived {
+		query.Set("archived", "true")
+	}
+
+	url := fmt.Sprintf("%s/boards?%s", s.baseURL, query.Encode())
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -169,229 +283,1983 @@ func (s *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error)
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrGetCard
+		err = statusError(resp.StatusCode, ErrGetBoards)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	var card dto.Card
-	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+	var boards dto.BoardPage
+	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return &card, nil
+	return &boards, nil
 }
 
-func (s *TodoService) CreateBoard(ctx context.Context, board dto.Board) error {
-	url := fmt.Sprintf("%s/boards", s.baseURL)
+func (s *TodoService) GetColumnsPage(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.ColumnPage, error) {
+	query := pageQuery(page)
+	query.Set("board_id", boardID)
+	if archived {
+		query.Set("archived", "true")
+	}
 
-	data := board
+	url := fmt.Sprintf("%s/columns?%s", s.baseURL, query.Encode())
 
-	method := http.MethodPost
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetColumns)
+		s.log.Error(ctx, err.Error())
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateBoard
+	var columns dto.ColumnPage
+	if err := json.NewDecoder(resp.Body).Decode(&columns); err != nil {
+		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &columns, nil
 }
 
-func (s *TodoService) CreateColumn(ctx context.Context, column dto.Column) error {
-	url := fmt.Sprintf("%s/columns", s.baseURL)
+func (s *TodoService) GetCardsPage(ctx context.Context, columnID, labelID string, page dto.PageRequest) (*dto.CardPage, error) {
+	query := pageQuery(page)
+	query.Set("column_id", columnID)
+	if labelID != "" {
+		query.Set("label_id", labelID)
+	}
 
-	data := column
+	url := fmt.Sprintf("%s/cards?%s", s.baseURL, query.Encode())
 
-	method := http.MethodPost
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateColumn
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetCards)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	var cards dto.CardPage
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
 
-func (s *TodoService) CreateCard(ctx context.Context, card dto.Card) error {
-	url := fmt.Sprintf("%s/cards", s.baseURL)
+	return &cards, nil
+}
 
-	data := card
+func (s *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s", s.baseURL, id)
 
-	method := http.MethodPost
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		err = ErrCreateCard
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetCard)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
 }
 
-func (s *TodoService) UpdateBoard(ctx context.Context, board *dto.Board) error {
-	url := fmt.Sprintf("%s/boards", s.baseURL)
+// GetComments returns a page of the thread of the card; zero limit and offset
+// leave the paging to the defaults of the todo service
+func (s *TodoService) GetComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
 
-	data := board
+	url := fmt.Sprintf("%s/cards/%s/comments", s.baseURL, cardID)
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateBoard
+		err = statusError(resp.StatusCode, ErrGetComments)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var comments []dto.Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return comments, nil
 }
 
-func (s *TodoService) UpdateColumn(ctx context.Context, column *dto.Column) error {
-	url := fmt.Sprintf("%s/columns", s.baseURL)
+// GetBoardActivity returns a page of the activity log of the board, newest
+// first; zero limit and offset leave the paging to the todo service
+func (s *TodoService) GetBoardActivity(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error) {
+	return s.getActivity(ctx, fmt.Sprintf("%s/boards/%s/activity", s.baseURL, boardID), limit, offset)
+}
 
-	data := column
+// GetCardActivity is GetBoardActivity for a single card
+func (s *TodoService) GetCardActivity(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error) {
+	return s.getActivity(ctx, fmt.Sprintf("%s/cards/%s/activity", s.baseURL, cardID), limit, offset)
+}
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+func (s *TodoService) StreamBoardEvents(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error) {
+	url := fmt.Sprintf("%s/boards/%s/events", s.baseURL, boardID)
+
+	method := http.MethodGet
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateColumn
+		resp.Body.Close()
+		err = statusError(resp.StatusCode, ErrStreamEvents)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	events := make(chan dto.BoardEvent)
+
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		err := readEvents(resp.Body, func(data string) bool {
+			var event dto.BoardEvent
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				s.log.Error(ctx, ErrDecodeResponse(err).Error())
+				return true
+			}
+
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+
+		if err != nil && ctx.Err() == nil {
+			s.log.Error(ctx, "Event stream broke off", "boardID", boardID, "err", err.Error())
+		}
+	}()
+
+	return events, nil
 }
 
-func (s *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
-	url := fmt.Sprintf("%s/cards", s.baseURL)
+// readEvents hands the data of each server-sent event in r over to handle
+// until it returns false. Comments, such as heartbeats, are skipped.
+func readEvents(r io.Reader, handle func(data string) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			if len(data) > 0 && !handle(strings.Join(data, "\n")) {
+				return nil
+			}
+			data = nil
+		case strings.HasPrefix(line, ":"):
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			if field == "data" {
+				data = append(data, strings.TrimPrefix(value, " "))
+			}
+		}
+	}
 
-	data := card
+	return scanner.Err()
+}
 
-	method := http.MethodPut
-	resp, err := s.makeRequest(ctx, method, url, data)
+func (s *TodoService) getActivity(ctx context.Context, feed string, limit, offset int) ([]dto.Activity, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+
+	url := feed
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrUpdateCard
+		err = statusError(resp.StatusCode, ErrGetActivity)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var activities []dto.Activity
+	if err := json.NewDecoder(resp.Body).Decode(&activities); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return activities, nil
 }
 
-func (s *TodoService) DeleteBoard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/boards?id=%s", s.baseURL, id)
+// GetCardRevisions returns a page of the revisions of the card, newest
+// first; zero limit and offset leave the paging to the todo service
+func (s *TodoService) GetCardRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
 
-	method := http.MethodDelete
+	url := fmt.Sprintf("%s/cards/%s/revisions", s.baseURL, cardID)
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteBoard
+		err = statusError(resp.StatusCode, ErrGetRevisions)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var revisions []dto.CardRevision
+	if err := json.NewDecoder(resp.Body).Decode(&revisions); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return revisions, nil
 }
 
-func (s *TodoService) DeleteColumn(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/columns?id=%s", s.baseURL, id)
+func (s *TodoService) DiffCardRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error) {
+	query := url.Values{}
+	query.Set("from", strconv.Itoa(from))
+	query.Set("to", strconv.Itoa(to))
 
-	method := http.MethodDelete
+	url := fmt.Sprintf("%s/cards/%s/revisions/diff?%s", s.baseURL, cardID, query.Encode())
+
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteColumn
+		err = statusError(resp.StatusCode, ErrDiffRevisions)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var diff dto.RevisionDiff
+	if err := json.NewDecoder(resp.Body).Decode(&diff); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &diff, nil
 }
 
-func (s *TodoService) DeleteCard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/cards?id=%s", s.baseURL, id)
+func (s *TodoService) RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s/revisions/%d/revert", s.baseURL, cardID, number)
 
-	method := http.MethodDelete
+	method := http.MethodPost
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteCard
+		err = statusError(resp.StatusCode, ErrRevertCard)
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
 }
 
-func (s *TodoService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
-	jsonBody, err := json.Marshal(data)
+func (s *TodoService) CreateComment(ctx context.Context, cardID, body string) (*dto.Comment, error) {
+	url := fmt.Sprintf("%s/cards/%s/comments", s.baseURL, cardID)
+
+	data := dto.CreateCommentRequest{Body: body}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
 	if err != nil {
-		err = fmt.Errorf("error marshaling user data: %w", err)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateComment)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		err = fmt.Errorf("error creating request: %w", err)
+	var comment dto.Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	return &comment, nil
+}
 
-	resp, err := s.httpClient.Do(req)
+func (s *TodoService) UpdateComment(ctx context.Context, id, body string) (*dto.Comment, error) {
+	url := fmt.Sprintf("%s/comments/%s", s.baseURL, id)
+
+	data := dto.UpdateCommentRequest{Body: body}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
 	if err != nil {
-		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateComment)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return resp, nil
+	var comment dto.Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+func (s *TodoService) DeleteComment(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/comments/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteComment)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CreateBoard(ctx context.Context, board dto.Board) error {
+	url := fmt.Sprintf("%s/boards", s.baseURL)
+
+	data := board
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateBoard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CreateColumn(ctx context.Context, column dto.Column) error {
+	url := fmt.Sprintf("%s/columns", s.baseURL)
+
+	data := column
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateColumn)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CreateCard(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/cards", s.baseURL)
+
+	data := card
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateCard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) UpdateBoard(ctx context.Context, board *dto.Board) error {
+	url := fmt.Sprintf("%s/boards", s.baseURL)
+
+	data := board
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if versionConflict(resp, board) {
+		s.log.Info(ctx, "Board changed in the meantime", "id", board.ID, "version", board.Version)
+		return todo.ErrConflict
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateBoard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	board.Version = etagVersion(resp)
+
+	return nil
+}
+
+func (s *TodoService) UpdateColumn(ctx context.Context, column *dto.Column) error {
+	url := fmt.Sprintf("%s/columns", s.baseURL)
+
+	data := column
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if versionConflict(resp, column) {
+		s.log.Info(ctx, "Column changed in the meantime", "id", column.ID, "version", column.Version)
+		return todo.ErrConflict
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateColumn)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	column.Version = etagVersion(resp)
+
+	return nil
+}
+
+func (s *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
+	url := fmt.Sprintf("%s/cards", s.baseURL)
+
+	data := card
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if versionConflict(resp, card) {
+		s.log.Info(ctx, "Card changed in the meantime", "id", card.ID, "version", card.Version)
+		return todo.ErrConflict
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateCard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	card.Version = etagVersion(resp)
+
+	return nil
+}
+
+func (s *TodoService) DeleteBoard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/boards?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteBoard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DeleteColumn(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/columns?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteColumn)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DeleteCard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/cards?id=%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteCard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) RepositionColumn(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/columns/%s/position", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRepositionColumn)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+func (s *TodoService) RepositionCard(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s/position", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRepositionCard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *TodoService) MoveCard(ctx context.Context, id string, req dto.MoveCardRequest) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s/move", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrMoveCard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *TodoService) SetCardDates(ctx context.Context, id string, req dto.SetCardDatesRequest) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/%s/dates", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrSetCardDates)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *TodoService) GetOverdueCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/overdue?user_id=%s", s.baseURL, userID)
+
+	return s.getCards(ctx, url, ErrGetOverdueCards)
+}
+
+func (s *TodoService) GetDueCards(ctx context.Context, userID string, days int) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/due?user_id=%s&days=%d", s.baseURL, userID, days)
+
+	return s.getCards(ctx, url, ErrGetDueCards)
+}
+
+func (s *TodoService) AssignCard(ctx context.Context, cardID, userID string) error {
+	url := fmt.Sprintf("%s/cards/%s/assignees/%s", s.baseURL, cardID, userID)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrAssignCard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) UnassignCard(ctx context.Context, cardID, userID string) error {
+	url := fmt.Sprintf("%s/cards/%s/assignees/%s", s.baseURL, cardID, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUnassignCard)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetAssignedCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/assigned?user_id=%s", s.baseURL, userID)
+
+	return s.getCards(ctx, url, ErrGetAssignedCards)
+}
+
+func (s *TodoService) getCards(ctx context.Context, url string, failure error) ([]dto.Card, error) {
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, failure)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+func (s *TodoService) UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error) {
+	url := fmt.Sprintf("%s/cards/%s/attachments?filename=%s", s.baseURL, cardID, url.QueryEscape(filename))
+
+	method := http.MethodPost
+	req, err := http.NewRequestWithContext(ctx, method, url, content)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrUploadAttachment)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var attachment dto.Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func (s *TodoService) GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error) {
+	url := fmt.Sprintf("%s/attachments/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err = statusError(resp.StatusCode, ErrGetAttachment)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &dto.AttachmentContent{
+		ContentType:        resp.Header.Get("Content-Type"),
+		ContentDisposition: resp.Header.Get("Content-Disposition"),
+		Size:               resp.ContentLength,
+		Body:               resp.Body,
+	}, nil
+}
+
+func (s *TodoService) DeleteAttachment(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/attachments/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteAttachment)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CreateLabel(ctx context.Context, boardID string, req dto.LabelRequest) (*dto.Label, error) {
+	url := fmt.Sprintf("%s/boards/%s/labels", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateLabel)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeLabel(ctx, resp)
+}
+
+func (s *TodoService) GetLabels(ctx context.Context, boardID string) ([]dto.Label, error) {
+	url := fmt.Sprintf("%s/boards/%s/labels", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetLabels)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var labels []dto.Label
+	if err := json.NewDecoder(resp.Body).Decode(&labels); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+func (s *TodoService) UpdateLabel(ctx context.Context, id string, req dto.LabelRequest) (*dto.Label, error) {
+	url := fmt.Sprintf("%s/labels/%s", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateLabel)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeLabel(ctx, resp)
+}
+
+func (s *TodoService) decodeLabel(ctx context.Context, resp *http.Response) (*dto.Label, error) {
+	var label dto.Label
+	if err := json.NewDecoder(resp.Body).Decode(&label); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &label, nil
+}
+
+func (s *TodoService) DeleteLabel(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/labels/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDeleteLabel)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) AttachLabel(ctx context.Context, cardID, labelID string) error {
+	url := fmt.Sprintf("%s/cards/%s/labels/%s", s.baseURL, cardID, labelID)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrAttachLabel)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) DetachLabel(ctx context.Context, cardID, labelID string) error {
+	url := fmt.Sprintf("%s/cards/%s/labels/%s", s.baseURL, cardID, labelID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrDetachLabel)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error) {
+	url := fmt.Sprintf("%s/cards/%s/checklists", s.baseURL, cardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetChecklists)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var checklists []dto.Checklist
+	if err := json.NewDecoder(resp.Body).Decode(&checklists); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return checklists, nil
+}
+
+func (s *TodoService) CreateChecklist(ctx context.Context, cardID, title string) (*dto.Checklist, error) {
+	url := fmt.Sprintf("%s/cards/%s/checklists", s.baseURL, cardID)
+
+	data := dto.CreateChecklistRequest{Title: title}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateChecklist)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var checklist dto.Checklist
+	if err := json.NewDecoder(resp.Body).Decode(&checklist); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &checklist, nil
+}
+
+func (s *TodoService) CreateChecklistItem(ctx context.Context, checklistID, text string) (*dto.ChecklistItem, error) {
+	url := fmt.Sprintf("%s/checklists/%s/items", s.baseURL, checklistID)
+
+	data := dto.CreateChecklistItemRequest{Text: text}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateChecklistItem)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeChecklistItem(ctx, resp)
+}
+
+func (s *TodoService) SetChecklistItemDone(ctx context.Context, itemID string, done bool) (*dto.ChecklistItem, error) {
+	url := fmt.Sprintf("%s/checklist-items/%s/done", s.baseURL, itemID)
+
+	data := dto.SetChecklistItemDoneRequest{Done: done}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateChecklistItem)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeChecklistItem(ctx, resp)
+}
+
+func (s *TodoService) decodeChecklistItem(ctx context.Context, resp *http.Response) (*dto.ChecklistItem, error) {
+	var item dto.ChecklistItem
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (s *TodoService) SetBoardPublic(ctx context.Context, boardID string, isPublic bool) error {
+	url := fmt.Sprintf("%s/boards/%s/public", s.baseURL, boardID)
+
+	data := dto.SetBoardPublicRequest{IsPublic: isPublic}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrSetBoardPublic)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) CreateShareToken(ctx context.Context, boardID string) (*dto.ShareToken, error) {
+	url := fmt.Sprintf("%s/boards/%s/share", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCreateShareToken)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var token dto.ShareToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (s *TodoService) GetShareTokens(ctx context.Context, boardID string) ([]dto.ShareToken, error) {
+	url := fmt.Sprintf("%s/boards/%s/share", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetShareTokens)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var tokens []dto.ShareToken
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (s *TodoService) RevokeShareToken(ctx context.Context, boardID, token string) error {
+	url := fmt.Sprintf("%s/boards/%s/share/%s", s.baseURL, boardID, url.PathEscape(token))
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRevokeShareToken)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetSharedBoard(ctx context.Context, token string) (*dto.BoardTree, error) {
+	url := fmt.Sprintf("%s/shared/%s", s.baseURL, url.PathEscape(token))
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetSharedBoard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var tree dto.BoardTree
+	if err := json.NewDecoder(resp.Body).Decode(&tree); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &tree, nil
+}
+
+func (s *TodoService) GetSharedCard(ctx context.Context, token, cardID string) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/shared/%s/cards/%s", s.baseURL, url.PathEscape(token), cardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetSharedCard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *TodoService) InviteMember(ctx context.Context, boardID string, req dto.InviteMemberRequest) (*dto.BoardMember, error) {
+	url := fmt.Sprintf("%s/boards/%s/members", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrInviteMember)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeMember(ctx, resp)
+}
+
+func (s *TodoService) AcceptInvitation(ctx context.Context, boardID string) (*dto.BoardMember, error) {
+	url := fmt.Sprintf("%s/boards/%s/members/accept", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrAcceptInvitation)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeMember(ctx, resp)
+}
+
+func (s *TodoService) UpdateMemberRole(ctx context.Context, boardID, userID, role string) (*dto.BoardMember, error) {
+	url := fmt.Sprintf("%s/boards/%s/members/%s", s.baseURL, boardID, userID)
+
+	data := dto.UpdateMemberRoleRequest{Role: role}
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, data)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", data)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrUpdateMemberRole)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.decodeMember(ctx, resp)
+}
+
+func (s *TodoService) decodeMember(ctx context.Context, resp *http.Response) (*dto.BoardMember, error) {
+	var member dto.BoardMember
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (s *TodoService) RemoveMember(ctx context.Context, boardID, userID string) error {
+	url := fmt.Sprintf("%s/boards/%s/members/%s", s.baseURL, boardID, userID)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRemoveMember)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoService) GetBoardMembers(ctx context.Context, boardID string) ([]dto.BoardMember, error) {
+	url := fmt.Sprintf("%s/boards/%s/members", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetBoardMembers)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var members []dto.BoardMember
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (s *TodoService) GetInvitations(ctx context.Context, userID string) ([]dto.BoardMember, error) {
+	url := fmt.Sprintf("%s/invitations?user_id=%s", s.baseURL, userID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetInvitations)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var invitations []dto.BoardMember
+	if err := json.NewDecoder(resp.Body).Decode(&invitations); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// GetTrash returns a page of the items the user deleted or that were deleted
+// from their boards; zero limit and offset leave the paging to the todo
+// service
+func (s *TodoService) GetTrash(ctx context.Context, userID string, limit, offset int) ([]dto.TrashItem, error) {
+	query := url.Values{}
+	query.Set("user_id", userID)
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+
+	url := fmt.Sprintf("%s/trash?%s", s.baseURL, query.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetTrash)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var items []dto.TrashItem
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (s *TodoService) RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error) {
+	url := fmt.Sprintf("%s/trash/%s/restore", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRestoreItem)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var item dto.TrashItem
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (s *TodoService) ArchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	return s.setBoardArchived(ctx, id, "archive", ErrArchiveBoard)
+}
+
+func (s *TodoService) UnarchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	return s.setBoardArchived(ctx, id, "unarchive", ErrUnarchiveBoard)
+}
+
+func (s *TodoService) setBoardArchived(ctx context.Context, id, action string, fallback error) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/%s/%s", s.baseURL, id, action)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, fallback)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+func (s *TodoService) ArchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	return s.setColumnArchived(ctx, id, "archive", ErrArchiveColumn)
+}
+
+func (s *TodoService) UnarchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	return s.setColumnArchived(ctx, id, "unarchive", ErrUnarchiveColumn)
+}
+
+func (s *TodoService) SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/columns/%s/wip-limit", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrSetColumnWIPLimit)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+func (s *TodoService) setColumnArchived(ctx context.Context, id, action string, fallback error) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/columns/%s/%s", s.baseURL, id, action)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, fallback)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+func (s *TodoService) CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/%s/clone", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrCloneBoard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+func (s *TodoService) GetTemplates(ctx context.Context, userID string) ([]dto.Board, error) {
+	url := fmt.Sprintf("%s/templates?user_id=%s", s.baseURL, userID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrGetTemplates)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var templates []dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&templates); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// ExportBoard returns the export document as it is; its format belongs to the
+// todo service
+func (s *TodoService) ExportBoard(ctx context.Context, id string) (json.RawMessage, error) {
+	url := fmt.Sprintf("%s/boards/%s/export", s.baseURL, id)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrExportBoard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var export json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&export); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (s *TodoService) ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/import", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, export)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = statusError(resp.StatusCode, ErrImportBoard)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+func (s *TodoService) ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error) {
+	url := fmt.Sprintf("%s/boards/import/trello?dry_run=%t", s.baseURL, dryRun)
+
+	// A dry run creates nothing and answers with 200
+	want := http.StatusCreated
+	if dryRun {
+		want = http.StatusOK
+	}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, trello)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != want {
+		err = statusError(resp.StatusCode, ErrImportTrello)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var summary dto.ImportSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+func (s *TodoService) SearchCards(ctx context.Context, userID string, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error) {
+	query := url.Values{}
+	query.Set("user_id", userID)
+	query.Set("q", search.Query)
+	if search.BoardID != "" {
+		query.Set("board_id", search.BoardID)
+	}
+	if search.From != "" {
+		query.Set("from", search.From)
+	}
+	if search.To != "" {
+		query.Set("to", search.To)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+
+	url := fmt.Sprintf("%s/cards/search?%s", s.baseURL, query.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrSearchCards)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var matches []dto.CardMatch
+	if err := json.NewDecoder(resp.Body).Decode(&matches); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+func (s *TodoService) RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error) {
+	url := fmt.Sprintf("%s/batch", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = statusError(resp.StatusCode, ErrRunBatch)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var batch dto.BatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &batch, nil
+}
+
+// statusError keeps the meaning of access errors reported by the todo service
+// and falls back to the operation error for everything else
+func statusError(statusCode int, fallback error) error {
+	switch statusCode {
+	case http.StatusForbidden:
+		return todo.ErrForbidden
+	case http.StatusNotFound:
+		return todo.ErrNotFound
+	case http.StatusBadRequest:
+		return todo.ErrBadRequest
+	case http.StatusRequestEntityTooLarge:
+		return todo.ErrTooLarge
+	case http.StatusLengthRequired:
+		return todo.ErrLengthRequired
+	default:
+		return fallback
+	}
+}
+
+// versionConflict reads the current state the todo service answers an
+// update based on an outdated version with into current. The other conflicts
+// of the todo service come as plain text and are left to statusError.
+func versionConflict(resp *http.Response, current any) bool {
+	if resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusPreconditionFailed {
+		return false
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		return false
+	}
+
+	return json.NewDecoder(resp.Body).Decode(current) == nil
+}
+
+// etagVersion reads the version from the ETag of a response; zero when there
+// is none
+func etagVersion(resp *http.Response) int {
+	tag, err := strconv.Unquote(resp.Header.Get("ETag"))
+	if err != nil {
+		return 0
+	}
+
+	version, _ := strconv.Atoi(tag)
+
+	return version
+}
+
+func (s *TodoService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
+	jsonBody, err := json.Marshal(data)
+	if err != nil {
+		err = fmt.Errorf("error marshaling user data: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	setCaller(ctx, req)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// doStream sends a request whose body is streamed rather than buffered
+func (s *TodoService) doStream(ctx context.Context, req *http.Request) (*http.Response, error) {
+	setCaller(ctx, req)
+
+	resp, err := s.streamClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// setCaller forwards the caller; the todo service checks ownership against it
+func setCaller(ctx context.Context, req *http.Request) {
+	if userID, ok := middleware.GetUserIDFromContext(ctx); ok {
+		req.Header.Set(userIDHeader, userID)
+	}
+
+	if role, ok := middleware.GetRoleFromContext(ctx); ok {
+		req.Header.Set(roleHeader, role)
+	}
 }
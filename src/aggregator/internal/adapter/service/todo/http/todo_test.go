@@ -0,0 +1,652 @@
+package http_test
+
+import (
+	todoHTTP "aggregator/internal/adapter/service/todo/http"
+	"aggregator/internal/common/logger"
+	"aggregator/internal/dto"
+	"aggregator/internal/middleware"
+	"aggregator/internal/service/todo"
+	"aggregator/mocks"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+// callerContext builds the context the auth middleware hands to handlers
+func callerContext(t *testing.T, userID, role string) context.Context {
+	var ctx context.Context
+
+	authSvc := new(mocks.AuthService)
+	authSvc.On("ValidateToken", mock.Anything, "token").Return(&dto.ValidateTokenResponse{UserID: userID, Role: role}, nil)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	middleware.NewAuthMiddleware(authSvc).Middleware(next).ServeHTTP(httptest.NewRecorder(), req)
+
+	if ctx == nil {
+		t.Fatal("auth middleware rejected the request")
+	}
+
+	return ctx
+}
+
+func TestForwardsCallerIdentity(t *testing.T) {
+	var gotUserID, gotRole string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserID = r.Header.Get("X-User-ID")
+		gotRole = r.Header.Get("X-User-Role")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	err := svc.DeleteCard(callerContext(t, "user-id", "admin"), "card-id")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "user-id", gotUserID)
+	assert.Equal(t, "admin", gotRole)
+}
+
+func TestMapsAccessErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		err    error
+	}{
+		{"forbidden", http.StatusForbidden, todo.ErrForbidden},
+		{"not found", http.StatusNotFound, todo.ErrNotFound},
+		{"bad request", http.StatusBadRequest, todo.ErrBadRequest},
+		{"too large", http.StatusRequestEntityTooLarge, todo.ErrTooLarge},
+		{"length required", http.StatusLengthRequired, todo.ErrLengthRequired},
+		{"other", http.StatusInternalServerError, todoHTTP.ErrGetCard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+			card, err := svc.GetCard(context.Background(), "card-id")
+
+			assert.Nil(t, card)
+			assert.True(t, errors.Is(err, tt.err), err)
+		})
+	}
+}
+
+func TestGetCardsForwardsLabelFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		labelID string
+		query   string
+	}{
+		{"without label", "", "column_id=column-id"},
+		{"with label", "label-id", "column_id=column-id&label_id=label-id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.RawQuery
+				w.Write([]byte(`[{"title":"Card","labels":[{"name":"Bug","color":"#d73a4a"}]}]`))
+			}))
+			defer server.Close()
+
+			svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+			cards, err := svc.GetCards(context.Background(), "column-id", tt.labelID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.query, gotQuery)
+			assert.Equal(t, []dto.Label{{Name: "Bug", Color: "#d73a4a"}}, cards[0].Labels)
+		})
+	}
+}
+
+func TestGetCommentsForwardsPaging(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		offset int
+		query  string
+	}{
+		{"service defaults", 0, 0, ""},
+		{"first page", 20, 0, "limit=20"},
+		{"next page", 20, 40, "limit=20&offset=40"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotQuery string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotQuery = r.URL.RawQuery
+				w.Write([]byte(`[{"body":"Looks good to me"}]`))
+			}))
+			defer server.Close()
+
+			svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+			comments, err := svc.GetComments(context.Background(), "card-id", tt.limit, tt.offset)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "/cards/card-id/comments", gotPath)
+			assert.Equal(t, tt.query, gotQuery)
+			assert.Equal(t, []dto.Comment{{Body: "Looks good to me"}}, comments)
+		})
+	}
+}
+
+func TestGetActivityForwardsPaging(t *testing.T) {
+	var gotPaths, gotQueries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		w.Write([]byte(`[{"action":"move","changes":[{"field":"column_id","before":"a","after":"b"}]}]`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	board, err := svc.GetBoardActivity(context.Background(), "board-id", 20, 40)
+	assert.Nil(t, err)
+
+	card, err := svc.GetCardActivity(context.Background(), "card-id", 0, 0)
+	assert.Nil(t, err)
+
+	before, after := "a", "b"
+	want := []dto.Activity{{Action: "move", Changes: []dto.FieldChange{{Field: "column_id", Before: &before, After: &after}}}}
+	assert.Equal(t, want, board)
+	assert.Equal(t, want, card)
+	assert.Equal(t, []string{"/boards/board-id/activity", "/cards/card-id/activity"}, gotPaths)
+	assert.Equal(t, []string{"limit=20&offset=40", ""}, gotQueries)
+}
+
+func TestCardRevisions(t *testing.T) {
+	var gotMethods, gotPaths, gotQueries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethods = append(gotMethods, r.Method)
+		gotPaths = append(gotPaths, r.URL.Path)
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/diff"):
+			w.Write([]byte(`{"from":{"number":1},"to":{"number":2},"description":[{"op":"delete","text":"old"},{"op":"insert","text":"new"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/revert"):
+			w.Write([]byte(`{"title":"Old title","version":4}`))
+		default:
+			w.Write([]byte(`[{"number":2,"reverted_from":1},{"number":1}]`))
+		}
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	revisions, err := svc.GetCardRevisions(context.Background(), "card-id", 5, 0)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, 1, *revisions[0].RevertedFrom)
+	assert.Nil(t, revisions[1].RevertedFrom)
+
+	diff, err := svc.DiffCardRevisions(context.Background(), "card-id", 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []dto.DiffLine{{Op: "delete", Text: "old"}, {Op: "insert", Text: "new"}}, diff.Description)
+
+	card, err := svc.RevertCard(context.Background(), "card-id", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Old title", card.Title)
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodGet, http.MethodPost}, gotMethods)
+	assert.Equal(t, []string{"/cards/card-id/revisions", "/cards/card-id/revisions/diff", "/cards/card-id/revisions/1/revert"}, gotPaths)
+	assert.Equal(t, []string{"limit=5", "from=1&to=2", ""}, gotQueries)
+}
+
+func TestGetTrashForwardsPaging(t *testing.T) {
+	var gotPath, gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`[{"type":"card","title":"Gone"}]`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	items, err := svc.GetTrash(context.Background(), "user-id", 20, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []dto.TrashItem{{Type: "card", Title: "Gone"}}, items)
+	assert.Equal(t, "/trash", gotPath)
+	assert.Equal(t, "limit=20&user_id=user-id", gotQuery)
+}
+
+func TestArchive(t *testing.T) {
+	var gotMethods, gotRequests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethods = append(gotMethods, r.Method)
+		gotRequests = append(gotRequests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/boards":
+			w.Write([]byte(`[{"title":"Done","archived_at":"2025-01-10T00:00:00Z"}]`))
+		case "/columns/column-id/unarchive":
+			w.Write([]byte(`{"title":"Later"}`))
+		default:
+			w.WriteHeader(http.StatusConflict)
+		}
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	boards, err := svc.GetBoards(context.Background(), "user-id", true)
+	assert.Nil(t, err)
+	assert.Len(t, boards, 1)
+	assert.NotNil(t, boards[0].ArchivedAt)
+
+	column, err := svc.UnarchiveColumn(context.Background(), "column-id")
+	assert.Nil(t, err)
+	assert.Nil(t, column.ArchivedAt)
+
+	_, err = svc.ArchiveBoard(context.Background(), "board-id")
+	assert.ErrorIs(t, err, todoHTTP.ErrArchiveBoard)
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost, http.MethodPost}, gotMethods)
+	assert.Equal(t, []string{"/boards?user_id=user-id&archived=true", "/columns/column-id/unarchive", "/boards/board-id/archive"}, gotRequests)
+}
+
+func TestCloneBoard(t *testing.T) {
+	var gotBody dto.CloneBoardRequest
+	var gotRequests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/boards/board-id/clone":
+			json.NewDecoder(r.Body).Decode(&gotBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"title":"Sprint","is_template":true}`))
+		case "/templates":
+			w.Write([]byte(`[{"title":"Sprint","is_template":true}]`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	board, err := svc.CloneBoard(context.Background(), "board-id", dto.CloneBoardRequest{ExcludeCards: true, Template: true})
+	assert.Nil(t, err)
+	assert.True(t, board.IsTemplate)
+	assert.Equal(t, dto.CloneBoardRequest{ExcludeCards: true, Template: true}, gotBody)
+
+	templates, err := svc.GetTemplates(context.Background(), "user-id")
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+
+	_, err = svc.CloneBoard(context.Background(), "other-id", dto.CloneBoardRequest{})
+	assert.ErrorIs(t, err, todo.ErrForbidden)
+
+	assert.Equal(t, []string{"/boards/board-id/clone", "/templates?user_id=user-id", "/boards/other-id/clone"}, gotRequests)
+}
+
+func TestExportImportBoard(t *testing.T) {
+	document := `{"version":1,"board":{"title":"Sprint"},"labels":[],"columns":[]}`
+	var gotBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/boards/board-id/export":
+			w.Write([]byte(document))
+		case "/boards/import":
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+			if !strings.Contains(gotBody, `"version":1`) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"title":"Sprint"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	export, err := svc.ExportBoard(context.Background(), "board-id")
+	assert.Nil(t, err)
+	assert.JSONEq(t, document, string(export))
+
+	// The document reaches the todo service untouched
+	board, err := svc.ImportBoard(context.Background(), export)
+	assert.Nil(t, err)
+	assert.Equal(t, "Sprint", board.Title)
+	assert.JSONEq(t, document, gotBody)
+
+	_, err = svc.ImportBoard(context.Background(), json.RawMessage(`{"version":2}`))
+	assert.ErrorIs(t, err, todo.ErrBadRequest)
+
+	_, err = svc.ExportBoard(context.Background(), "other-id")
+	assert.ErrorIs(t, err, todo.ErrNotFound)
+}
+
+func TestUpdateCardVersions(t *testing.T) {
+	current := `{"id":"6c1f0b7e-5d0a-4a53-9b8e-1a2b3c4d5e6f","title":"Theirs","version":4}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var card dto.Card
+		json.NewDecoder(r.Body).Decode(&card)
+
+		switch card.Version {
+		case 3:
+			w.Header().Set("ETag", `"4"`)
+		case 2:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"4"`)
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(current))
+		default:
+			// Other conflicts of the todo service are plain text
+			http.Error(w, "board or column is archived", http.StatusConflict)
+		}
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	card := dto.Card{Title: "Ours", Version: 3}
+	err := svc.UpdateCard(context.Background(), &card)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, card.Version)
+
+	// A stale update comes back with the current state to merge into
+	card = dto.Card{Title: "Ours", Version: 2}
+	err = svc.UpdateCard(context.Background(), &card)
+	assert.ErrorIs(t, err, todo.ErrConflict)
+	assert.Equal(t, "Theirs", card.Title)
+	assert.Equal(t, 4, card.Version)
+
+	card = dto.Card{Title: "Ours", Version: 1}
+	err = svc.UpdateCard(context.Background(), &card)
+	assert.NotErrorIs(t, err, todo.ErrConflict)
+	assert.ErrorIs(t, err, todoHTTP.ErrUpdateCard)
+}
+
+func TestImportTrelloBoard(t *testing.T) {
+	var gotQueries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		if r.URL.Query().Get("dry_run") == "true" {
+			w.Write([]byte(`{"title":"Roadmap","dry_run":true,"cards":2,"skipped":{"attachments":3}}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"title":"Roadmap","board":{"title":"Roadmap"},"cards":2}`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	summary, err := svc.ImportTrelloBoard(context.Background(), json.RawMessage(`{"name":"Roadmap"}`), true)
+	assert.Nil(t, err)
+	assert.Nil(t, summary.Board)
+	assert.Equal(t, 3, summary.Skipped.Attachments)
+
+	summary, err = svc.ImportTrelloBoard(context.Background(), json.RawMessage(`{"name":"Roadmap"}`), false)
+	assert.Nil(t, err)
+	assert.Equal(t, "Roadmap", summary.Board.Title)
+
+	assert.Equal(t, []string{"dry_run=true", "dry_run=false"}, gotQueries)
+}
+
+func TestSearchCardsForwardsFilters(t *testing.T) {
+	var gotPath, gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		if r.URL.Query().Get("q") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`[{"card":{"title":"Release notes"},"rank":0.6,"title_headline":"<b>Release</b> notes"}]`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	matches, err := svc.SearchCards(context.Background(), "user-id", dto.CardSearch{Query: "release notes", To: "31-12-2025"}, 20, 0)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "<b>Release</b> notes", matches[0].TitleHeadline)
+	assert.Equal(t, "/cards/search", gotPath)
+	assert.Equal(t, "limit=20&q=release+notes&to=31-12-2025&user_id=user-id", gotQuery)
+
+	_, err = svc.SearchCards(context.Background(), "user-id", dto.CardSearch{}, 0, 0)
+	assert.ErrorIs(t, err, todo.ErrBadRequest)
+}
+
+func TestGetDueCardsForwardsWindow(t *testing.T) {
+	var gotPath, gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`[{"title":"Release","due_date":"2025-01-10T00:00:00Z"}]`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	cards, err := svc.GetDueCards(context.Background(), "user-id", 7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "/cards/due", gotPath)
+	assert.Equal(t, "user_id=user-id&days=7", gotQuery)
+	assert.Equal(t, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), cards[0].DueDate.UTC())
+}
+
+func TestUploadAttachmentStreamsContent(t *testing.T) {
+	var gotQuery, gotUserID, gotBody string
+	var gotLength int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		gotUserID = r.Header.Get("X-User-ID")
+		gotLength = r.ContentLength
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"filename":"my notes.txt","content_type":"text/plain; charset=utf-8","size":8}`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	attachment, err := svc.UploadAttachment(callerContext(t, "user-id", "user"), "card-id", "my notes.txt", 8, strings.NewReader("Log line"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "filename=my+notes.txt", gotQuery)
+	assert.Equal(t, "user-id", gotUserID)
+	assert.Equal(t, int64(8), gotLength)
+	assert.Equal(t, "Log line", gotBody)
+	assert.Equal(t, int64(8), attachment.Size)
+}
+
+func TestGetAttachmentStreamsContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="log.txt"`)
+		w.Header().Set("Content-Length", "8")
+		w.Write([]byte("Log line"))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	content, err := svc.GetAttachment(context.Background(), "attachment-id")
+	assert.Nil(t, err)
+	defer content.Body.Close()
+
+	body, _ := io.ReadAll(content.Body)
+	assert.Equal(t, "Log line", string(body))
+	assert.Equal(t, "text/plain; charset=utf-8", content.ContentType)
+	assert.Equal(t, `attachment; filename="log.txt"`, content.ContentDisposition)
+	assert.Equal(t, int64(8), content.Size)
+}
+
+func TestGetCardsPage(t *testing.T) {
+	var gotQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"cards":[{"title":"Card"}],"next_cursor":"next"}`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	page, err := svc.GetCardsPage(context.Background(), "column", "", dto.PageRequest{})
+	assert.Nil(t, err)
+	assert.Len(t, page.Cards, 1)
+	assert.Equal(t, "next", page.NextCursor)
+
+	// The first page is asked for with an empty cursor
+	assert.True(t, gotQuery.Has("cursor"))
+	assert.False(t, gotQuery.Has("limit"))
+	assert.False(t, gotQuery.Has("label_id"))
+
+	_, err = svc.GetCardsPage(context.Background(), "column", "label", dto.PageRequest{Cursor: page.NextCursor, Limit: 20})
+	assert.Nil(t, err)
+	assert.Equal(t, "next", gotQuery.Get("cursor"))
+	assert.Equal(t, "20", gotQuery.Get("limit"))
+	assert.Equal(t, "label", gotQuery.Get("label_id"))
+}
+
+func TestCreateCardWIPWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"9a4b4c77-0c0e-4a9e-9b57-3c8f4a2d7e11","title":"Card","version":1,"over_wip_limit":true}`))
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	card := dto.Card{Title: "Card"}
+	err := svc.CreateCard(context.Background(), &card)
+	assert.Nil(t, err)
+	assert.Equal(t, "9a4b4c77-0c0e-4a9e-9b57-3c8f4a2d7e11", card.ID.String())
+	assert.True(t, card.OverWIPLimit)
+}
+
+func TestStreamBoardEvents(t *testing.T) {
+	var gotPath, gotLastEventID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotLastEventID = r.Header.Get("Last-Event-ID")
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte(": heartbeat\n\n"))
+		w.Write([]byte("id: e-2\nevent: card.update\ndata: {\"id\":\"e-2\",\"type\":\"card.update\",\"change\":{\"action\":\"update\"}}\n\n"))
+		w.Write([]byte(": heartbeat\n\n"))
+		w.Write([]byte("id: e-3\nevent: reset\ndata: {\"id\":\"e-3\",\"type\":\"reset\"}\n\n"))
+	}))
+	defer server.Close()
+
+	// The stream outlives the timeout of the other requests
+	svc := todoHTTP.NewTodoService(server.URL, time.Nanosecond, nopLogger{})
+
+	events, err := svc.StreamBoardEvents(context.Background(), "board-id", "e-1")
+	assert.Nil(t, err)
+
+	var got []dto.BoardEvent
+	for event := range events {
+		got = append(got, event)
+	}
+
+	assert.Equal(t, "/boards/board-id/events", gotPath)
+	assert.Equal(t, "e-1", gotLastEventID)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "card.update", got[0].Type)
+		assert.Equal(t, "update", got[0].Change.Action)
+		assert.Equal(t, "e-3", got[1].ID)
+		assert.Nil(t, got[1].Change)
+	}
+}
+
+func TestStreamBoardEventsRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	_, err := svc.StreamBoardEvents(context.Background(), "board-id", "")
+	assert.ErrorIs(t, err, todo.ErrForbidden)
+}
+
+func TestStreamBoardEventsStopsWithContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(": heartbeat\n\n"))
+		w.(http.Flusher).Flush()
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	svc := todoHTTP.NewTodoService(server.URL, time.Second, nopLogger{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := svc.StreamBoardEvents(ctx, "board-id", "")
+	assert.Nil(t, err)
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream not closed")
+	}
+}
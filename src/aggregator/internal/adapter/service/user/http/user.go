@@ -10,13 +10,20 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const layout string = "02-01-2006"
 
+// maxUsersPerRequest is the most users the user service looks up at once
+const maxUsersPerRequest = 100
+
 var (
 	ErrGetNewUsers    error             = errors.New("failed to get new users")
+	ErrGetUsersByIDs  error             = errors.New("failed to get users by ids")
 	ErrDecodeResponse func(error) error = func(err error) error {
 		return fmt.Errorf("Failed to decode response: %w", err)
 	}
@@ -69,6 +76,57 @@ func (s *UserService) GetNewUsers(ctx context.Context, from, to time.Time) ([]dt
 	return users, nil
 }
 
+func (s *UserService) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]dto.User, error) {
+	var users []dto.User
+
+	for start := 0; start < len(ids); start += maxUsersPerRequest {
+		end := min(start+maxUsersPerRequest, len(ids))
+
+		batch, err := s.getUsersByIDs(ctx, ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, batch...)
+	}
+
+	return users, nil
+}
+
+func (s *UserService) getUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]dto.User, error) {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+
+	url := fmt.Sprintf("%s/users/batch?ids=%s", s.baseURL, strings.Join(strIDs, ","))
+
+	s.log.Info(ctx, "Making GetUsersByIDs request", "url", url)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetUsersByIDs
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var users []dto.User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (s *UserService) makeRequest(ctx context.Context, method, url string, data any) (*http.Response, error) {
 	jsonBody, err := json.Marshal(data)
 	if err != nil {
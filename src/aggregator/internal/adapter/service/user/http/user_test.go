@@ -0,0 +1,69 @@
+package http_test
+
+import (
+	userHTTP "aggregator/internal/adapter/service/user/http"
+	"aggregator/internal/common/logger"
+	"aggregator/internal/dto"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+func TestGetUsersByIDsInBatches(t *testing.T) {
+	var batches []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/batch", r.URL.Path)
+
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		batches = append(batches, len(ids))
+
+		users := make([]dto.User, len(ids))
+		for i, id := range ids {
+			users[i] = dto.User{ID: uuid.MustParse(id), Username: "user"}
+		}
+		json.NewEncoder(w).Encode(users)
+	}))
+	defer server.Close()
+
+	ids := make([]uuid.UUID, 150)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+
+	svc := userHTTP.NewUserService(server.URL, time.Second, nopLogger{})
+	users, err := svc.GetUsersByIDs(context.Background(), ids)
+
+	assert.Nil(t, err)
+	assert.Len(t, users, 150)
+	assert.Equal(t, []int{100, 50}, batches)
+}
+
+func TestGetUsersByIDsFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	svc := userHTTP.NewUserService(server.URL, time.Second, nopLogger{})
+	users, err := svc.GetUsersByIDs(context.Background(), []uuid.UUID{uuid.New()})
+
+	assert.ErrorIs(t, err, userHTTP.ErrGetUsersByIDs)
+	assert.Nil(t, users)
+}
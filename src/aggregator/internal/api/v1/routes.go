@@ -14,25 +14,100 @@ func InitializeV1Routes(router *mux.Router, aggHandler *v1.AggregatorHandler, au
 	router.HandleFunc("/api/v1/validate", aggHandler.Validate).Methods("POST")
 	router.HandleFunc("/api/v1/logout", aggHandler.Logout).Methods("POST")
 
+	// Guest access by share link; no authentication, read-only
+	router.HandleFunc("/api/v1/shared/{token}", aggHandler.GetSharedBoard).Methods("GET")
+	router.HandleFunc("/api/v1/shared/{token}/card/{id}", aggHandler.GetSharedCard).Methods("GET")
+	router.PathPrefix("/api/v1/shared/").HandlerFunc(aggHandler.RejectSharedMutation)
+
 	authRoutes := router.PathPrefix("/api/v1").Subrouter()
 	authRoutes.Use(authMiddleware.Middleware)
 
-	authRoutes.HandleFunc("/boards", aggHandler.GetBoards).Methods("GET")      // Boards
-	authRoutes.HandleFunc("/board/{id}", aggHandler.GetBoard).Methods("GET")   // Columns + cards
-	authRoutes.HandleFunc("/column/{id}", aggHandler.GetColumn).Methods("GET") // Cards
-	authRoutes.HandleFunc("/card/{id}", aggHandler.GetCard).Methods("GET")     // Card + description
+	authRoutes.HandleFunc("/boards", aggHandler.GetBoards).Methods("GET")                     // Boards, ?archived=true for the archived ones, ?cursor=&limit= to page
+	authRoutes.HandleFunc("/board/{id}", aggHandler.GetBoard).Methods("GET")                  // Columns with their card counts and WIP limits and the label palette, ?archived=true for the archived columns, ?cursor=&limit= to page
+	authRoutes.HandleFunc("/column/{id}", aggHandler.GetColumn).Methods("GET")                // Cards, ?label_id= to filter, ?cursor=&limit= to page
+	authRoutes.HandleFunc("/card/{id}", aggHandler.GetCard).Methods("GET")                    // Card + description
+	authRoutes.HandleFunc("/card/{id}/comments", aggHandler.GetComments).Methods("GET")       // Comments, ?limit=&offset= to page
+	authRoutes.HandleFunc("/board/{id}/activity", aggHandler.GetBoardActivity).Methods("GET") // Activity log, newest first, paged
+	authRoutes.HandleFunc("/card/{id}/activity", aggHandler.GetCardActivity).Methods("GET")
+	authRoutes.HandleFunc("/cards/overdue", aggHandler.GetOverdueCards).Methods("GET")   // Overdue cards of all boards
+	authRoutes.HandleFunc("/cards/due", aggHandler.GetDueCards).Methods("GET")           // Cards due within ?days=
+	authRoutes.HandleFunc("/cards/assigned", aggHandler.GetAssignedCards).Methods("GET") // Cards assigned to the caller
+
+	authRoutes.HandleFunc("/board/{id}/events", aggHandler.StreamBoardEvents).Methods("GET") // Live changes as server-sent events, resumed with Last-Event-ID
+
+	authRoutes.HandleFunc("/card/{id}/revisions", aggHandler.GetCardRevisions).Methods("GET")            // Title and description history, newest first, paged
+	authRoutes.HandleFunc("/card/{id}/revisions/diff", aggHandler.DiffCardRevisions).Methods("GET")      // Line diff of ?from= and ?to=
+	authRoutes.HandleFunc("/card/{id}/revisions/{number}/revert", aggHandler.RevertCard).Methods("POST") // New revision from an old one
 
 	authRoutes.HandleFunc("/board", aggHandler.CreateBoard).Methods("POST")
 	authRoutes.HandleFunc("/column", aggHandler.CreateColumn).Methods("POST")
 	authRoutes.HandleFunc("/card", aggHandler.CreateCard).Methods("POST")
+	authRoutes.HandleFunc("/card/{id}/comments", aggHandler.CreateComment).Methods("POST")
 
 	authRoutes.HandleFunc("/board", aggHandler.UpdateBoard).Methods("PUT")
 	authRoutes.HandleFunc("/column", aggHandler.UpdateColumn).Methods("PUT")
 	authRoutes.HandleFunc("/card", aggHandler.UpdateCard).Methods("PUT")
+	authRoutes.HandleFunc("/comment/{id}", aggHandler.UpdateComment).Methods("PUT") // Author or admin only
 
 	authRoutes.HandleFunc("/board/{id}", aggHandler.DeleteBoard).Methods("DELETE")
 	authRoutes.HandleFunc("/column/{id}", aggHandler.DeleteColumn).Methods("DELETE")
 	authRoutes.HandleFunc("/card/{id}", aggHandler.DeleteCard).Methods("DELETE")
+	authRoutes.HandleFunc("/comment/{id}", aggHandler.DeleteComment).Methods("DELETE")
+
+	authRoutes.HandleFunc("/column/{id}/position", aggHandler.RepositionColumn).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/position", aggHandler.RepositionCard).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/move", aggHandler.MoveCard).Methods("POST")
+	authRoutes.HandleFunc("/card/{id}/dates", aggHandler.SetCardDates).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/assignees/{user_id}", aggHandler.AssignCard).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/assignees/{user_id}", aggHandler.UnassignCard).Methods("DELETE")
+
+	authRoutes.HandleFunc("/board/{id}/labels", aggHandler.GetLabels).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/labels", aggHandler.CreateLabel).Methods("POST")
+	authRoutes.HandleFunc("/label/{id}", aggHandler.UpdateLabel).Methods("PUT")
+	authRoutes.HandleFunc("/label/{id}", aggHandler.DeleteLabel).Methods("DELETE")
+	authRoutes.HandleFunc("/card/{id}/labels/{label_id}", aggHandler.AttachLabel).Methods("PUT")
+	authRoutes.HandleFunc("/card/{id}/labels/{label_id}", aggHandler.DetachLabel).Methods("DELETE")
+
+	authRoutes.HandleFunc("/card/{id}/attachments", aggHandler.UploadAttachment).Methods("POST")
+	authRoutes.HandleFunc("/attachment/{id}", aggHandler.GetAttachment).Methods("GET")
+	authRoutes.HandleFunc("/attachment/{id}", aggHandler.DeleteAttachment).Methods("DELETE")
+
+	authRoutes.HandleFunc("/card/{id}/checklists", aggHandler.GetChecklists).Methods("GET")
+	authRoutes.HandleFunc("/card/{id}/checklists", aggHandler.CreateChecklist).Methods("POST")
+	authRoutes.HandleFunc("/checklist/{id}/items", aggHandler.CreateChecklistItem).Methods("POST")
+	authRoutes.HandleFunc("/checklist-item/{id}/done", aggHandler.SetChecklistItemDone).Methods("PUT")
+
+	authRoutes.HandleFunc("/board/{id}/public", aggHandler.SetBoardPublic).Methods("PUT")
+	authRoutes.HandleFunc("/board/{id}/share", aggHandler.CreateShareToken).Methods("POST")
+	authRoutes.HandleFunc("/board/{id}/share", aggHandler.GetShareTokens).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/share/{token}", aggHandler.RevokeShareToken).Methods("DELETE")
+
+	authRoutes.HandleFunc("/board/{id}/members", aggHandler.GetBoardMembers).Methods("GET")
+	authRoutes.HandleFunc("/board/{id}/members", aggHandler.InviteMember).Methods("POST")
+	authRoutes.HandleFunc("/board/{id}/members/{user_id}", aggHandler.UpdateMemberRole).Methods("PUT")
+	authRoutes.HandleFunc("/board/{id}/members/{user_id}", aggHandler.RemoveMember).Methods("DELETE")
+	authRoutes.HandleFunc("/board/{id}/accept", aggHandler.AcceptInvitation).Methods("POST")
+	authRoutes.HandleFunc("/invitations", aggHandler.GetInvitations).Methods("GET")
+
+	authRoutes.HandleFunc("/trash", aggHandler.GetTrash).Methods("GET") // Deleted items of the caller, paged
+	authRoutes.HandleFunc("/trash/{id}/restore", aggHandler.RestoreItem).Methods("POST")
+
+	authRoutes.HandleFunc("/board/{id}/archive", aggHandler.ArchiveBoard).Methods("POST")
+	authRoutes.HandleFunc("/board/{id}/unarchive", aggHandler.UnarchiveBoard).Methods("POST")
+	authRoutes.HandleFunc("/column/{id}/archive", aggHandler.ArchiveColumn).Methods("POST")
+	authRoutes.HandleFunc("/column/{id}/unarchive", aggHandler.UnarchiveColumn).Methods("POST")
+	authRoutes.HandleFunc("/column/{id}/wip-limit", aggHandler.SetColumnWIPLimit).Methods("PUT") // Strict or soft cap on the cards of the column
+
+	authRoutes.HandleFunc("/board/{id}/clone", aggHandler.CloneBoard).Methods("POST") // Copy, optionally as a template
+	authRoutes.HandleFunc("/templates", aggHandler.GetTemplates).Methods("GET")       // Templates of the caller
+
+	authRoutes.HandleFunc("/board/{id}/export", aggHandler.ExportBoard).Methods("GET")           // Versioned JSON document of the board
+	authRoutes.HandleFunc("/boards/import", aggHandler.ImportBoard).Methods("POST")              // New board of the caller from an export
+	authRoutes.HandleFunc("/boards/import/trello", aggHandler.ImportTrelloBoard).Methods("POST") // Trello board JSON, ?dry_run=true only reports
+
+	authRoutes.HandleFunc("/search", aggHandler.SearchCards).Methods("GET") // ?q= with optional &board_id=&from=&to= and paging
+
+	authRoutes.HandleFunc("/batch", aggHandler.RunBatch).Methods("POST") // Creates, updates, moves and deletes in one transaction
 
 	authRoutes.HandleFunc("/stats/{from}/{to}", aggHandler.GetStats).Methods("GET")
 	authRoutes.HandleFunc("/stats/{from}", aggHandler.GetStats).Methods("GET")
@@ -0,0 +1,587 @@
+package v1_test
+
+import (
+	api "aggregator/internal/api/v1"
+	"aggregator/internal/dto"
+	"aggregator/internal/entity"
+	handler "aggregator/internal/handler/v1"
+	"aggregator/internal/middleware"
+	"aggregator/internal/service/todo"
+	"aggregator/mocks"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userID  = uuid.New()
+	adminID = uuid.New()
+)
+
+const (
+	userToken  = "user-token"
+	adminToken = "admin-token"
+	// heartbeat is short for the heartbeat of event streams to show up
+	heartbeat = 20 * time.Millisecond
+)
+
+func newRouter(uc *mocks.AggregatorUseCase) *mux.Router {
+	authSvc := new(mocks.AuthService)
+	authSvc.On("ValidateToken", mock.Anything, userToken).Return(&dto.ValidateTokenResponse{UserID: userID.String(), Role: "user"}, nil)
+	authSvc.On("ValidateToken", mock.Anything, adminToken).Return(&dto.ValidateTokenResponse{UserID: adminID.String(), Role: "admin"}, nil)
+	authSvc.On("ValidateToken", mock.Anything, mock.Anything).Return(nil, errors.New("invalid token"))
+
+	router := mux.NewRouter()
+	api.InitializeV1Routes(router, handler.NewAggregatorHandler(uc, heartbeat), middleware.NewAuthMiddleware(authSvc))
+
+	return router
+}
+
+func do(router *mux.Router, method, path string, body any, token string) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+
+	req := httptest.NewRequest(method, path, &buf)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// callerIs matches the context the aggregator passes down to the todo service
+func callerIs(id uuid.UUID) any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		callerID, ok := middleware.GetUserIDFromContext(ctx)
+		return ok && callerID == id.String()
+	})
+}
+
+type todoRoute struct {
+	name   string
+	method string
+	path   string
+	body   any
+	token  string
+	caller uuid.UUID
+	ok     int
+	// ucMethod and argc describe the usecase call behind the route
+	ucMethod string
+	argc     int
+	// returns builds the usecase results for the given error
+	returns func(err error) []any
+}
+
+func errOnly(err error) []any { return []any{err} }
+
+func withNil(value any) func(err error) []any {
+	return func(err error) []any {
+		if err != nil {
+			return []any{nil, err}
+		}
+		return []any{value, nil}
+	}
+}
+
+// closedEvents is an event stream that ends right away
+func closedEvents() <-chan dto.BoardEvent {
+	events := make(chan dto.BoardEvent)
+	close(events)
+	return events
+}
+
+func todoRoutes() []todoRoute {
+	id := uuid.New().String()
+
+	return []todoRoute{
+		{"GetBoards", http.MethodGet, "/api/v1/boards", nil, userToken, userID, http.StatusOK, "GetBoards", 2, withNil([]dto.Board{})},
+		{"GetBoards archived", http.MethodGet, "/api/v1/boards?archived=true", nil, userToken, userID, http.StatusOK, "GetBoards", 2, withNil([]dto.Board{})},
+		{"GetBoard", http.MethodGet, "/api/v1/board/" + id, nil, userToken, userID, http.StatusOK, "GetBoard", 2, withNil(&dto.BoardView{})},
+		{"GetColumn", http.MethodGet, "/api/v1/column/" + id, nil, userToken, userID, http.StatusOK, "GetCards", 2, withNil([]dto.Card{})},
+		{"GetColumn by label", http.MethodGet, "/api/v1/column/" + id + "?label_id=" + id, nil, userToken, userID, http.StatusOK, "GetCards", 2, withNil([]dto.Card{})},
+		{"GetBoards page", http.MethodGet, "/api/v1/boards?cursor=&limit=10", nil, userToken, userID, http.StatusOK, "GetBoardsPage", 3, withNil(&dto.BoardPage{})},
+		{"GetBoard page", http.MethodGet, "/api/v1/board/" + id + "?cursor=abc", nil, userToken, userID, http.StatusOK, "GetBoardPage", 3, withNil(&dto.BoardView{})},
+		{"GetColumn page", http.MethodGet, "/api/v1/column/" + id + "?cursor=abc&limit=5", nil, userToken, userID, http.StatusOK, "GetCardsPage", 3, withNil(&dto.CardPage{})},
+		{"GetCard", http.MethodGet, "/api/v1/card/" + id, nil, userToken, userID, http.StatusOK, "GetCard", 1, withNil(&dto.Card{})},
+		{"GetComments", http.MethodGet, "/api/v1/card/" + id + "/comments?limit=20&offset=40", nil, userToken, userID, http.StatusOK, "GetComments", 3, withNil([]dto.Comment{})},
+		{"GetBoardActivity", http.MethodGet, "/api/v1/board/" + id + "/activity?limit=20", nil, userToken, userID, http.StatusOK, "GetBoardActivity", 3, withNil([]dto.Activity{})},
+		{"GetCardActivity", http.MethodGet, "/api/v1/card/" + id + "/activity", nil, userToken, userID, http.StatusOK, "GetCardActivity", 3, withNil([]dto.Activity{})},
+		{"StreamBoardEvents", http.MethodGet, "/api/v1/board/" + id + "/events", nil, userToken, userID, http.StatusOK, "StreamBoardEvents", 2, withNil(closedEvents())},
+		{"GetCardRevisions", http.MethodGet, "/api/v1/card/" + id + "/revisions?limit=5", nil, userToken, userID, http.StatusOK, "GetCardRevisions", 3, withNil([]dto.CardRevision{})},
+		{"DiffCardRevisions", http.MethodGet, "/api/v1/card/" + id + "/revisions/diff?from=1&to=2", nil, userToken, userID, http.StatusOK, "DiffCardRevisions", 3, withNil(&dto.RevisionDiff{})},
+		{"RevertCard", http.MethodPost, "/api/v1/card/" + id + "/revisions/1/revert", nil, userToken, userID, http.StatusOK, "RevertCard", 2, withNil(&dto.Card{})},
+		{"CreateBoard", http.MethodPost, "/api/v1/board", dto.CreateBoardRequest{Title: "Title"}, userToken, userID, http.StatusCreated, "CreateBoard", 1, errOnly},
+		{"CreateColumn", http.MethodPost, "/api/v1/column", dto.CreateColumnRequest{Title: "Title"}, userToken, userID, http.StatusCreated, "CreateColumn", 1, errOnly},
+		{"CreateCard", http.MethodPost, "/api/v1/card", dto.CreateCardRequest{Title: "Title"}, userToken, userID, http.StatusCreated, "CreateCard", 1, errOnly},
+		{"CreateComment", http.MethodPost, "/api/v1/card/" + id + "/comments", dto.CreateCommentRequest{Body: "Looks good to me"}, userToken, userID, http.StatusCreated, "CreateComment", 2, withNil(&dto.Comment{})},
+		{"UpdateBoard", http.MethodPut, "/api/v1/board", dto.UpdateBoardRequest{}, userToken, userID, http.StatusOK, "UpdateBoard", 1, errOnly},
+		{"UpdateColumn", http.MethodPut, "/api/v1/column", dto.UpdateColumnRequest{}, userToken, userID, http.StatusOK, "UpdateColumn", 1, errOnly},
+		{"UpdateCard", http.MethodPut, "/api/v1/card", dto.UpdateCardRequest{}, userToken, userID, http.StatusOK, "UpdateCard", 1, errOnly},
+		{"DeleteBoard", http.MethodDelete, "/api/v1/board/" + id, nil, userToken, userID, http.StatusOK, "DeleteBoard", 1, errOnly},
+		{"DeleteColumn", http.MethodDelete, "/api/v1/column/" + id, nil, userToken, userID, http.StatusOK, "DeleteColumn", 1, errOnly},
+		{"DeleteCard", http.MethodDelete, "/api/v1/card/" + id, nil, userToken, userID, http.StatusOK, "DeleteCard", 1, errOnly},
+		{"UpdateComment", http.MethodPut, "/api/v1/comment/" + id, dto.UpdateCommentRequest{Body: "Fixed a typo"}, userToken, userID, http.StatusOK, "UpdateComment", 2, withNil(&dto.Comment{})},
+		{"DeleteComment", http.MethodDelete, "/api/v1/comment/" + id, nil, userToken, userID, http.StatusOK, "DeleteComment", 1, errOnly},
+		{"RepositionColumn", http.MethodPut, "/api/v1/column/" + id + "/position", dto.RepositionRequest{AfterID: uuid.New()}, userToken, userID, http.StatusOK, "RepositionColumn", 2, withNil(&dto.Column{})},
+		{"RepositionCard", http.MethodPut, "/api/v1/card/" + id + "/position", dto.RepositionRequest{BeforeID: uuid.New()}, userToken, userID, http.StatusOK, "RepositionCard", 2, withNil(&dto.Card{})},
+		{"MoveCard", http.MethodPost, "/api/v1/card/" + id + "/move", dto.MoveCardRequest{ColumnID: uuid.New()}, userToken, userID, http.StatusOK, "MoveCard", 2, withNil(&dto.Card{})},
+		{"SetCardDates", http.MethodPut, "/api/v1/card/" + id + "/dates", dto.SetCardDatesRequest{}, userToken, userID, http.StatusOK, "SetCardDates", 2, withNil(&dto.Card{})},
+		{"GetOverdueCards", http.MethodGet, "/api/v1/cards/overdue", nil, userToken, userID, http.StatusOK, "GetOverdueCards", 1, withNil([]dto.Card{})},
+		{"GetDueCards", http.MethodGet, "/api/v1/cards/due?days=7", nil, userToken, userID, http.StatusOK, "GetDueCards", 2, withNil([]dto.Card{})},
+		{"GetAssignedCards", http.MethodGet, "/api/v1/cards/assigned", nil, userToken, userID, http.StatusOK, "GetAssignedCards", 1, withNil([]dto.Card{})},
+		{"AssignCard", http.MethodPut, "/api/v1/card/" + id + "/assignees/" + id, nil, userToken, userID, http.StatusOK, "AssignCard", 2, errOnly},
+		{"UnassignCard", http.MethodDelete, "/api/v1/card/" + id + "/assignees/" + id, nil, userToken, userID, http.StatusOK, "UnassignCard", 2, errOnly},
+		{"CreateLabel", http.MethodPost, "/api/v1/board/" + id + "/labels", dto.LabelRequest{Name: "Bug", Color: "#d73a4a"}, userToken, userID, http.StatusCreated, "CreateLabel", 2, withNil(&dto.Label{})},
+		{"GetLabels", http.MethodGet, "/api/v1/board/" + id + "/labels", nil, userToken, userID, http.StatusOK, "GetLabels", 1, withNil([]dto.Label{})},
+		{"UpdateLabel", http.MethodPut, "/api/v1/label/" + id, dto.LabelRequest{Name: "Bug", Color: "#d73a4a"}, userToken, userID, http.StatusOK, "UpdateLabel", 2, withNil(&dto.Label{})},
+		{"DeleteLabel", http.MethodDelete, "/api/v1/label/" + id, nil, userToken, userID, http.StatusOK, "DeleteLabel", 1, errOnly},
+		{"AttachLabel", http.MethodPut, "/api/v1/card/" + id + "/labels/" + id, nil, userToken, userID, http.StatusOK, "AttachLabel", 2, errOnly},
+		{"DetachLabel", http.MethodDelete, "/api/v1/card/" + id + "/labels/" + id, nil, userToken, userID, http.StatusOK, "DetachLabel", 2, errOnly},
+		{"UploadAttachment", http.MethodPost, "/api/v1/card/" + id + "/attachments?filename=log.txt", nil, userToken, userID, http.StatusCreated, "UploadAttachment", 4, withNil(&dto.Attachment{})},
+		{"GetAttachment", http.MethodGet, "/api/v1/attachment/" + id, nil, userToken, userID, http.StatusOK, "GetAttachment", 1, withNil(&dto.AttachmentContent{Body: io.NopCloser(strings.NewReader(""))})},
+		{"DeleteAttachment", http.MethodDelete, "/api/v1/attachment/" + id, nil, userToken, userID, http.StatusOK, "DeleteAttachment", 1, errOnly},
+		{"GetChecklists", http.MethodGet, "/api/v1/card/" + id + "/checklists", nil, userToken, userID, http.StatusOK, "GetChecklists", 1, withNil([]dto.Checklist{})},
+		{"CreateChecklist", http.MethodPost, "/api/v1/card/" + id + "/checklists", dto.CreateChecklistRequest{Title: "Release"}, userToken, userID, http.StatusCreated, "CreateChecklist", 2, withNil(&dto.Checklist{})},
+		{"CreateChecklistItem", http.MethodPost, "/api/v1/checklist/" + id + "/items", dto.CreateChecklistItemRequest{Text: "Tag"}, userToken, userID, http.StatusCreated, "CreateChecklistItem", 2, withNil(&dto.ChecklistItem{})},
+		{"SetChecklistItemDone", http.MethodPut, "/api/v1/checklist-item/" + id + "/done", dto.SetChecklistItemDoneRequest{Done: true}, userToken, userID, http.StatusOK, "SetChecklistItemDone", 2, withNil(&dto.ChecklistItem{})},
+		{"SetBoardPublic", http.MethodPut, "/api/v1/board/" + id + "/public", dto.SetBoardPublicRequest{IsPublic: true}, userToken, userID, http.StatusOK, "SetBoardPublic", 2, errOnly},
+		{"CreateShareToken", http.MethodPost, "/api/v1/board/" + id + "/share", nil, userToken, userID, http.StatusCreated, "CreateShareToken", 1, withNil(&dto.ShareToken{})},
+		{"GetShareTokens", http.MethodGet, "/api/v1/board/" + id + "/share", nil, userToken, userID, http.StatusOK, "GetShareTokens", 1, withNil([]dto.ShareToken{})},
+		{"RevokeShareToken", http.MethodDelete, "/api/v1/board/" + id + "/share/token", nil, userToken, userID, http.StatusOK, "RevokeShareToken", 2, errOnly},
+		{"GetBoardMembers", http.MethodGet, "/api/v1/board/" + id + "/members", nil, userToken, userID, http.StatusOK, "GetBoardMembers", 1, withNil([]dto.BoardMember{})},
+		{"InviteMember", http.MethodPost, "/api/v1/board/" + id + "/members", dto.InviteMemberRequest{UserID: uuid.New(), Role: "editor"}, userToken, userID, http.StatusCreated, "InviteMember", 2, withNil(&dto.BoardMember{})},
+		{"UpdateMemberRole", http.MethodPut, "/api/v1/board/" + id + "/members/" + id, dto.UpdateMemberRoleRequest{Role: "viewer"}, userToken, userID, http.StatusOK, "UpdateMemberRole", 3, withNil(&dto.BoardMember{})},
+		{"RemoveMember", http.MethodDelete, "/api/v1/board/" + id + "/members/" + id, nil, userToken, userID, http.StatusOK, "RemoveMember", 2, errOnly},
+		{"AcceptInvitation", http.MethodPost, "/api/v1/board/" + id + "/accept", nil, userToken, userID, http.StatusOK, "AcceptInvitation", 1, withNil(&dto.BoardMember{})},
+		{"GetInvitations", http.MethodGet, "/api/v1/invitations", nil, userToken, userID, http.StatusOK, "GetInvitations", 1, withNil([]dto.BoardMember{})},
+		{"GetTrash", http.MethodGet, "/api/v1/trash?limit=20", nil, userToken, userID, http.StatusOK, "GetTrash", 3, withNil([]dto.TrashItem{})},
+		{"RestoreItem", http.MethodPost, "/api/v1/trash/" + id + "/restore", nil, userToken, userID, http.StatusOK, "RestoreItem", 1, withNil(&dto.TrashItem{})},
+		{"ArchiveBoard", http.MethodPost, "/api/v1/board/" + id + "/archive", nil, userToken, userID, http.StatusOK, "ArchiveBoard", 1, withNil(&dto.Board{})},
+		{"UnarchiveBoard", http.MethodPost, "/api/v1/board/" + id + "/unarchive", nil, userToken, userID, http.StatusOK, "UnarchiveBoard", 1, withNil(&dto.Board{})},
+		{"ArchiveColumn", http.MethodPost, "/api/v1/column/" + id + "/archive", nil, userToken, userID, http.StatusOK, "ArchiveColumn", 1, withNil(&dto.Column{})},
+		{"SetColumnWIPLimit", http.MethodPut, "/api/v1/column/" + id + "/wip-limit", dto.SetColumnWIPLimitRequest{Limit: 3, Strict: true}, userToken, userID, http.StatusOK, "SetColumnWIPLimit", 2, withNil(&dto.Column{})},
+		{"UnarchiveColumn", http.MethodPost, "/api/v1/column/" + id + "/unarchive", nil, userToken, userID, http.StatusOK, "UnarchiveColumn", 1, withNil(&dto.Column{})},
+		{"CloneBoard", http.MethodPost, "/api/v1/board/" + id + "/clone", dto.CloneBoardRequest{Template: true}, userToken, userID, http.StatusCreated, "CloneBoard", 2, withNil(&dto.Board{})},
+		{"CloneBoard without body", http.MethodPost, "/api/v1/board/" + id + "/clone", nil, userToken, userID, http.StatusCreated, "CloneBoard", 2, withNil(&dto.Board{})},
+		{"GetTemplates", http.MethodGet, "/api/v1/templates", nil, userToken, userID, http.StatusOK, "GetTemplates", 1, withNil([]dto.Board{})},
+		{"ExportBoard", http.MethodGet, "/api/v1/board/" + id + "/export", nil, userToken, userID, http.StatusOK, "ExportBoard", 1, withNil(json.RawMessage(`{"version":1}`))},
+		{"ImportBoard", http.MethodPost, "/api/v1/boards/import", map[string]any{"version": 1}, userToken, userID, http.StatusCreated, "ImportBoard", 1, withNil(&dto.Board{})},
+		{"ImportTrelloBoard", http.MethodPost, "/api/v1/boards/import/trello", map[string]any{"name": "Roadmap"}, userToken, userID, http.StatusCreated, "ImportTrelloBoard", 2, withNil(&dto.ImportSummary{})},
+		{"ImportTrelloBoard dry run", http.MethodPost, "/api/v1/boards/import/trello?dry_run=true", map[string]any{"name": "Roadmap"}, userToken, userID, http.StatusOK, "ImportTrelloBoard", 2, withNil(&dto.ImportSummary{DryRun: true})},
+		{"SearchCards", http.MethodGet, "/api/v1/search?q=notes&from=01-01-2025", nil, userToken, userID, http.StatusOK, "SearchCards", 4, withNil([]dto.CardMatch{})},
+		{"RunBatch", http.MethodPost, "/api/v1/batch", dto.BatchRequest{Operations: []dto.BatchOperation{{Op: "delete", Type: "card", ID: uuid.New()}}}, userToken, userID, http.StatusOK, "RunBatch", 1, withNil(&dto.BatchResponse{})},
+		{"GetStats", http.MethodGet, "/api/v1/stats", nil, adminToken, adminID, http.StatusOK, "GetStats", 2, withNil([]entity.NewUsersAndCardsStats{})},
+		{"GetStats from", http.MethodGet, "/api/v1/stats/01-01-2024", nil, adminToken, adminID, http.StatusOK, "GetStats", 2, withNil([]entity.NewUsersAndCardsStats{})},
+		{"GetStats from to", http.MethodGet, "/api/v1/stats/01-01-2024/01-01-2025", nil, adminToken, adminID, http.StatusOK, "GetStats", 2, withNil([]entity.NewUsersAndCardsStats{})},
+	}
+}
+
+func TestTodoRoutes(t *testing.T) {
+	cases := []struct {
+		name  string
+		err   error
+		ok    bool
+		wantS int
+	}{
+		{name: "success", ok: true},
+		{name: "forbidden", err: todo.ErrForbidden, wantS: http.StatusForbidden},
+		{name: "not found", err: todo.ErrNotFound, wantS: http.StatusNotFound},
+		{name: "bad request", err: todo.ErrBadRequest, wantS: http.StatusBadRequest},
+		{name: "other error", err: errors.New("failed"), wantS: http.StatusConflict},
+	}
+
+	for _, r := range todoRoutes() {
+		t.Run(r.name, func(t *testing.T) {
+			for _, c := range cases {
+				t.Run(c.name, func(t *testing.T) {
+					uc := new(mocks.AggregatorUseCase)
+
+					args := []any{callerIs(r.caller)}
+					for i := 0; i < r.argc; i++ {
+						args = append(args, mock.Anything)
+					}
+					uc.On(r.ucMethod, args...).Return(r.returns(c.err)...)
+
+					rec := do(newRouter(uc), r.method, r.path, r.body, r.token)
+
+					want := c.wantS
+					if c.ok {
+						want = r.ok
+					}
+					assert.Equal(t, want, rec.Code, rec.Body.String())
+					uc.AssertExpectations(t)
+				})
+			}
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				uc := new(mocks.AggregatorUseCase)
+
+				rec := do(newRouter(uc), r.method, r.path, r.body, "")
+				assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+				rec = do(newRouter(uc), r.method, r.path, r.body, "invalid")
+				assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+				uc.AssertNotCalled(t, r.ucMethod)
+			})
+		})
+	}
+}
+
+func TestInvalidFlags(t *testing.T) {
+	id := uuid.New().String()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"GetBoards archived", "/api/v1/boards?archived=yes"},
+		{"GetBoard archived", "/api/v1/board/" + id + "?archived=yes"},
+		{"GetBoard page archived", "/api/v1/board/" + id + "?archived=&cursor="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := new(mocks.AggregatorUseCase)
+
+			rec := do(newRouter(uc), http.MethodGet, tt.path, nil, userToken)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStatsRequiresAdmin(t *testing.T) {
+	uc := new(mocks.AggregatorUseCase)
+
+	rec := do(newRouter(uc), http.MethodGet, "/api/v1/stats", nil, userToken)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	uc.AssertNotCalled(t, "GetStats")
+}
+
+func TestAuthRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     any
+		ucMethod string
+		argc     int
+		returns  func(err error) []any
+		ok       int
+		fail     int
+	}{
+		{"Register", "/api/v1/register", dto.RegisterRequest{}, "Register", 3, withNil(&dto.Tokens{}), http.StatusCreated, http.StatusUnauthorized},
+		{"Login", "/api/v1/login", dto.LoginRequest{}, "Login", 2, withNil(&dto.Tokens{}), http.StatusOK, http.StatusUnauthorized},
+		{"Refresh", "/api/v1/refresh", dto.RefreshRequest{}, "Refresh", 1, withNil(&dto.RefreshResponse{}), http.StatusOK, http.StatusUnauthorized},
+		{"Validate", "/api/v1/validate", dto.ValidateTokenRequest{}, "Validate", 1, withNil(&dto.ValidateTokenResponse{}), http.StatusOK, http.StatusUnauthorized},
+		{"Logout", "/api/v1/logout", dto.LogoutRequest{}, "Logout", 1, errOnly, http.StatusOK, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, err := range []error{nil, errors.New("failed")} {
+				uc := new(mocks.AggregatorUseCase)
+
+				args := []any{mock.Anything}
+				for i := 0; i < tt.argc; i++ {
+					args = append(args, mock.Anything)
+				}
+				uc.On(tt.ucMethod, args...).Return(tt.returns(err)...)
+
+				rec := do(newRouter(uc), http.MethodPost, tt.path, tt.body, "")
+
+				want := tt.ok
+				if err != nil {
+					want = tt.fail
+				}
+				assert.Equal(t, want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestSharedRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		ucMethod string
+		argc     int
+		returns  func(err error) []any
+		err      error
+		want     int
+	}{
+		{"GetSharedBoard", http.MethodGet, "/api/v1/shared/token", "GetSharedBoard", 1, withNil(&dto.BoardTree{}), nil, http.StatusOK},
+		{"GetSharedBoard revoked", http.MethodGet, "/api/v1/shared/token", "GetSharedBoard", 1, withNil(&dto.BoardTree{}), todo.ErrNotFound, http.StatusNotFound},
+		{"GetSharedCard", http.MethodGet, "/api/v1/shared/token/card/" + uuid.New().String(), "GetSharedCard", 2, withNil(&dto.Card{}), nil, http.StatusOK},
+		{"GetSharedCard not on board", http.MethodGet, "/api/v1/shared/token/card/" + uuid.New().String(), "GetSharedCard", 2, withNil(&dto.Card{}), todo.ErrNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := new(mocks.AggregatorUseCase)
+
+			args := []any{mock.Anything}
+			for i := 0; i < tt.argc; i++ {
+				args = append(args, mock.Anything)
+			}
+			uc.On(tt.ucMethod, args...).Return(tt.returns(tt.err)...)
+
+			rec := do(newRouter(uc), tt.method, tt.path, nil, "")
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		t.Run("reject "+method, func(t *testing.T) {
+			rec := do(newRouter(new(mocks.AggregatorUseCase)), method, "/api/v1/shared/token", nil, userToken)
+			assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		})
+	}
+}
+
+func TestAttachmentRoutes(t *testing.T) {
+	cardID, attachmentID := uuid.New().String(), uuid.New().String()
+
+	t.Run("upload streams the body", func(t *testing.T) {
+		uc := new(mocks.AggregatorUseCase)
+
+		var got []byte
+		uc.On("UploadAttachment", callerIs(userID), cardID, "report.pdf", int64(9), mock.Anything).
+			Run(func(args mock.Arguments) {
+				got, _ = io.ReadAll(args.Get(4).(io.Reader))
+			}).
+			Return(&dto.Attachment{Filename: "report.pdf", Size: 9}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/card/"+cardID+"/attachments?filename=report.pdf", strings.NewReader("%PDF-1.7\n"))
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		rec := httptest.NewRecorder()
+		newRouter(uc).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		assert.Equal(t, "%PDF-1.7\n", string(got))
+	})
+
+	t.Run("upload refused", func(t *testing.T) {
+		for err, want := range map[error]int{
+			todo.ErrTooLarge:       http.StatusRequestEntityTooLarge,
+			todo.ErrLengthRequired: http.StatusLengthRequired,
+		} {
+			uc := new(mocks.AggregatorUseCase)
+			uc.On("UploadAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, err)
+
+			rec := do(newRouter(uc), http.MethodPost, "/api/v1/card/"+cardID+"/attachments?filename=big.bin", nil, userToken)
+			assert.Equal(t, want, rec.Code)
+		}
+	})
+
+	t.Run("download", func(t *testing.T) {
+		uc := new(mocks.AggregatorUseCase)
+		uc.On("GetAttachment", callerIs(userID), attachmentID).Return(&dto.AttachmentContent{
+			ContentType:        "text/plain; charset=utf-8",
+			ContentDisposition: `attachment; filename="log.txt"`,
+			Size:               8,
+			Body:               io.NopCloser(strings.NewReader("Log line")),
+		}, nil)
+
+		rec := do(newRouter(uc), http.MethodGet, "/api/v1/attachment/"+attachmentID, nil, userToken)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "Log line", rec.Body.String())
+		assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="log.txt"`, rec.Header().Get("Content-Disposition"))
+		assert.Equal(t, "8", rec.Header().Get("Content-Length"))
+		assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	})
+}
+
+func TestUpdateVersions(t *testing.T) {
+	cardID := uuid.New()
+
+	// updateCard answers like the todo service with the card at version 4
+	// and keeps the version the update was sent with
+	updateCard := func(uc *mocks.AggregatorUseCase, sent *int) {
+		uc.On("UpdateCard", callerIs(userID), mock.Anything).
+			Run(func(args mock.Arguments) {
+				card := args.Get(1).(*dto.Card)
+				*sent = card.Version
+				if card.Version != 0 && card.Version != 4 {
+					*card = dto.Card{ID: card.ID, Title: "Theirs", Version: 4}
+					return
+				}
+				card.Version = 5
+			}).
+			Return(func(ctx context.Context, card *dto.Card) error {
+				if card.Title == "Theirs" {
+					return todo.ErrConflict
+				}
+				return nil
+			})
+	}
+
+	tests := []struct {
+		name     string
+		version  int
+		ifMatch  string
+		status   int
+		etag     string
+		sentWith int
+	}{
+		{name: "current version in the body", version: 4, status: http.StatusOK, etag: `"5"`, sentWith: 4},
+		{name: "current version in If-Match", ifMatch: `"4"`, status: http.StatusOK, etag: `"5"`, sentWith: 4},
+		{name: "no version", status: http.StatusOK, etag: `"5"`},
+		{name: "If-Match over the body", version: 3, ifMatch: `"4"`, status: http.StatusOK, etag: `"5"`, sentWith: 4},
+		{name: "stale version in the body", version: 3, status: http.StatusConflict, etag: `"4"`, sentWith: 3},
+		{name: "stale version in If-Match", ifMatch: `"3"`, status: http.StatusPreconditionFailed, etag: `"4"`, sentWith: 3},
+		{name: "broken If-Match", ifMatch: "4", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := new(mocks.AggregatorUseCase)
+			sent := -1
+			updateCard(uc, &sent)
+
+			var buf bytes.Buffer
+			json.NewEncoder(&buf).Encode(dto.UpdateCardRequest{ID: cardID, CreateCardRequest: dto.CreateCardRequest{Title: "Ours"}, Version: tt.version})
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/card", &buf)
+			req.Header.Set("Authorization", "Bearer "+userToken)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			newRouter(uc).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+			assert.Equal(t, tt.etag, rec.Header().Get("ETag"))
+
+			if tt.status == http.StatusBadRequest {
+				uc.AssertNotCalled(t, "UpdateCard", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.Equal(t, tt.sentWith, sent)
+
+			if tt.status != http.StatusOK {
+				// The current state comes back to merge the change into
+				var card dto.Card
+				json.NewDecoder(rec.Body).Decode(&card)
+				assert.Equal(t, "Theirs", card.Title)
+				assert.Equal(t, 4, card.Version)
+			}
+		})
+	}
+
+	t.Run("get card tags the version", func(t *testing.T) {
+		uc := new(mocks.AggregatorUseCase)
+		uc.On("GetCard", callerIs(userID), cardID.String()).Return(&dto.Card{ID: cardID, Version: 4}, nil)
+
+		rec := do(newRouter(uc), http.MethodGet, "/api/v1/card/"+cardID.String(), nil, userToken)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+	})
+}
+
+func TestRunBatchOwner(t *testing.T) {
+	uc := new(mocks.AggregatorUseCase)
+
+	var sent dto.BatchRequest
+	uc.On("RunBatch", callerIs(userID), mock.Anything).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(dto.BatchRequest) }).
+		Return(&dto.BatchResponse{Mode: "best_effort"}, nil)
+
+	other := uuid.New()
+	rec := do(newRouter(uc), http.MethodPost, "/api/v1/batch", dto.BatchRequest{
+		Mode: "best_effort",
+		Operations: []dto.BatchOperation{
+			{Op: "create", Type: "card", UserID: other, ColumnID: uuid.New(), Title: "New"},
+			{Op: "update", Type: "card", ID: uuid.New(), Title: "Renamed"},
+		},
+	}, userToken)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "best_effort", sent.Mode)
+
+	// Whatever the body says, the caller owns what the batch creates
+	assert.Equal(t, userID, sent.Operations[0].UserID)
+	assert.Equal(t, uuid.Nil, sent.Operations[1].UserID)
+}
+
+func TestBoardEventStream(t *testing.T) {
+	boardID := uuid.New().String()
+
+	t.Run("events", func(t *testing.T) {
+		change := &dto.Activity{ID: uuid.New(), TargetType: "card", Action: "create"}
+		events := make(chan dto.BoardEvent, 2)
+		events <- dto.BoardEvent{ID: "e-2", Type: "card.create", Change: change}
+		events <- dto.BoardEvent{ID: "e-3", Type: "reset"}
+		close(events)
+
+		uc := new(mocks.AggregatorUseCase)
+		uc.On("StreamBoardEvents", callerIs(userID), boardID, "e-1").Return((<-chan dto.BoardEvent)(events), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/board/"+boardID+"/events", nil)
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		req.Header.Set("Last-Event-ID", "e-1")
+		rec := httptest.NewRecorder()
+		newRouter(uc).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+
+		frames := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n\n"), "\n\n")
+		if assert.Len(t, frames, 2) {
+			lines := strings.Split(frames[0], "\n")
+			assert.Equal(t, []string{"id: e-2", "event: card.create"}, lines[:2])
+
+			var got dto.BoardEvent
+			json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &got)
+			assert.Equal(t, change.ID, got.Change.ID)
+
+			assert.True(t, strings.HasPrefix(frames[1], "id: e-3\nevent: reset\n"))
+		}
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		uc := new(mocks.AggregatorUseCase)
+		uc.On("StreamBoardEvents", mock.Anything, boardID, "").Return((<-chan dto.BoardEvent)(make(chan dto.BoardEvent)), nil)
+
+		server := httptest.NewServer(newRouter(uc))
+		defer server.Close()
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/board/"+boardID+"/events", nil)
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.Nil(t, err) {
+			return
+		}
+		defer resp.Body.Close()
+
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		assert.Nil(t, err)
+		assert.Equal(t, ": heartbeat\n", line)
+	})
+}
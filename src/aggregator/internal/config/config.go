@@ -31,6 +31,7 @@ type AggregatorConfig struct {
 	BaseURL       string    `toml:"base_url"`
 	LocalPort     int       `toml:"local_port"`
 	ExposedPort   int       `toml:"exposed_port"`
+	HeartbeatSec  int       `toml:"heartbeat_sec"`
 	Log           LogConfig `toml:"log"`
 }
 
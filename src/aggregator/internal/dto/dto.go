@@ -2,6 +2,7 @@ package dto
 
 import (
 	"aggregator/internal/entity"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,13 +32,71 @@ func ToUserEntities(userDTOs []User) []entity.User {
 }
 
 type Card struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ColumnID    uuid.UUID          `json:"column_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description,omitempty"`
+	Position    float64            `json:"position"`
+	StartDate   *time.Time         `json:"start_date,omitempty"`
+	DueDate     *time.Time         `json:"due_date,omitempty"`
+	Labels      []Label            `json:"labels,omitempty"`
+	Checklist   *ChecklistProgress `json:"checklist,omitempty"`
+	AssigneeIDs []uuid.UUID        `json:"assignee_ids,omitempty"`
+	Assignees   []Assignee         `json:"assignees,omitempty"`
+	Attachments []Attachment       `json:"attachments,omitempty"`
+	Version     int                `json:"version"`
+	CreatedAt   time.Time          `json:"created_at"`
+	// OverWIPLimit warns that the card was just created in or moved to a
+	// column that was already at its soft WIP limit
+	OverWIPLimit bool `json:"over_wip_limit,omitempty"`
+}
+
+// CardSearch is passed on to the todo service as it is; the dates are
+// DD-MM-YYYY and empty fields are left out
+type CardSearch struct {
+	Query   string
+	BoardID string
+	From    string
+	To      string
+}
+
+// CardMatch is a card found by a search with the matched words wrapped in
+// <b></b> in its headlines
+type CardMatch struct {
+	Card                Card      `json:"card"`
+	BoardID             uuid.UUID `json:"board_id"`
+	Rank                float64   `json:"rank"`
+	TitleHeadline       string    `json:"title_headline"`
+	DescriptionHeadline string    `json:"description_headline,omitempty"`
+}
+
+// ImportSummary tells what an import created, or would create on a dry run,
+// and what it had to leave out. Board is only set when a board was created.
+type ImportSummary struct {
+	Title   string        `json:"title"`
+	Board   *Board        `json:"board,omitempty"`
+	DryRun  bool          `json:"dry_run"`
+	Labels  int           `json:"labels"`
+	Columns int           `json:"columns"`
+	Cards   int           `json:"cards"`
+	Skipped ImportSkipped `json:"skipped"`
+}
+
+type ImportSkipped struct {
+	ArchivedCards int `json:"archived_cards"`
+	Attachments   int `json:"attachments"`
+	Members       int `json:"members"`
+	CardMembers   int `json:"card_members"`
+	Checklists    int `json:"checklists"`
+	Comments      int `json:"comments"`
+}
+
+// Assignee is a user assigned to a card. The username comes from the user
+// service and is empty if the user could not be looked up.
+type Assignee struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
 }
 
 func CardToEntity(cardDTO *Card) entity.Card {
@@ -58,9 +117,18 @@ func ToCardEntities(cardDTOs []Card) []entity.Card {
 }
 
 type Board struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
-	Title  string    `json:"title"`
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Title    string    `json:"title"`
+	IsPublic bool      `json:"is_public"`
+	// IsTemplate boards are kept apart from the other boards and serve as a
+	// starting point for new ones
+	IsTemplate bool `json:"is_template"`
+	// ArchivedAt is only set for archived boards
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Version goes up with every change; updates based on another version
+	// are refused
+	Version int `json:"version"`
 }
 
 type Column struct {
@@ -69,6 +137,163 @@ type Column struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	// ArchivedAt is only set for archived columns
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// WIPLimit is the most cards the column takes, zero for no limit;
+	// CardCount is the number of cards in it
+	WIPLimit  int  `json:"wip_limit,omitempty"`
+	WIPStrict bool `json:"wip_strict,omitempty"`
+	CardCount int  `json:"card_count"`
+	Version   int  `json:"version"`
+}
+
+type Label struct {
+	ID      uuid.UUID `json:"id"`
+	BoardID uuid.UUID `json:"board_id"`
+	Name    string    `json:"name"`
+	Color   string    `json:"color"`
+}
+
+type Checklist struct {
+	ID       uuid.UUID       `json:"id"`
+	CardID   uuid.UUID       `json:"card_id"`
+	Title    string          `json:"title"`
+	Position float64         `json:"position"`
+	Items    []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID `json:"id"`
+	ChecklistID uuid.UUID `json:"checklist_id"`
+	Text        string    `json:"text"`
+	Done        bool      `json:"done"`
+	Position    float64   `json:"position"`
+}
+
+// Comment is a message in the discussion thread of a card; EditedAt is only
+// set once the body has been changed
+type Comment struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	AuthorID  uuid.UUID  `json:"author_id"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
+
+// Activity is an entry of the activity log of a board. A move between
+// boards is on both of them, the destination first. Actor is the username of
+// the actor when the user service could tell it.
+type Activity struct {
+	ID         uuid.UUID     `json:"id"`
+	BoardIDs   []uuid.UUID   `json:"board_ids"`
+	TargetType string        `json:"target_type"`
+	TargetID   uuid.UUID     `json:"target_id"`
+	Action     string        `json:"action"`
+	ActorID    uuid.UUID     `json:"actor_id"`
+	Actor      string        `json:"actor,omitempty"`
+	Changes    []FieldChange `json:"changes"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// BoardEvent is a change on the live feed of a board, typed
+// "<target_type>.<action>". An event of type "reset" carries no change and
+// tells the client to reload the board, since changes were missed.
+type BoardEvent struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	BoardID uuid.UUID `json:"board_id"`
+	Change  *Activity `json:"change,omitempty"`
+}
+
+// CardRevision is a snapshot of the title and description of a card;
+// reverted_from is set on the revisions made by a revert
+type CardRevision struct {
+	ID           uuid.UUID `json:"id"`
+	CardID       uuid.UUID `json:"card_id"`
+	Number       int       `json:"number"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	Author       string    `json:"author,omitempty"`
+	RevertedFrom *int      `json:"reverted_from,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DiffLine is a line of a diff; op is one of "equal", "insert" and "delete"
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiff struct {
+	From        CardRevision `json:"from"`
+	To          CardRevision `json:"to"`
+	Title       []DiffLine   `json:"title"`
+	Description []DiffLine   `json:"description"`
+}
+
+// FieldChange is the value of a field before and after a change; a nil
+// value stands for an unset field
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// TrashItem is a deleted board, column or card that can still be restored.
+// ParentID is the board of a column or the column of a card.
+type TrashItem struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	DeletedBy uuid.UUID  `json:"deleted_by"`
+	DeletedAt time.Time  `json:"deleted_at"`
+}
+
+// Attachment is the metadata of a file uploaded to a card; ContentType is
+// sniffed by the todo service from the content
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	CardID      uuid.UUID `json:"card_id"`
+	UploaderID  uuid.UUID `json:"uploader_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AttachmentContent is a download on its way from the todo service to the
+// client. Body has to be closed by whoever ends up with it.
+type AttachmentContent struct {
+	ContentType        string
+	ContentDisposition string
+	Size               int64
+	Body               io.ReadCloser
+}
+
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+type ShareToken struct {
+	Token     string    `json:"token"`
+	BoardID   uuid.UUID `json:"board_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BoardTree struct {
+	Board
+	Columns []ColumnTree `json:"columns"`
+}
+
+type ColumnTree struct {
+	Column
+	Cards []Card `json:"cards"`
 }
 
 type RegisterRequest struct {
@@ -123,17 +348,186 @@ type CreateCardRequest struct {
 	Description string    `json:"description,omitempty"`
 }
 
+// The update requests may name the version they are based on, either in the
+// body or in an If-Match header; without one they apply to any version
 type UpdateBoardRequest struct {
 	ID uuid.UUID `json:"id"`
 	CreateBoardRequest
+	Version int `json:"version,omitempty"`
 }
 
 type UpdateColumnRequest struct {
 	ID uuid.UUID `json:"id"`
 	CreateColumnRequest
+	Version int `json:"version,omitempty"`
 }
 
 type UpdateCardRequest struct {
 	ID uuid.UUID `json:"id"`
 	CreateCardRequest
+	Version int `json:"version,omitempty"`
+}
+
+type SetBoardPublicRequest struct {
+	IsPublic bool `json:"is_public"`
+}
+
+type BoardMember struct {
+	BoardID   uuid.UUID `json:"board_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	Accepted  bool      `json:"accepted"`
+	InvitedBy uuid.UUID `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type InviteMemberRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+// RepositionRequest places a card or a column right before or right after
+// one of its siblings; exactly one of the ids should be set
+type RepositionRequest struct {
+	BeforeID uuid.UUID `json:"before_id,omitempty"`
+	AfterID  uuid.UUID `json:"after_id,omitempty"`
+}
+
+// MoveCardRequest sends a card to a column, optionally right before or right
+// after one of the cards already there; without an anchor the card goes last
+type MoveCardRequest struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	BeforeID uuid.UUID `json:"before_id,omitempty"`
+	AfterID  uuid.UUID `json:"after_id,omitempty"`
+}
+
+// SetCardDatesRequest replaces both dates of a card; a null date is cleared
+type SetCardDatesRequest struct {
+	StartDate *time.Time `json:"start_date"`
+	DueDate   *time.Time `json:"due_date"`
+}
+
+// SetColumnWIPLimitRequest replaces the WIP limit of a column; a strict limit
+// refuses cards once the column is full, a soft one only warns. A zero limit
+// lifts it.
+type SetColumnWIPLimitRequest struct {
+	Limit  int  `json:"limit"`
+	Strict bool `json:"strict"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// LabelRequest creates or updates a label of a board palette; the color is a
+// hex color like #1f6feb
+type LabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// CloneBoardRequest copies a board with its labels, columns and cards; the
+// copy keeps the title of the original unless one is given. Template marks
+// the copy as a template.
+type CloneBoardRequest struct {
+	Title        string `json:"title,omitempty"`
+	ExcludeCards bool   `json:"exclude_cards"`
+	Template     bool   `json:"template"`
+}
+
+type CreateChecklistRequest struct {
+	Title string `json:"title"`
+}
+
+type CreateChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+type SetChecklistItemDoneRequest struct {
+	Done bool `json:"done"`
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// PageRequest asks the todo service for Limit items after the opaque Cursor
+// of a previous page; an empty cursor asks for the first page and a zero
+// limit leaves the page size to the todo service
+type PageRequest struct {
+	Cursor string
+	Limit  int
+}
+
+// BoardPage, ColumnPage and CardPage are pages of a listing; NextCursor is
+// empty on the last page
+type BoardPage struct {
+	Boards     []Board `json:"boards"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+type ColumnPage struct {
+	Columns    []Column `json:"columns"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// BoardView is the board as the aggregator shows it: its columns along with
+// the label palette of the board. NextCursor is only set when the columns
+// are paged.
+type BoardView struct {
+	Columns    []Column `json:"columns"`
+	Labels     []Label  `json:"labels"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+type CardPage struct {
+	Cards      []Card `json:"cards"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// BatchRequest is passed on to the todo service; Mode is atomic, the
+// default, or best_effort
+type BatchRequest struct {
+	Mode       string           `json:"mode,omitempty"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation is one step of a batch. Op is create, update, move or
+// delete and Type is board, column or card; the other fields are those of
+// the single request doing the same. The user id of creates is the caller.
+type BatchOperation struct {
+	Op          string     `json:"op"`
+	Type        string     `json:"type"`
+	ID          uuid.UUID  `json:"id,omitempty"`
+	UserID      uuid.UUID  `json:"user_id,omitempty"`
+	BoardID     uuid.UUID  `json:"board_id,omitempty"`
+	ColumnID    uuid.UUID  `json:"column_id,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	BeforeID    uuid.UUID  `json:"before_id,omitempty"`
+	AfterID     uuid.UUID  `json:"after_id,omitempty"`
+	Version     int        `json:"version,omitempty"`
+}
+
+// BatchResult is the outcome of the operation at the same index of the
+// batch. Status is applied, failed, rolled_back or skipped; Code and Error
+// are only set on the operation that failed.
+type BatchResult struct {
+	Status  string    `json:"status"`
+	ID      uuid.UUID `json:"id,omitempty"`
+	Version int       `json:"version,omitempty"`
+	Code    int       `json:"code,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Mode    string        `json:"mode"`
+	Results []BatchResult `json:"results"`
 }
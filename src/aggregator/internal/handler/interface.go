@@ -13,11 +13,20 @@ type AggregatorHandler interface {
 	GetBoard(w http.ResponseWriter, r *http.Request)
 	GetColumn(w http.ResponseWriter, r *http.Request)
 	GetCard(w http.ResponseWriter, r *http.Request)
+	GetComments(w http.ResponseWriter, r *http.Request)
+	GetBoardActivity(w http.ResponseWriter, r *http.Request)
+	GetCardActivity(w http.ResponseWriter, r *http.Request)
+	GetCardRevisions(w http.ResponseWriter, r *http.Request)
+	DiffCardRevisions(w http.ResponseWriter, r *http.Request)
+	RevertCard(w http.ResponseWriter, r *http.Request)
 	GetStats(w http.ResponseWriter, r *http.Request)
 
 	CreateBoard(w http.ResponseWriter, r *http.Request)
 	CreateColumn(w http.ResponseWriter, r *http.Request)
 	CreateCard(w http.ResponseWriter, r *http.Request)
+	CreateComment(w http.ResponseWriter, r *http.Request)
+	UpdateComment(w http.ResponseWriter, r *http.Request)
+	DeleteComment(w http.ResponseWriter, r *http.Request)
 
 	UpdateBoard(w http.ResponseWriter, r *http.Request)
 	UpdateColumn(w http.ResponseWriter, r *http.Request)
@@ -26,4 +35,55 @@ type AggregatorHandler interface {
 	DeleteBoard(w http.ResponseWriter, r *http.Request)
 	DeleteColumn(w http.ResponseWriter, r *http.Request)
 	DeleteCard(w http.ResponseWriter, r *http.Request)
+
+	RepositionColumn(w http.ResponseWriter, r *http.Request)
+	RepositionCard(w http.ResponseWriter, r *http.Request)
+	MoveCard(w http.ResponseWriter, r *http.Request)
+
+	CreateLabel(w http.ResponseWriter, r *http.Request)
+	GetLabels(w http.ResponseWriter, r *http.Request)
+	UpdateLabel(w http.ResponseWriter, r *http.Request)
+	DeleteLabel(w http.ResponseWriter, r *http.Request)
+	AttachLabel(w http.ResponseWriter, r *http.Request)
+	DetachLabel(w http.ResponseWriter, r *http.Request)
+
+	GetChecklists(w http.ResponseWriter, r *http.Request)
+	CreateChecklist(w http.ResponseWriter, r *http.Request)
+	CreateChecklistItem(w http.ResponseWriter, r *http.Request)
+	SetChecklistItemDone(w http.ResponseWriter, r *http.Request)
+
+	SetBoardPublic(w http.ResponseWriter, r *http.Request)
+	CreateShareToken(w http.ResponseWriter, r *http.Request)
+	GetShareTokens(w http.ResponseWriter, r *http.Request)
+	RevokeShareToken(w http.ResponseWriter, r *http.Request)
+	GetSharedBoard(w http.ResponseWriter, r *http.Request)
+	GetSharedCard(w http.ResponseWriter, r *http.Request)
+	RejectSharedMutation(w http.ResponseWriter, r *http.Request)
+
+	InviteMember(w http.ResponseWriter, r *http.Request)
+	AcceptInvitation(w http.ResponseWriter, r *http.Request)
+	UpdateMemberRole(w http.ResponseWriter, r *http.Request)
+	RemoveMember(w http.ResponseWriter, r *http.Request)
+	GetBoardMembers(w http.ResponseWriter, r *http.Request)
+	GetInvitations(w http.ResponseWriter, r *http.Request)
+
+	GetTrash(w http.ResponseWriter, r *http.Request)
+	RestoreItem(w http.ResponseWriter, r *http.Request)
+
+	ArchiveBoard(w http.ResponseWriter, r *http.Request)
+	UnarchiveBoard(w http.ResponseWriter, r *http.Request)
+	ArchiveColumn(w http.ResponseWriter, r *http.Request)
+	UnarchiveColumn(w http.ResponseWriter, r *http.Request)
+	SetColumnWIPLimit(w http.ResponseWriter, r *http.Request)
+
+	CloneBoard(w http.ResponseWriter, r *http.Request)
+	GetTemplates(w http.ResponseWriter, r *http.Request)
+
+	ExportBoard(w http.ResponseWriter, r *http.Request)
+	ImportBoard(w http.ResponseWriter, r *http.Request)
+	ImportTrelloBoard(w http.ResponseWriter, r *http.Request)
+
+	SearchCards(w http.ResponseWriter, r *http.Request)
+
+	RunBatch(w http.ResponseWriter, r *http.Request)
 }
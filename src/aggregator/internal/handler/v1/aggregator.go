@@ -3,33 +3,55 @@ package v1
 import (
 	"aggregator/internal/dto"
 	"aggregator/internal/middleware"
+	"aggregator/internal/service/todo"
 	"aggregator/internal/usecase"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
-const layout = "02-01-2006"
+const (
+	layout = "02-01-2006"
+	// maxImportSize and maxTrelloImportSize match the limits of the todo
+	// service
+	maxImportSize       = 10 << 20
+	maxTrelloImportSize = 50 << 20
+)
 
 var (
 	ErrInvalidRequestBody error = errors.New("invalid request body")
+	ErrInvalidDryRun      error = errors.New("invalid dry_run flag")
+	ErrInvalidArchived    error = errors.New("invalid archived flag")
 	ErrNoUserID           error = errors.New("couldn't get userID from context")
 	ErrBadUserID          error = errors.New("couldn't parse userID")
 	ErrNoRole             error = errors.New("couldn't get role from context")
 	ErrNotAdmin           error = errors.New("not admin")
+	ErrShareNotFound      error = errors.New("shared board or card not found")
+	ErrReadOnlyShare      error = errors.New("shared boards are read-only")
+	ErrInvalidDays        error = errors.New("invalid number of days")
+	ErrInvalidRevision    error = errors.New("invalid revision number")
+	ErrNoStreaming        error = errors.New("streaming is not supported")
 )
 
 type AggregatorHandler struct {
 	uc usecase.AggregatorUseCase
+	// heartbeat is how often an idle event stream sends a comment, so that
+	// proxies and clients do not take it for a dead connection
+	heartbeat time.Duration
 }
 
-func NewAggregatorHandler(uc usecase.AggregatorUseCase) *AggregatorHandler {
+func NewAggregatorHandler(uc usecase.AggregatorUseCase, heartbeat time.Duration) *AggregatorHandler {
 	return &AggregatorHandler{
-		uc: uc,
+		uc:        uc,
+		heartbeat: heartbeat,
 	}
 }
 
@@ -120,9 +142,26 @@ func (h *AggregatorHandler) GetBoards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	boards, err := h.uc.GetBoards(r.Context(), userID)
+	archived, err := archivedFilter(r.URL.Query())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, ErrInvalidArchived.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if page, ok := pageRequest(r.URL.Query()); ok {
+		boards, err := h.uc.GetBoardsPage(r.Context(), userID, archived, page)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(boards)
+		return
+	}
+
+	boards, err := h.uc.GetBoards(r.Context(), userID, archived)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -132,21 +171,51 @@ func (h *AggregatorHandler) GetBoards(w http.ResponseWriter, r *http.Request) {
 func (h *AggregatorHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
 	boardID := mux.Vars(r)["id"]
 
-	columns, err := h.uc.GetColumns(r.Context(), boardID)
+	archived, err := archivedFilter(r.URL.Query())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, ErrInvalidArchived.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if page, ok := pageRequest(r.URL.Query()); ok {
+		board, err := h.uc.GetBoardPage(r.Context(), boardID, archived, page)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(board)
+		return
+	}
+
+	board, err := h.uc.GetBoard(r.Context(), boardID, archived)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
-	json.NewEncoder(w).Encode(columns)
+	json.NewEncoder(w).Encode(board)
 }
 
 func (h *AggregatorHandler) GetColumn(w http.ResponseWriter, r *http.Request) {
 	columnID := mux.Vars(r)["id"]
 
-	cards, err := h.uc.GetCards(r.Context(), columnID)
+	labelID := r.URL.Query().Get("label_id")
+
+	if page, ok := pageRequest(r.URL.Query()); ok {
+		cards, err := h.uc.GetCardsPage(r.Context(), columnID, labelID, page)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(cards)
+		return
+	}
+
+	cards, err := h.uc.GetCards(r.Context(), columnID, labelID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -158,13 +227,222 @@ func (h *AggregatorHandler) GetCard(w http.ResponseWriter, r *http.Request) {
 
 	card, err := h.uc.GetCard(r.Context(), cardID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	setETag(w, card.Version)
+	json.NewEncoder(w).Encode(card)
+}
+
+// GetComments returns a page of the thread of the card; ?limit= and ?offset=
+// are passed on to the todo service
+func (h *AggregatorHandler) GetComments(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	comments, err := h.uc.GetComments(r.Context(), cardID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(comments)
+}
+
+// GetBoardActivity returns a page of the activity log of the board, newest
+// first; ?limit= and ?offset= are passed on to the todo service
+func (h *AggregatorHandler) GetBoardActivity(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	activities, err := h.uc.GetBoardActivity(r.Context(), boardID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(activities)
+}
+
+// StreamBoardEvents streams the changes to the board as server-sent events.
+// A client reconnecting with Last-Event-ID gets the changes it missed, or a
+// reset event when they are no longer all known.
+func (h *AggregatorHandler) StreamBoardEvents(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, ErrNoStreaming.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events, err := h.uc.StreamBoardEvents(r.Context(), boardID, r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, _ := json.Marshal(event)
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+
+		flusher.Flush()
+	}
+}
+
+// GetCardActivity is GetBoardActivity for a single card; the log of a
+// deleted card stays readable
+func (h *AggregatorHandler) GetCardActivity(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	activities, err := h.uc.GetCardActivity(r.Context(), cardID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(activities)
+}
+
+func (h *AggregatorHandler) GetCardRevisions(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	revisions, err := h.uc.GetCardRevisions(r.Context(), cardID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(revisions)
+}
+
+// DiffCardRevisions compares the revisions given by ?from= and ?to=
+func (h *AggregatorHandler) DiffCardRevisions(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	query := r.URL.Query()
+
+	from, err := strconv.Atoi(query.Get("from"))
+	if err != nil {
+		http.Error(w, ErrInvalidRevision.Error(), http.StatusBadRequest)
+		return
+	}
+
+	to, err := strconv.Atoi(query.Get("to"))
+	if err != nil {
+		http.Error(w, ErrInvalidRevision.Error(), http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.uc.DiffCardRevisions(r.Context(), cardID, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(diff)
+}
+
+func (h *AggregatorHandler) RevertCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	number, err := strconv.Atoi(vars["number"])
+	if err != nil {
+		http.Error(w, ErrInvalidRevision.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.uc.RevertCard(r.Context(), vars["id"], number)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	json.NewEncoder(w).Encode(card)
 }
 
+func (h *AggregatorHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	var req dto.CreateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	comment, err := h.uc.CreateComment(r.Context(), cardID, req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(comment)
+}
+
+func (h *AggregatorHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	comment, err := h.uc.UpdateComment(r.Context(), id, req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(comment)
+}
+
+func (h *AggregatorHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	err := h.uc.DeleteComment(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
 func (h *AggregatorHandler) GetStats(w http.ResponseWriter, r *http.Request) {
 	// XXX: Role check better should be in another role checking middleware
 	role, ok := middleware.GetRoleFromContext(r.Context())
@@ -193,7 +471,7 @@ func (h *AggregatorHandler) GetStats(w http.ResponseWriter, r *http.Request) {
 
 	stats, err := h.uc.GetStats(r.Context(), from, to)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -226,7 +504,7 @@ func (h *AggregatorHandler) CreateBoard(w http.ResponseWriter, r *http.Request)
 
 	err = h.uc.CreateBoard(r.Context(), board)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -261,7 +539,7 @@ func (h *AggregatorHandler) CreateColumn(w http.ResponseWriter, r *http.Request)
 	err = h.uc.CreateColumn(r.Context(), column)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -294,14 +572,16 @@ func (h *AggregatorHandler) CreateCard(w http.ResponseWriter, r *http.Request) {
 		Description: req.Description,
 	}
 
-	err = h.uc.CreateCard(r.Context(), card)
+	err = h.uc.CreateCard(r.Context(), &card)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(card)
 }
 
 func (h *AggregatorHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
@@ -311,16 +591,35 @@ func (h *AggregatorHandler) UpdateBoard(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = req.Version
+	}
+
 	board := dto.Board{
-		ID:    req.ID,
-		Title: req.Title,
+		ID:      req.ID,
+		Title:   req.Title,
+		Version: version,
+	}
+
+	err = h.uc.UpdateBoard(r.Context(), &board)
+	if errors.Is(err, todo.ErrConflict) {
+		writeConflict(w, fromHeader, board.Version, board)
+		return
 	}
 
-	err := h.uc.UpdateBoard(r.Context(), &board)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
+
+	setETag(w, board.Version)
 }
 
 func (h *AggregatorHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
@@ -342,19 +641,38 @@ func (h *AggregatorHandler) UpdateColumn(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = req.Version
+	}
+
 	column := dto.Column{
 		ID:      req.ID,
 		UserID:  userID,
 		BoardID: req.BoardID,
 		Title:   req.Title,
+		Version: version,
 	}
 
 	err = h.uc.UpdateColumn(r.Context(), &column)
 
+	if errors.Is(err, todo.ErrConflict) {
+		writeConflict(w, fromHeader, column.Version, column)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
+
+	setETag(w, column.Version)
 }
 
 func (h *AggregatorHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
@@ -376,20 +694,39 @@ func (h *AggregatorHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = req.Version
+	}
+
 	card := dto.Card{
 		ID:          req.ID,
 		UserID:      userID,
 		ColumnID:    req.ColumnID,
 		Title:       req.Title,
 		Description: req.Description,
+		Version:     version,
 	}
 
 	err = h.uc.UpdateCard(r.Context(), &card)
 
+	if errors.Is(err, todo.ErrConflict) {
+		writeConflict(w, fromHeader, card.Version, card)
+		return
+	}
+
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
+
+	setETag(w, card.Version)
 }
 
 func (h *AggregatorHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
@@ -398,7 +735,7 @@ func (h *AggregatorHandler) DeleteBoard(w http.ResponseWriter, r *http.Request)
 	err := h.uc.DeleteBoard(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 }
@@ -409,7 +746,7 @@ func (h *AggregatorHandler) DeleteColumn(w http.ResponseWriter, r *http.Request)
 	err := h.uc.DeleteColumn(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 }
@@ -420,7 +757,843 @@ func (h *AggregatorHandler) DeleteCard(w http.ResponseWriter, r *http.Request) {
 	err := h.uc.DeleteCard(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusConflict)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) RepositionColumn(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.RepositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	column, err := h.uc.RepositionColumn(r.Context(), id, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) RepositionCard(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.RepositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.uc.RepositionCard(r.Context(), id, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(card)
+}
+
+func (h *AggregatorHandler) MoveCard(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.MoveCardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.uc.MoveCard(r.Context(), id, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(card)
+}
+
+func (h *AggregatorHandler) SetCardDates(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.SetCardDatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.uc.SetCardDates(r.Context(), id, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(card)
+}
+
+// GetOverdueCards returns the overdue cards across all boards of the caller
+func (h *AggregatorHandler) GetOverdueCards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	cards, err := h.uc.GetOverdueCards(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+// GetDueCards returns the cards due within ?days= across all boards of the
+// caller
+func (h *AggregatorHandler) GetDueCards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	days, err := strconv.Atoi(r.URL.Query().Get("days"))
+	if err != nil {
+		http.Error(w, ErrInvalidDays.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cards, err := h.uc.GetDueCards(r.Context(), userID, days)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+// GetAssignedCards returns the cards the caller is assigned to across all
+// boards
+func (h *AggregatorHandler) GetAssignedCards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	cards, err := h.uc.GetAssignedCards(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(cards)
+}
+
+func (h *AggregatorHandler) AssignCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.AssignCard(r.Context(), vars["id"], vars["user_id"])
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) UnassignCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.UnassignCard(r.Context(), vars["id"], vars["user_id"])
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	// The body is streamed through to the todo service, which checks the
+	// size limit against the length declared here
+	attachment, err := h.uc.UploadAttachment(r.Context(), cardID, r.URL.Query().Get("filename"), r.ContentLength, r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(attachment)
+}
+
+func (h *AggregatorHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	content, err := h.uc.GetAttachment(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	defer content.Body.Close()
+
+	w.Header().Set("Content-Type", content.ContentType)
+	w.Header().Set("Content-Disposition", content.ContentDisposition)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if content.Size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(content.Size, 10))
+	}
+
+	io.Copy(w, content.Body)
+}
+
+func (h *AggregatorHandler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	err := h.uc.DeleteAttachment(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	var req dto.LabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	label, err := h.uc.CreateLabel(r.Context(), boardID, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(label)
+}
+
+func (h *AggregatorHandler) GetLabels(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	labels, err := h.uc.GetLabels(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(labels)
+}
+
+func (h *AggregatorHandler) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var req dto.LabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	label, err := h.uc.UpdateLabel(r.Context(), id, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(label)
+}
+
+func (h *AggregatorHandler) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	err := h.uc.DeleteLabel(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) AttachLabel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.AttachLabel(r.Context(), vars["id"], vars["label_id"])
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) DetachLabel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.DetachLabel(r.Context(), vars["id"], vars["label_id"])
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) GetChecklists(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	checklists, err := h.uc.GetChecklists(r.Context(), cardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(checklists)
+}
+
+func (h *AggregatorHandler) CreateChecklist(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+
+	var req dto.CreateChecklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	checklist, err := h.uc.CreateChecklist(r.Context(), cardID, req.Title)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(checklist)
+}
+
+func (h *AggregatorHandler) CreateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	checklistID := mux.Vars(r)["id"]
+
+	var req dto.CreateChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.uc.CreateChecklistItem(r.Context(), checklistID, req.Text)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(item)
+}
+
+func (h *AggregatorHandler) SetChecklistItemDone(w http.ResponseWriter, r *http.Request) {
+	itemID := mux.Vars(r)["id"]
+
+	var req dto.SetChecklistItemDoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.uc.SetChecklistItemDone(r.Context(), itemID, req.Done)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(item)
+}
+
+func (h *AggregatorHandler) SetBoardPublic(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	var req dto.SetBoardPublicRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err := h.uc.SetBoardPublic(r.Context(), boardID, req.IsPublic)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) CreateShareToken(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	token, err := h.uc.CreateShareToken(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(token)
+}
+
+func (h *AggregatorHandler) GetShareTokens(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	tokens, err := h.uc.GetShareTokens(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(tokens)
+}
+
+func (h *AggregatorHandler) RevokeShareToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.RevokeShareToken(r.Context(), vars["id"], vars["token"])
+	if errors.Is(err, todo.ErrNotFound) {
+		http.Error(w, ErrShareNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) GetSharedBoard(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	tree, err := h.uc.GetSharedBoard(r.Context(), token)
+	if errors.Is(err, todo.ErrNotFound) {
+		http.Error(w, ErrShareNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(tree)
+}
+
+func (h *AggregatorHandler) GetSharedCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	card, err := h.uc.GetSharedCard(r.Context(), vars["token"], vars["id"])
+	if errors.Is(err, todo.ErrNotFound) {
+		http.Error(w, ErrShareNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(card)
+}
+
+func (h *AggregatorHandler) RejectSharedMutation(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, ErrReadOnlyShare.Error(), http.StatusMethodNotAllowed)
+}
+
+func (h *AggregatorHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	var req dto.InviteMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.uc.InviteMember(r.Context(), boardID, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(member)
+}
+
+func (h *AggregatorHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	member, err := h.uc.AcceptInvitation(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(member)
+}
+
+func (h *AggregatorHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	var req dto.UpdateMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.uc.UpdateMemberRole(r.Context(), vars["id"], vars["user_id"], req.Role)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(member)
+}
+
+func (h *AggregatorHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	err := h.uc.RemoveMember(r.Context(), vars["id"], vars["user_id"])
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+}
+
+func (h *AggregatorHandler) GetBoardMembers(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	members, err := h.uc.GetBoardMembers(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(members)
+}
+
+func (h *AggregatorHandler) GetInvitations(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	invitations, err := h.uc.GetInvitations(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(invitations)
+}
+
+// GetTrash returns a page of the trash of the caller; ?limit= and ?offset=
+// are passed on to the todo service
+func (h *AggregatorHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	items, err := h.uc.GetTrash(r.Context(), userID, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(items)
+}
+
+func (h *AggregatorHandler) RestoreItem(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	item, err := h.uc.RestoreItem(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(item)
+}
+
+func (h *AggregatorHandler) ArchiveBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	board, err := h.uc.ArchiveBoard(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(board)
+}
+
+func (h *AggregatorHandler) UnarchiveBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	board, err := h.uc.UnarchiveBoard(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(board)
+}
+
+func (h *AggregatorHandler) ArchiveColumn(w http.ResponseWriter, r *http.Request) {
+	columnID := mux.Vars(r)["id"]
+
+	column, err := h.uc.ArchiveColumn(r.Context(), columnID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) UnarchiveColumn(w http.ResponseWriter, r *http.Request) {
+	columnID := mux.Vars(r)["id"]
+
+	column, err := h.uc.UnarchiveColumn(r.Context(), columnID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) SetColumnWIPLimit(w http.ResponseWriter, r *http.Request) {
+	columnID := mux.Vars(r)["id"]
+
+	var req dto.SetColumnWIPLimitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	column, err := h.uc.SetColumnWIPLimit(r.Context(), columnID, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(column)
+}
+
+func (h *AggregatorHandler) CloneBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	// Without a body the board is copied as it is
+	var req dto.CloneBoardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.uc.CloneBoard(r.Context(), boardID, req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(board)
+}
+
+func (h *AggregatorHandler) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	templates, err := h.uc.GetTemplates(r.Context(), userID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(templates)
+}
+
+func (h *AggregatorHandler) ExportBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+
+	export, err := h.uc.ExportBoard(r.Context(), boardID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"board-%s.json\"", boardID))
+
+	w.Write(export)
+}
+
+func (h *AggregatorHandler) ImportBoard(w http.ResponseWriter, r *http.Request) {
+	// The document is only checked to be JSON here; the todo service
+	// validates its contents
+	var export json.RawMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportSize)).Decode(&export); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.uc.ImportBoard(r.Context(), export)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(board)
+}
+
+func (h *AggregatorHandler) ImportTrelloBoard(w http.ResponseWriter, r *http.Request) {
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, ErrInvalidDryRun.Error(), http.StatusBadRequest)
+			return
+		}
+		dryRun = parsed
+	}
+
+	var trello json.RawMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTrelloImportSize)).Decode(&trello); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	summary, err := h.uc.ImportTrelloBoard(r.Context(), trello, dryRun)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	if !dryRun {
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	json.NewEncoder(w).Encode(summary)
+}
+
+func (h *AggregatorHandler) SearchCards(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	search := dto.CardSearch{
+		Query:   query.Get("q"),
+		BoardID: query.Get("board_id"),
+		From:    query.Get("from"),
+		To:      query.Get("to"),
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	matches, err := h.uc.SearchCards(r.Context(), userID, search, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(matches)
+}
+
+// RunBatch passes the batch on to the todo service with the caller as the
+// owner of everything it creates
+func (h *AggregatorHandler) RunBatch(w http.ResponseWriter, r *http.Request) {
+	var req dto.BatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userIDstr, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrNoUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		http.Error(w, ErrBadUserID.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	for i := range req.Operations {
+		if req.Operations[i].Op == "create" {
+			req.Operations[i].UserID = userID
+		}
+	}
+
+	batch, err := h.uc.RunBatch(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(batch)
+}
+
+// archivedFilter reads the optional ?archived= flag; archived items are only
+// listed when it is set
+func archivedFilter(query url.Values) (bool, error) {
+	if _, ok := query["archived"]; !ok {
+		return false, nil
+	}
+
+	return strconv.ParseBool(query.Get("archived"))
+}
+
+// pageRequest reads ?cursor= and ?limit=; the listings are only paged by
+// cursor when the cursor is given, an empty one asking for the first page
+func pageRequest(query url.Values) (dto.PageRequest, bool) {
+	if _, ok := query["cursor"]; !ok {
+		return dto.PageRequest{}, false
+	}
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	return dto.PageRequest{Cursor: query.Get("cursor"), Limit: limit}, true
+}
+
+// errorStatus passes access errors of the todo service through to the client
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, todo.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, todo.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, todo.ErrBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, todo.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, todo.ErrLengthRequired):
+		return http.StatusLengthRequired
+	case errors.Is(err, todo.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusConflict
+	}
 }
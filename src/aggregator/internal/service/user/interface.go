@@ -4,8 +4,13 @@ import (
 	"aggregator/internal/dto"
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type UserService interface {
 	GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]dto.User, error)
+	// GetUsersByIDs looks up the users in as few requests as possible; ids
+	// of missing users are skipped
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]dto.User, error)
 }
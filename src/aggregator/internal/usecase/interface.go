@@ -4,6 +4,8 @@ import (
 	"aggregator/internal/dto"
 	"aggregator/internal/entity"
 	"context"
+	"encoding/json"
+	"io"
 	"time"
 )
 
@@ -16,14 +18,27 @@ type AggregatorUseCase interface {
 	Validate(ctx context.Context, token string) (*dto.ValidateTokenResponse, error)
 	Logout(ctx context.Context, refreshToken string) error
 
-	GetBoards(ctx context.Context, userID string) ([]dto.Board, error)
-	GetColumns(ctx context.Context, boardID string) ([]dto.Column, error)
-	GetCards(ctx context.Context, columnID string) ([]dto.Card, error)
+	GetBoards(ctx context.Context, userID string, archived bool) ([]dto.Board, error)
+	GetBoard(ctx context.Context, boardID string, archived bool) (*dto.BoardView, error)
+	GetCards(ctx context.Context, columnID, labelID string) ([]dto.Card, error)
+	GetBoardsPage(ctx context.Context, userID string, archived bool, page dto.PageRequest) (*dto.BoardPage, error)
+	GetBoardPage(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.BoardView, error)
+	GetCardsPage(ctx context.Context, columnID, labelID string, page dto.PageRequest) (*dto.CardPage, error)
 	GetCard(ctx context.Context, id string) (*dto.Card, error)
+	GetComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error)
+	GetBoardActivity(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error)
+	GetCardActivity(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error)
+	StreamBoardEvents(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error)
+	GetCardRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error)
+	DiffCardRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error)
+	RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error)
 
 	CreateBoard(ctx context.Context, board dto.Board) error
 	CreateColumn(ctx context.Context, column dto.Column) error
-	CreateCard(ctx context.Context, card dto.Card) error
+	CreateCard(ctx context.Context, card *dto.Card) error
+	CreateComment(ctx context.Context, cardID, body string) (*dto.Comment, error)
+	UpdateComment(ctx context.Context, id, body string) (*dto.Comment, error)
+	DeleteComment(ctx context.Context, id string) error
 
 	UpdateBoard(ctx context.Context, board *dto.Board) error
 	UpdateColumn(ctx context.Context, column *dto.Column) error
@@ -32,4 +47,65 @@ type AggregatorUseCase interface {
 	DeleteBoard(ctx context.Context, id string) error
 	DeleteColumn(ctx context.Context, id string) error
 	DeleteCard(ctx context.Context, id string) error
+
+	RepositionColumn(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Column, error)
+	RepositionCard(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Card, error)
+	MoveCard(ctx context.Context, id string, req dto.MoveCardRequest) (*dto.Card, error)
+
+	SetCardDates(ctx context.Context, id string, req dto.SetCardDatesRequest) (*dto.Card, error)
+	GetOverdueCards(ctx context.Context, userID string) ([]dto.Card, error)
+	GetDueCards(ctx context.Context, userID string, days int) ([]dto.Card, error)
+	AssignCard(ctx context.Context, cardID, userID string) error
+	UnassignCard(ctx context.Context, cardID, userID string) error
+	GetAssignedCards(ctx context.Context, userID string) ([]dto.Card, error)
+
+	UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error)
+	GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error)
+	DeleteAttachment(ctx context.Context, id string) error
+
+	CreateLabel(ctx context.Context, boardID string, req dto.LabelRequest) (*dto.Label, error)
+	GetLabels(ctx context.Context, boardID string) ([]dto.Label, error)
+	UpdateLabel(ctx context.Context, id string, req dto.LabelRequest) (*dto.Label, error)
+	DeleteLabel(ctx context.Context, id string) error
+	AttachLabel(ctx context.Context, cardID, labelID string) error
+	DetachLabel(ctx context.Context, cardID, labelID string) error
+
+	GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error)
+	CreateChecklist(ctx context.Context, cardID, title string) (*dto.Checklist, error)
+	CreateChecklistItem(ctx context.Context, checklistID, text string) (*dto.ChecklistItem, error)
+	SetChecklistItemDone(ctx context.Context, itemID string, done bool) (*dto.ChecklistItem, error)
+
+	SetBoardPublic(ctx context.Context, boardID string, isPublic bool) error
+	CreateShareToken(ctx context.Context, boardID string) (*dto.ShareToken, error)
+	GetShareTokens(ctx context.Context, boardID string) ([]dto.ShareToken, error)
+	RevokeShareToken(ctx context.Context, boardID, token string) error
+	GetSharedBoard(ctx context.Context, token string) (*dto.BoardTree, error)
+	GetSharedCard(ctx context.Context, token, cardID string) (*dto.Card, error)
+
+	InviteMember(ctx context.Context, boardID string, req dto.InviteMemberRequest) (*dto.BoardMember, error)
+	AcceptInvitation(ctx context.Context, boardID string) (*dto.BoardMember, error)
+	UpdateMemberRole(ctx context.Context, boardID, userID, role string) (*dto.BoardMember, error)
+	RemoveMember(ctx context.Context, boardID, userID string) error
+	GetBoardMembers(ctx context.Context, boardID string) ([]dto.BoardMember, error)
+	GetInvitations(ctx context.Context, userID string) ([]dto.BoardMember, error)
+
+	GetTrash(ctx context.Context, userID string, limit, offset int) ([]dto.TrashItem, error)
+	RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error)
+
+	ArchiveBoard(ctx context.Context, id string) (*dto.Board, error)
+	UnarchiveBoard(ctx context.Context, id string) (*dto.Board, error)
+	ArchiveColumn(ctx context.Context, id string) (*dto.Column, error)
+	UnarchiveColumn(ctx context.Context, id string) (*dto.Column, error)
+	SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error)
+
+	CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error)
+	GetTemplates(ctx context.Context, userID string) ([]dto.Board, error)
+
+	ExportBoard(ctx context.Context, id string) (json.RawMessage, error)
+	ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error)
+	ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error)
+
+	SearchCards(ctx context.Context, userID string, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error)
+
+	RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error)
 }
@@ -9,8 +9,10 @@ import (
 	"aggregator/internal/service/user"
 	"aggregator/internal/usecase"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,6 +43,11 @@ var (
 	ErrDeleteCard       error  = errors.New("failed to delete card")
 )
 
+var (
+	ErrInvalidAssigneeID = fmt.Errorf("%w: invalid assignee id", todo.ErrBadRequest)
+	ErrAssigneeNotFound  = fmt.Errorf("assignee %w", todo.ErrNotFound)
+)
+
 type AggregatorUseCase struct {
 	userSvc user.UserService
 	authSvc auth.AuthService
@@ -271,12 +278,12 @@ func (uc *AggregatorUseCase) Logout(ctx context.Context, refreshToken string) er
 	return nil
 }
 
-func (uc *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dto.Board, error) {
+func (uc *AggregatorUseCase) GetBoards(ctx context.Context, userID string, archived bool) ([]dto.Board, error) {
 	header := "GetBoards: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "archived", archived)
 
-	boards, err := uc.todoSvc.GetBoards(ctx, userID)
+	boards, err := uc.todoSvc.GetBoards(ctx, userID, archived)
 
 	if err != nil {
 		info := "Failed to get boards"
@@ -289,12 +296,12 @@ func (uc *AggregatorUseCase) GetBoards(ctx context.Context, userID string) ([]dt
 	return boards, nil
 }
 
-func (uc *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]dto.Column, error) {
-	header := "GetColumns: "
+func (uc *AggregatorUseCase) GetBoard(ctx context.Context, boardID string, archived bool) (*dto.BoardView, error) {
+	header := "GetBoard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "archived", archived)
 
-	columns, err := uc.todoSvc.GetColumns(ctx, boardID)
+	columns, err := uc.todoSvc.GetColumns(ctx, boardID, archived)
 
 	if err != nil {
 		info := "Failed to get columns"
@@ -302,17 +309,94 @@ func (uc *AggregatorUseCase) GetColumns(ctx context.Context, boardID string) ([]
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Got columns", "columns", columns)
+	uc.log.Info(ctx, header+"Got columns; Making request to todo service for labels", "columns", columns)
+
+	labels, err := uc.todoSvc.GetLabels(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get labels"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got labels", "labels", labels)
 
-	return columns, nil
+	return &dto.BoardView{Columns: columns, Labels: labels}, nil
 }
 
-func (uc *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]dto.Card, error) {
+func (uc *AggregatorUseCase) GetCards(ctx context.Context, columnID, labelID string) ([]dto.Card, error) {
 	header := "GetCards: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "columnID", columnID)
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "columnID", columnID, "labelID", labelID)
+
+	cards, err := uc.todoSvc.GetCards(ctx, columnID, labelID)
+
+	if err != nil {
+		info := "Failed to get cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withAssignees(ctx, cards)
+
+	uc.log.Info(ctx, header+"Got cards", "cards", cards)
+
+	return cards, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardsPage(ctx context.Context, userID string, archived bool, page dto.PageRequest) (*dto.BoardPage, error) {
+	header := "GetBoardsPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "archived", archived, "page", page)
+
+	boards, err := uc.todoSvc.GetBoardsPage(ctx, userID, archived, page)
+
+	if err != nil {
+		info := "Failed to get boards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got boards", "boards", boards)
+
+	return boards, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardPage(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.BoardView, error) {
+	header := "GetBoardPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "archived", archived, "page", page)
+
+	columns, err := uc.todoSvc.GetColumnsPage(ctx, boardID, archived, page)
+
+	if err != nil {
+		info := "Failed to get columns"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got columns; Making request to todo service for labels", "columns", columns)
+
+	// The palette is small and comes whole with every page
+	labels, err := uc.todoSvc.GetLabels(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get labels"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got labels", "labels", labels)
 
-	cards, err := uc.todoSvc.GetCards(ctx, columnID)
+	return &dto.BoardView{Columns: columns.Columns, Labels: labels, NextCursor: columns.NextCursor}, nil
+}
+
+func (uc *AggregatorUseCase) GetCardsPage(ctx context.Context, columnID, labelID string, page dto.PageRequest) (*dto.CardPage, error) {
+	header := "GetCardsPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "columnID", columnID, "labelID", labelID, "page", page)
+
+	cards, err := uc.todoSvc.GetCardsPage(ctx, columnID, labelID, page)
 
 	if err != nil {
 		info := "Failed to get cards"
@@ -320,6 +404,8 @@ func (uc *AggregatorUseCase) GetCards(ctx context.Context, columnID string) ([]d
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	uc.withAssignees(ctx, cards.Cards)
+
 	uc.log.Info(ctx, header+"Got cards", "cards", cards)
 
 	return cards, nil
@@ -338,11 +424,271 @@ func (uc *AggregatorUseCase) GetCard(ctx context.Context, id string) (*dto.Card,
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	cards := []dto.Card{*card}
+	uc.withAssignees(ctx, cards)
+	card = &cards[0]
+
 	uc.log.Info(ctx, header+"Got card", "card", card)
 
 	return card, nil
 }
 
+func (uc *AggregatorUseCase) GetComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error) {
+	header := "GetComments: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "limit", limit, "offset", offset)
+
+	comments, err := uc.todoSvc.GetComments(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get comments"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got comments", "count", len(comments))
+
+	return comments, nil
+}
+
+func (uc *AggregatorUseCase) GetBoardActivity(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error) {
+	header := "GetBoardActivity: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "limit", limit, "offset", offset)
+
+	activities, err := uc.todoSvc.GetBoardActivity(ctx, boardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get activity"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withActors(ctx, activities)
+
+	uc.log.Info(ctx, header+"Got activity", "count", len(activities))
+
+	return activities, nil
+}
+
+func (uc *AggregatorUseCase) StreamBoardEvents(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error) {
+	header := "StreamBoardEvents: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "lastEventID", lastEventID)
+
+	events, err := uc.todoSvc.StreamBoardEvents(ctx, boardID, lastEventID)
+
+	if err != nil {
+		info := "Failed to stream board events"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	return events, nil
+}
+
+func (uc *AggregatorUseCase) GetCardActivity(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error) {
+	header := "GetCardActivity: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "limit", limit, "offset", offset)
+
+	activities, err := uc.todoSvc.GetCardActivity(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get activity"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withActors(ctx, activities)
+
+	uc.log.Info(ctx, header+"Got activity", "count", len(activities))
+
+	return activities, nil
+}
+
+// withActors fills in the usernames of the actors the same way withAssignees
+// does for cards
+func (uc *AggregatorUseCase) withActors(ctx context.Context, activities []dto.Activity) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+
+	for _, activity := range activities {
+		if !seen[activity.ActorID] {
+			seen[activity.ActorID] = true
+			ids = append(ids, activity.ActorID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	users, err := uc.userSvc.GetUsersByIDs(ctx, ids)
+
+	if err != nil {
+		uc.log.Warn(ctx, "Failed to get actors; Leaving usernames out", "err", err.Error())
+		return
+	}
+
+	usernames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	for i := range activities {
+		activities[i].Actor = usernames[activities[i].ActorID]
+	}
+}
+
+func (uc *AggregatorUseCase) GetCardRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error) {
+	header := "GetCardRevisions: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "limit", limit, "offset", offset)
+
+	revisions, err := uc.todoSvc.GetCardRevisions(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get revisions"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withAuthors(ctx, revisions)
+
+	uc.log.Info(ctx, header+"Got revisions", "count", len(revisions))
+
+	return revisions, nil
+}
+
+func (uc *AggregatorUseCase) DiffCardRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error) {
+	header := "DiffCardRevisions: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "from", from, "to", to)
+
+	diff, err := uc.todoSvc.DiffCardRevisions(ctx, cardID, from, to)
+
+	if err != nil {
+		info := "Failed to compare revisions"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	revisions := []dto.CardRevision{diff.From, diff.To}
+	uc.withAuthors(ctx, revisions)
+	diff.From, diff.To = revisions[0], revisions[1]
+
+	uc.log.Info(ctx, header+"Compared revisions")
+
+	return diff, nil
+}
+
+func (uc *AggregatorUseCase) RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error) {
+	header := "RevertCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "number", number)
+
+	card, err := uc.todoSvc.RevertCard(ctx, cardID, number)
+
+	if err != nil {
+		info := "Failed to revert card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully reverted")
+
+	return card, nil
+}
+
+// withAuthors fills in the usernames of the authors of the revisions the
+// same way withActors does for the activity log
+func (uc *AggregatorUseCase) withAuthors(ctx context.Context, revisions []dto.CardRevision) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+
+	for _, revision := range revisions {
+		if !seen[revision.AuthorID] {
+			seen[revision.AuthorID] = true
+			ids = append(ids, revision.AuthorID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	users, err := uc.userSvc.GetUsersByIDs(ctx, ids)
+
+	if err != nil {
+		uc.log.Warn(ctx, "Failed to get authors; Leaving usernames out", "err", err.Error())
+		return
+	}
+
+	usernames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	for i := range revisions {
+		revisions[i].Author = usernames[revisions[i].AuthorID]
+	}
+}
+
+func (uc *AggregatorUseCase) CreateComment(ctx context.Context, cardID, body string) (*dto.Comment, error) {
+	header := "CreateComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID)
+
+	comment, err := uc.todoSvc.CreateComment(ctx, cardID, body)
+
+	if err != nil {
+		info := "Failed to create comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Comment successfully created", "comment", comment)
+
+	return comment, nil
+}
+
+func (uc *AggregatorUseCase) UpdateComment(ctx context.Context, id, body string) (*dto.Comment, error) {
+	header := "UpdateComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	comment, err := uc.todoSvc.UpdateComment(ctx, id, body)
+
+	if err != nil {
+		info := "Failed to update comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully updated comment", "comment", comment)
+
+	return comment, nil
+}
+
+func (uc *AggregatorUseCase) DeleteComment(ctx context.Context, id string) error {
+	header := "DeleteComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	err := uc.todoSvc.DeleteComment(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully deleted comment")
+
+	return nil
+}
+
 func (uc *AggregatorUseCase) CreateBoard(ctx context.Context, board dto.Board) error {
 	header := "CreateBoard: "
 
@@ -379,7 +725,7 @@ func (uc *AggregatorUseCase) CreateColumn(ctx context.Context, column dto.Column
 	return nil
 }
 
-func (uc *AggregatorUseCase) CreateCard(ctx context.Context, card dto.Card) error {
+func (uc *AggregatorUseCase) CreateCard(ctx context.Context, card *dto.Card) error {
 	header := "CreateCard: "
 
 	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "card", card)
@@ -504,3 +850,939 @@ func (uc *AggregatorUseCase) DeleteCard(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (uc *AggregatorUseCase) RepositionColumn(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Column, error) {
+	header := "RepositionColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	column, err := uc.todoSvc.RepositionColumn(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to reposition column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully repositioned column", "position", column.Position)
+
+	return column, nil
+}
+
+func (uc *AggregatorUseCase) RepositionCard(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Card, error) {
+	header := "RepositionCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	card, err := uc.todoSvc.RepositionCard(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to reposition card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully repositioned card", "position", card.Position)
+
+	return card, nil
+}
+
+func (uc *AggregatorUseCase) MoveCard(ctx context.Context, id string, req dto.MoveCardRequest) (*dto.Card, error) {
+	header := "MoveCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	card, err := uc.todoSvc.MoveCard(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to move card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully moved card", "columnID", card.ColumnID, "position", card.Position)
+
+	return card, nil
+}
+
+func (uc *AggregatorUseCase) SetCardDates(ctx context.Context, id string, req dto.SetCardDatesRequest) (*dto.Card, error) {
+	header := "SetCardDates: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	card, err := uc.todoSvc.SetCardDates(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to set card dates"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set card dates", "startDate", card.StartDate, "dueDate", card.DueDate)
+
+	return card, nil
+}
+
+func (uc *AggregatorUseCase) GetOverdueCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	header := "GetOverdueCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	cards, err := uc.todoSvc.GetOverdueCards(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get overdue cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withAssignees(ctx, cards)
+
+	uc.log.Info(ctx, header+"Got overdue cards", "count", len(cards))
+
+	return cards, nil
+}
+
+func (uc *AggregatorUseCase) GetDueCards(ctx context.Context, userID string, days int) ([]dto.Card, error) {
+	header := "GetDueCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "days", days)
+
+	cards, err := uc.todoSvc.GetDueCards(ctx, userID, days)
+
+	if err != nil {
+		info := "Failed to get due cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withAssignees(ctx, cards)
+
+	uc.log.Info(ctx, header+"Got due cards", "count", len(cards))
+
+	return cards, nil
+}
+
+func (uc *AggregatorUseCase) AssignCard(ctx context.Context, cardID, userID string) error {
+	header := "AssignCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to user service", "cardID", cardID, "userID", userID)
+
+	id, err := uuid.Parse(userID)
+
+	if err != nil {
+		err = ErrInvalidAssigneeID
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	users, err := uc.userSvc.GetUsersByIDs(ctx, []uuid.UUID{id})
+
+	if err != nil {
+		info := "Failed to get assignee"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if len(users) == 0 {
+		err = ErrAssigneeNotFound
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Assignee exists; Making request to todo service", "cardID", cardID, "userID", userID)
+
+	err = uc.todoSvc.AssignCard(ctx, cardID, userID)
+
+	if err != nil {
+		info := "Failed to assign card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully assigned card")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) UnassignCard(ctx context.Context, cardID, userID string) error {
+	header := "UnassignCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "userID", userID)
+
+	err := uc.todoSvc.UnassignCard(ctx, cardID, userID)
+
+	if err != nil {
+		info := "Failed to unassign card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully unassigned card")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetAssignedCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	header := "GetAssignedCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	cards, err := uc.todoSvc.GetAssignedCards(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get assigned cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.withAssignees(ctx, cards)
+
+	uc.log.Info(ctx, header+"Got assigned cards", "count", len(cards))
+
+	return cards, nil
+}
+
+// withAssignees fills in the assignees of the cards with a single lookup of
+// all their users. Usernames are a nicety, so if the user service fails the
+// cards are left with the bare assignee ids.
+func (uc *AggregatorUseCase) withAssignees(ctx context.Context, cards []dto.Card) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+
+	for _, card := range cards {
+		for _, id := range card.AssigneeIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	users, err := uc.userSvc.GetUsersByIDs(ctx, ids)
+
+	if err != nil {
+		uc.log.Warn(ctx, "Failed to get assignees; Leaving usernames out", "err", err.Error())
+		return
+	}
+
+	usernames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	for i := range cards {
+		cards[i].Assignees = make([]dto.Assignee, len(cards[i].AssigneeIDs))
+		for j, id := range cards[i].AssigneeIDs {
+			cards[i].Assignees[j] = dto.Assignee{ID: id, Username: usernames[id]}
+		}
+	}
+}
+
+func (uc *AggregatorUseCase) CreateLabel(ctx context.Context, boardID string, req dto.LabelRequest) (*dto.Label, error) {
+	header := "CreateLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "req", req)
+
+	label, err := uc.todoSvc.CreateLabel(ctx, boardID, req)
+
+	if err != nil {
+		info := "Failed to create label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created label", "id", label.ID)
+
+	return label, nil
+}
+
+func (uc *AggregatorUseCase) GetLabels(ctx context.Context, boardID string) ([]dto.Label, error) {
+	header := "GetLabels: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	labels, err := uc.todoSvc.GetLabels(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get labels"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got labels", "labels", labels)
+
+	return labels, nil
+}
+
+func (uc *AggregatorUseCase) UpdateLabel(ctx context.Context, id string, req dto.LabelRequest) (*dto.Label, error) {
+	header := "UpdateLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	label, err := uc.todoSvc.UpdateLabel(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to update label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully updated label")
+
+	return label, nil
+}
+
+func (uc *AggregatorUseCase) DeleteLabel(ctx context.Context, id string) error {
+	header := "DeleteLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	err := uc.todoSvc.DeleteLabel(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully deleted label")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) AttachLabel(ctx context.Context, cardID, labelID string) error {
+	header := "AttachLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "labelID", labelID)
+
+	err := uc.todoSvc.AttachLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Failed to attach label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully attached label")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) DetachLabel(ctx context.Context, cardID, labelID string) error {
+	header := "DetachLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "labelID", labelID)
+
+	err := uc.todoSvc.DetachLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Failed to detach label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully detached label")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error) {
+	header := "GetChecklists: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID)
+
+	checklists, err := uc.todoSvc.GetChecklists(ctx, cardID)
+
+	if err != nil {
+		info := "Failed to get checklists"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got checklists", "count", len(checklists))
+
+	return checklists, nil
+}
+
+func (uc *AggregatorUseCase) CreateChecklist(ctx context.Context, cardID, title string) (*dto.Checklist, error) {
+	header := "CreateChecklist: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "title", title)
+
+	checklist, err := uc.todoSvc.CreateChecklist(ctx, cardID, title)
+
+	if err != nil {
+		info := "Failed to create checklist"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created checklist", "id", checklist.ID)
+
+	return checklist, nil
+}
+
+func (uc *AggregatorUseCase) CreateChecklistItem(ctx context.Context, checklistID, text string) (*dto.ChecklistItem, error) {
+	header := "CreateChecklistItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "checklistID", checklistID, "text", text)
+
+	item, err := uc.todoSvc.CreateChecklistItem(ctx, checklistID, text)
+
+	if err != nil {
+		info := "Failed to create checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created checklist item", "id", item.ID)
+
+	return item, nil
+}
+
+func (uc *AggregatorUseCase) SetChecklistItemDone(ctx context.Context, itemID string, done bool) (*dto.ChecklistItem, error) {
+	header := "SetChecklistItemDone: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "itemID", itemID, "done", done)
+
+	item, err := uc.todoSvc.SetChecklistItemDone(ctx, itemID, done)
+
+	if err != nil {
+		info := "Failed to update checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully updated checklist item", "done", item.Done)
+
+	return item, nil
+}
+
+func (uc *AggregatorUseCase) SetBoardPublic(ctx context.Context, boardID string, isPublic bool) error {
+	header := "SetBoardPublic: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "isPublic", isPublic)
+
+	err := uc.todoSvc.SetBoardPublic(ctx, boardID, isPublic)
+
+	if err != nil {
+		info := "Failed to set board visibility"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set board visibility")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) CreateShareToken(ctx context.Context, boardID string) (*dto.ShareToken, error) {
+	header := "CreateShareToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	token, err := uc.todoSvc.CreateShareToken(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to create share token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully created share token", "boardID", boardID)
+
+	return token, nil
+}
+
+func (uc *AggregatorUseCase) GetShareTokens(ctx context.Context, boardID string) ([]dto.ShareToken, error) {
+	header := "GetShareTokens: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	tokens, err := uc.todoSvc.GetShareTokens(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get share tokens"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got share tokens", "count", len(tokens))
+
+	return tokens, nil
+}
+
+func (uc *AggregatorUseCase) RevokeShareToken(ctx context.Context, boardID, token string) error {
+	header := "RevokeShareToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	err := uc.todoSvc.RevokeShareToken(ctx, boardID, token)
+
+	if err != nil {
+		info := "Failed to revoke share token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully revoked share token")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetSharedBoard(ctx context.Context, token string) (*dto.BoardTree, error) {
+	header := "GetSharedBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service")
+
+	tree, err := uc.todoSvc.GetSharedBoard(ctx, token)
+
+	if err != nil {
+		info := "Failed to get shared board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got shared board", "boardID", tree.ID)
+
+	return tree, nil
+}
+
+func (uc *AggregatorUseCase) GetSharedCard(ctx context.Context, token, cardID string) (*dto.Card, error) {
+	header := "GetSharedCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID)
+
+	card, err := uc.todoSvc.GetSharedCard(ctx, token, cardID)
+
+	if err != nil {
+		info := "Failed to get shared card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got shared card", "card", card)
+
+	return card, nil
+}
+
+func (uc *AggregatorUseCase) InviteMember(ctx context.Context, boardID string, req dto.InviteMemberRequest) (*dto.BoardMember, error) {
+	header := "InviteMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "userID", req.UserID, "role", req.Role)
+
+	member, err := uc.todoSvc.InviteMember(ctx, boardID, req)
+
+	if err != nil {
+		info := "Failed to invite member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully invited member")
+
+	return member, nil
+}
+
+func (uc *AggregatorUseCase) AcceptInvitation(ctx context.Context, boardID string) (*dto.BoardMember, error) {
+	header := "AcceptInvitation: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	member, err := uc.todoSvc.AcceptInvitation(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to accept invitation"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully accepted invitation")
+
+	return member, nil
+}
+
+func (uc *AggregatorUseCase) UpdateMemberRole(ctx context.Context, boardID, userID, role string) (*dto.BoardMember, error) {
+	header := "UpdateMemberRole: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "userID", userID, "role", role)
+
+	member, err := uc.todoSvc.UpdateMemberRole(ctx, boardID, userID, role)
+
+	if err != nil {
+		info := "Failed to update member role"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully updated member role")
+
+	return member, nil
+}
+
+func (uc *AggregatorUseCase) RemoveMember(ctx context.Context, boardID, userID string) error {
+	header := "RemoveMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "userID", userID)
+
+	err := uc.todoSvc.RemoveMember(ctx, boardID, userID)
+
+	if err != nil {
+		info := "Failed to remove member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully removed member")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) GetBoardMembers(ctx context.Context, boardID string) ([]dto.BoardMember, error) {
+	header := "GetBoardMembers: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID)
+
+	members, err := uc.todoSvc.GetBoardMembers(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get board members"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got board members", "count", len(members))
+
+	return members, nil
+}
+
+func (uc *AggregatorUseCase) GetInvitations(ctx context.Context, userID string) ([]dto.BoardMember, error) {
+	header := "GetInvitations: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	invitations, err := uc.todoSvc.GetInvitations(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get invitations"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got invitations", "count", len(invitations))
+
+	return invitations, nil
+}
+
+func (uc *AggregatorUseCase) GetTrash(ctx context.Context, userID string, limit, offset int) ([]dto.TrashItem, error) {
+	header := "GetTrash: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "limit", limit, "offset", offset)
+
+	items, err := uc.todoSvc.GetTrash(ctx, userID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get trash"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got trash", "count", len(items))
+
+	return items, nil
+}
+
+func (uc *AggregatorUseCase) RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error) {
+	header := "RestoreItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	item, err := uc.todoSvc.RestoreItem(ctx, id)
+
+	if err != nil {
+		info := "Failed to restore item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully restored item", "type", item.Type)
+
+	return item, nil
+}
+
+func (uc *AggregatorUseCase) ArchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	header := "ArchiveBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	board, err := uc.todoSvc.ArchiveBoard(ctx, id)
+
+	if err != nil {
+		info := "Failed to archive board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully archived board")
+
+	return board, nil
+}
+
+func (uc *AggregatorUseCase) UnarchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	header := "UnarchiveBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	board, err := uc.todoSvc.UnarchiveBoard(ctx, id)
+
+	if err != nil {
+		info := "Failed to unarchive board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully unarchived board")
+
+	return board, nil
+}
+
+func (uc *AggregatorUseCase) ArchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	header := "ArchiveColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	column, err := uc.todoSvc.ArchiveColumn(ctx, id)
+
+	if err != nil {
+		info := "Failed to archive column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully archived column")
+
+	return column, nil
+}
+
+func (uc *AggregatorUseCase) SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error) {
+	header := "SetColumnWIPLimit: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id, "req", req)
+
+	column, err := uc.todoSvc.SetColumnWIPLimit(ctx, id, req)
+
+	if err != nil {
+		info := "Failed to set wip limit"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully set wip limit")
+
+	return column, nil
+}
+
+func (uc *AggregatorUseCase) UnarchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	header := "UnarchiveColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	column, err := uc.todoSvc.UnarchiveColumn(ctx, id)
+
+	if err != nil {
+		info := "Failed to unarchive column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully unarchived column")
+
+	return column, nil
+}
+
+func (uc *AggregatorUseCase) CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error) {
+	header := "CloneBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "boardID", boardID, "req", req)
+
+	board, err := uc.todoSvc.CloneBoard(ctx, boardID, req)
+
+	if err != nil {
+		info := "Failed to clone board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully cloned board")
+
+	return board, nil
+}
+
+func (uc *AggregatorUseCase) GetTemplates(ctx context.Context, userID string) ([]dto.Board, error) {
+	header := "GetTemplates: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID)
+
+	templates, err := uc.todoSvc.GetTemplates(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get templates"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully got templates")
+
+	return templates, nil
+}
+
+func (uc *AggregatorUseCase) ExportBoard(ctx context.Context, id string) (json.RawMessage, error) {
+	header := "ExportBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	export, err := uc.todoSvc.ExportBoard(ctx, id)
+
+	if err != nil {
+		info := "Failed to export board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully exported board", "size", len(export))
+
+	return export, nil
+}
+
+func (uc *AggregatorUseCase) ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error) {
+	header := "ImportBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "size", len(export))
+
+	board, err := uc.todoSvc.ImportBoard(ctx, export)
+
+	if err != nil {
+		info := "Failed to import board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully imported board", "id", board.ID)
+
+	return board, nil
+}
+
+func (uc *AggregatorUseCase) ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error) {
+	header := "ImportTrelloBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "size", len(trello), "dryRun", dryRun)
+
+	summary, err := uc.todoSvc.ImportTrelloBoard(ctx, trello, dryRun)
+
+	if err != nil {
+		info := "Failed to import trello board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully imported trello board", "summary", summary)
+
+	return summary, nil
+}
+
+func (uc *AggregatorUseCase) SearchCards(ctx context.Context, userID string, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error) {
+	header := "SearchCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "userID", userID, "search", search, "limit", limit, "offset", offset)
+
+	matches, err := uc.todoSvc.SearchCards(ctx, userID, search, limit, offset)
+
+	if err != nil {
+		info := "Failed to search cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successfully searched cards", "count", len(matches))
+
+	return matches, nil
+}
+
+func (uc *AggregatorUseCase) UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error) {
+	header := "UploadAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "cardID", cardID, "filename", filename, "size", size)
+
+	attachment, err := uc.todoSvc.UploadAttachment(ctx, cardID, filename, size, content)
+
+	if err != nil {
+		info := "Failed to upload attachment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Attachment successfully uploaded", "attachment", attachment)
+
+	return attachment, nil
+}
+
+func (uc *AggregatorUseCase) GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error) {
+	header := "GetAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	content, err := uc.todoSvc.GetAttachment(ctx, id)
+
+	if err != nil {
+		info := "Failed to get attachment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got attachment", "contentType", content.ContentType, "size", content.Size)
+
+	return content, nil
+}
+
+func (uc *AggregatorUseCase) DeleteAttachment(ctx context.Context, id string) error {
+	header := "DeleteAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "id", id)
+
+	err := uc.todoSvc.DeleteAttachment(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete attachment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Attachment successfully deleted")
+
+	return nil
+}
+
+func (uc *AggregatorUseCase) RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error) {
+	header := "RunBatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to todo service", "mode", req.Mode, "count", len(req.Operations))
+
+	batch, err := uc.todoSvc.RunBatch(ctx, req)
+
+	if err != nil {
+		info := "Failed to run batch"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Batch run", "results", batch.Results)
+
+	return batch, nil
+}
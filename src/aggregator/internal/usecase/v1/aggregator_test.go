@@ -1,8 +1,10 @@
 package v1_test
 
 import (
+	"aggregator/internal/common/logger"
 	"aggregator/internal/dto"
 	"aggregator/internal/entity"
+	"aggregator/internal/service/todo"
 	"aggregator/internal/usecase"
 	v1 "aggregator/internal/usecase/v1"
 	"aggregator/mocks"
@@ -17,6 +19,15 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
 type testSetup struct {
 	ctx         context.Context
 	mockUserSvc *mocks.UserService
@@ -32,7 +43,7 @@ func setup() *testSetup {
 	mockAuthSvc := new(mocks.AuthService)
 	mockTodoSvc := new(mocks.TodoService)
 
-	aggregatorUseCase := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc)
+	aggregatorUseCase := v1.NewAggregatorUseCase(mockUserSvc, mockAuthSvc, mockTodoSvc, nopLogger{})
 
 	return &testSetup{
 		ctx:         ctx,
@@ -324,7 +335,7 @@ func TestGetStats(t *testing.T) {
 			mockUserFn: func(from, to time.Time, users []dto.User) {},
 			mockTodoFn: func(from, to time.Time, users []dto.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrInvalidTimeRange.Error(),
+			errMsg:     "GetStats: Validation failed: " + v1.ErrInvalidTimeRange.Error(),
 		},
 		{
 			name:       "failed to get new users",
@@ -336,7 +347,7 @@ func TestGetStats(t *testing.T) {
 			mockUserFn: mockUserFnErr,
 			mockTodoFn: mockTodoFnOk,
 			wantErr:    true,
-			errMsg:     v1.ErrGetNewUsers.Error(),
+			errMsg:     "GetStats: Failed to get new users: ",
 		},
 		{
 			name:       "failed to get new cards",
@@ -348,7 +359,7 @@ func TestGetStats(t *testing.T) {
 			mockUserFn: mockUserFnOk,
 			mockTodoFn: mockTodoFnErr,
 			wantErr:    true,
-			errMsg:     v1.ErrGetNewCards.Error(),
+			errMsg:     "GetStats: Failed to get new cards: ",
 		},
 	}
 
@@ -384,6 +395,228 @@ func TestGetStats(t *testing.T) {
 	}
 }
 
+func TestGetSharedBoard(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		token      string
+		tree       *dto.BoardTree
+		mockTodoFn func(token string, tree *dto.BoardTree)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success",
+			token: "token",
+			tree:  &dto.BoardTree{Board: dto.Board{ID: uuid.New(), IsPublic: true}},
+			mockTodoFn: func(token string, tree *dto.BoardTree) {
+				ts.mockTodoSvc.On("GetSharedBoard", ts.ctx, token).Return(tree, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "revoked link is reported as not found",
+			token: "revoked",
+			mockTodoFn: func(token string, tree *dto.BoardTree) {
+				ts.mockTodoSvc.On("GetSharedBoard", ts.ctx, token).Return(nil, todo.ErrNotFound)
+			},
+			wantErr: true,
+			err:     todo.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockTodoFn(tt.token, tt.tree)
+
+			tree, err := ts.uc.GetSharedBoard(ts.ctx, tt.token)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, tree)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.tree, tree)
+			}
+		})
+	}
+}
+
+func TestAssignCard(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		userID     string
+		mockUserFn func(userID string)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			userID: uuid.NewString(),
+			mockUserFn: func(userID string) {
+				id := uuid.MustParse(userID)
+				ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, []uuid.UUID{id}).Return([]dto.User{{ID: id, Username: "alice"}}, nil)
+				ts.mockTodoSvc.On("AssignCard", ts.ctx, "card", userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "unknown user",
+			userID: uuid.NewString(),
+			mockUserFn: func(userID string) {
+				ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, []uuid.UUID{uuid.MustParse(userID)}).Return([]dto.User{}, nil)
+			},
+			wantErr: true,
+			err:     todo.ErrNotFound,
+		},
+		{
+			name:       "invalid user id",
+			userID:     "alice",
+			mockUserFn: func(userID string) {},
+			wantErr:    true,
+			err:        todo.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockUserFn(tt.userID)
+
+			err := ts.uc.AssignCard(ts.ctx, "card", tt.userID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockTodoSvc.AssertNotCalled(t, "AssignCard", ts.ctx, "card", tt.userID)
+			} else {
+				assert.Nil(t, err)
+				ts.mockTodoSvc.AssertCalled(t, "AssignCard", ts.ctx, "card", tt.userID)
+			}
+		})
+	}
+}
+
+func TestCardsComeWithAssigneeUsernames(t *testing.T) {
+	ts := setup()
+
+	alice, bob := uuid.New(), uuid.New()
+	cards := []dto.Card{
+		{ID: uuid.New(), AssigneeIDs: []uuid.UUID{alice}},
+		{ID: uuid.New()},
+		{ID: uuid.New(), AssigneeIDs: []uuid.UUID{bob, alice}},
+	}
+
+	ts.mockTodoSvc.On("GetCards", ts.ctx, "column", "").Return(cards, nil)
+	ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, []uuid.UUID{alice, bob}).Return([]dto.User{
+		{ID: alice, Username: "alice"},
+	}, nil)
+
+	got, err := ts.uc.GetCards(ts.ctx, "column", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []dto.Assignee{{ID: alice, Username: "alice"}}, got[0].Assignees)
+	assert.Empty(t, got[1].Assignees)
+	assert.Equal(t, []dto.Assignee{{ID: bob}, {ID: alice, Username: "alice"}}, got[2].Assignees)
+	ts.mockUserSvc.AssertNumberOfCalls(t, "GetUsersByIDs", 1)
+}
+
+func TestCardsWithoutUserService(t *testing.T) {
+	ts := setup()
+
+	alice := uuid.New()
+	cards := []dto.Card{{ID: uuid.New(), AssigneeIDs: []uuid.UUID{alice}}}
+
+	ts.mockTodoSvc.On("GetAssignedCards", ts.ctx, alice.String()).Return(cards, nil)
+	ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, mock.Anything).Return(nil, errors.New(""))
+
+	got, err := ts.uc.GetAssignedCards(ts.ctx, alice.String())
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{alice}, got[0].AssigneeIDs)
+	assert.Empty(t, got[0].Assignees)
+}
+
+func TestCardsWithoutAssignees(t *testing.T) {
+	ts := setup()
+
+	ts.mockTodoSvc.On("GetOverdueCards", ts.ctx, "user").Return([]dto.Card{{ID: uuid.New()}}, nil)
+
+	_, err := ts.uc.GetOverdueCards(ts.ctx, "user")
+
+	assert.Nil(t, err)
+	ts.mockUserSvc.AssertNotCalled(t, "GetUsersByIDs", mock.Anything, mock.Anything)
+}
+
+func TestActivityComesWithActorUsernames(t *testing.T) {
+	ts := setup()
+
+	alice, bob := uuid.New(), uuid.New()
+	activities := []dto.Activity{{ActorID: alice}, {ActorID: bob}, {ActorID: alice}}
+
+	ts.mockTodoSvc.On("GetBoardActivity", ts.ctx, "board", 20, 0).Return(activities, nil)
+	ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, []uuid.UUID{alice, bob}).Return([]dto.User{
+		{ID: alice, Username: "alice"},
+	}, nil)
+
+	got, err := ts.uc.GetBoardActivity(ts.ctx, "board", 20, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", got[0].Actor)
+	assert.Empty(t, got[1].Actor)
+	assert.Equal(t, "alice", got[2].Actor)
+}
+
+func TestRevisionDiffComesWithAuthorUsernames(t *testing.T) {
+	ts := setup()
+
+	alice, bob := uuid.New(), uuid.New()
+	diff := &dto.RevisionDiff{
+		From: dto.CardRevision{Number: 1, AuthorID: alice},
+		To:   dto.CardRevision{Number: 3, AuthorID: bob},
+	}
+
+	ts.mockTodoSvc.On("DiffCardRevisions", ts.ctx, "card", 1, 3).Return(diff, nil)
+	ts.mockUserSvc.On("GetUsersByIDs", ts.ctx, []uuid.UUID{alice, bob}).Return([]dto.User{
+		{ID: alice, Username: "alice"},
+		{ID: bob, Username: "bob"},
+	}, nil)
+
+	got, err := ts.uc.DiffCardRevisions(ts.ctx, "card", 1, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "alice", got.From.Author)
+	assert.Equal(t, "bob", got.To.Author)
+}
+
+func TestBoardViewComesWithLabels(t *testing.T) {
+	ts := setup()
+
+	page := dto.PageRequest{Limit: 1}
+	columns := &dto.ColumnPage{Columns: []dto.Column{{ID: uuid.New()}}, NextCursor: "next"}
+	labels := []dto.Label{{ID: uuid.New(), Name: "bug", Color: "red"}}
+
+	ts.mockTodoSvc.On("GetColumnsPage", ts.ctx, "board", false, page).Return(columns, nil)
+	ts.mockTodoSvc.On("GetLabels", ts.ctx, "board").Return(labels, nil)
+
+	got, err := ts.uc.GetBoardPage(ts.ctx, "board", false, page)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &dto.BoardView{Columns: columns.Columns, Labels: labels, NextCursor: "next"}, got)
+}
+
+func TestBoardViewWithoutLabels(t *testing.T) {
+	ts := setup()
+
+	ts.mockTodoSvc.On("GetColumns", ts.ctx, "board", true).Return([]dto.Column{}, nil)
+	ts.mockTodoSvc.On("GetLabels", ts.ctx, "board").Return(nil, todo.ErrNotFound)
+
+	_, err := ts.uc.GetBoard(ts.ctx, "board", true)
+
+	assert.ErrorIs(t, err, todo.ErrNotFound)
+}
+
 type ComparableStats struct {
 	Date               time.Time
 	NumUsers           int
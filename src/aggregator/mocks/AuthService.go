@@ -1,14 +1,157 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	dto "aggregator/internal/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // AuthService is an autogenerated mock type for the AuthService type
 type AuthService struct {
 	mock.Mock
 }
 
+// Login provides a mock function with given fields: ctx, email, password
+func (_m *AuthService) Login(ctx context.Context, email string, password string) (*dto.Tokens, error) {
+	ret := _m.Called(ctx, email, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *dto.Tokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Tokens, error)); ok {
+		return rf(ctx, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Tokens); ok {
+		r0 = rf(ctx, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Tokens)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *AuthService) Refresh(ctx context.Context, refreshToken string) (*dto.RefreshResponse, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *dto.RefreshResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.RefreshResponse, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.RefreshResponse); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RefreshResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Register provides a mock function with given fields: ctx, username, email, password
+func (_m *AuthService) Register(ctx context.Context, username string, email string, password string) (*dto.Tokens, error) {
+	ret := _m.Called(ctx, username, email, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *dto.Tokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*dto.Tokens, error)); ok {
+		return rf(ctx, username, email, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *dto.Tokens); ok {
+		r0 = rf(ctx, username, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Tokens)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, username, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateToken provides a mock function with given fields: ctx, token
+func (_m *AuthService) ValidateToken(ctx context.Context, token string) (*dto.ValidateTokenResponse, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateToken")
+	}
+
+	var r0 *dto.ValidateTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.ValidateTokenResponse, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ValidateTokenResponse); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ValidateTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAuthService creates a new instance of AuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthService(t interface {
@@ -1,10 +1,13 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
 import (
 	dto "aggregator/internal/dto"
 	context "context"
+	io "io"
+
+	jsontext "encoding/json/jsontext"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -16,29 +19,2021 @@ type TodoService struct {
 	mock.Mock
 }
 
-// GetNewCards provides a mock function with given fields: ctx, from, to
-func (_m *TodoService) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]dto.Card, error) {
-	ret := _m.Called(ctx, from, to)
+// AcceptInvitation provides a mock function with given fields: ctx, boardID
+func (_m *TodoService) AcceptInvitation(ctx context.Context, boardID string) (*dto.BoardMember, error) {
+	ret := _m.Called(ctx, boardID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNewCards")
+		panic("no return value specified for AcceptInvitation")
 	}
 
-	var r0 []dto.Card
+	var r0 *dto.BoardMember
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]dto.Card, error)); ok {
-		return rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.BoardMember, error)); ok {
+		return rf(ctx, boardID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []dto.Card); ok {
-		r0 = rf(ctx, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.BoardMember); ok {
+		r0 = rf(ctx, boardID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Card)
+			r0 = ret.Get(0).(*dto.BoardMember)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, from, to)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveBoard provides a mock function with given fields: ctx, id
+func (_m *TodoService) ArchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveBoard")
+	}
+
+	var r0 *dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Board, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Board); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveColumn provides a mock function with given fields: ctx, id
+func (_m *TodoService) ArchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveColumn")
+	}
+
+	var r0 *dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Column, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Column); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignCard provides a mock function with given fields: ctx, cardID, userID
+func (_m *TodoService) AssignCard(ctx context.Context, cardID string, userID string) error {
+	ret := _m.Called(ctx, cardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, cardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *TodoService) AttachLabel(ctx context.Context, cardID string, labelID string) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloneBoard provides a mock function with given fields: ctx, boardID, req
+func (_m *TodoService) CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error) {
+	ret := _m.Called(ctx, boardID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneBoard")
+	}
+
+	var r0 *dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CloneBoardRequest) (*dto.Board, error)); ok {
+		return rf(ctx, boardID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CloneBoardRequest) *dto.Board); ok {
+		r0 = rf(ctx, boardID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.CloneBoardRequest) error); ok {
+		r1 = rf(ctx, boardID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBoard provides a mock function with given fields: ctx, board
+func (_m *TodoService) CreateBoard(ctx context.Context, board dto.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCard provides a mock function with given fields: ctx, card
+func (_m *TodoService) CreateCard(ctx context.Context, card *dto.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChecklist provides a mock function with given fields: ctx, cardID, title
+func (_m *TodoService) CreateChecklist(ctx context.Context, cardID string, title string) (*dto.Checklist, error) {
+	ret := _m.Called(ctx, cardID, title)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklist")
+	}
+
+	var r0 *dto.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Checklist, error)); ok {
+		return rf(ctx, cardID, title)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Checklist); ok {
+		r0 = rf(ctx, cardID, title)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, cardID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateChecklistItem provides a mock function with given fields: ctx, checklistID, text
+func (_m *TodoService) CreateChecklistItem(ctx context.Context, checklistID string, text string) (*dto.ChecklistItem, error) {
+	ret := _m.Called(ctx, checklistID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklistItem")
+	}
+
+	var r0 *dto.ChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.ChecklistItem, error)); ok {
+		return rf(ctx, checklistID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.ChecklistItem); ok {
+		r0 = rf(ctx, checklistID, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, checklistID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateColumn provides a mock function with given fields: ctx, column
+func (_m *TodoService) CreateColumn(ctx context.Context, column dto.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateComment provides a mock function with given fields: ctx, cardID, body
+func (_m *TodoService) CreateComment(ctx context.Context, cardID string, body string) (*dto.Comment, error) {
+	ret := _m.Called(ctx, cardID, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *dto.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Comment, error)); ok {
+		return rf(ctx, cardID, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Comment); ok {
+		r0 = rf(ctx, cardID, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, cardID, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLabel provides a mock function with given fields: ctx, boardID, req
+func (_m *TodoService) CreateLabel(ctx context.Context, boardID string, req dto.LabelRequest) (*dto.Label, error) {
+	ret := _m.Called(ctx, boardID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 *dto.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LabelRequest) (*dto.Label, error)); ok {
+		return rf(ctx, boardID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LabelRequest) *dto.Label); ok {
+		r0 = rf(ctx, boardID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.LabelRequest) error); ok {
+		r1 = rf(ctx, boardID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, boardID
+func (_m *TodoService) CreateShareToken(ctx context.Context, boardID string) (*dto.ShareToken, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareToken")
+	}
+
+	var r0 *dto.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.ShareToken, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ShareToken); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAttachment provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteAttachment(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBoard provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteBoard(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCard provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteCard(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteColumn provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteColumn(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComment provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteComment(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteLabel provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteLabel(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DetachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *TodoService) DetachLabel(ctx context.Context, cardID string, labelID string) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiffCardRevisions provides a mock function with given fields: ctx, cardID, from, to
+func (_m *TodoService) DiffCardRevisions(ctx context.Context, cardID string, from int, to int) (*dto.RevisionDiff, error) {
+	ret := _m.Called(ctx, cardID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffCardRevisions")
+	}
+
+	var r0 *dto.RevisionDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (*dto.RevisionDiff, error)); ok {
+		return rf(ctx, cardID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) *dto.RevisionDiff); ok {
+		r0 = rf(ctx, cardID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RevisionDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, cardID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportBoard provides a mock function with given fields: ctx, id
+func (_m *TodoService) ExportBoard(ctx context.Context, id string) (jsontext.Value, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBoard")
+	}
+
+	var r0 jsontext.Value
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (jsontext.Value, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) jsontext.Value); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignedCards provides a mock function with given fields: ctx, userID
+func (_m *TodoService) GetAssignedCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignedCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Card, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Card); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttachment provides a mock function with given fields: ctx, id
+func (_m *TodoService) GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *dto.AttachmentContent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.AttachmentContent, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.AttachmentContent); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AttachmentContent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardActivity provides a mock function with given fields: ctx, boardID, limit, offset
+func (_m *TodoService) GetBoardActivity(ctx context.Context, boardID string, limit int, offset int) ([]dto.Activity, error) {
+	ret := _m.Called(ctx, boardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardActivity")
+	}
+
+	var r0 []dto.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]dto.Activity, error)); ok {
+		return rf(ctx, boardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []dto.Activity); ok {
+		r0 = rf(ctx, boardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, boardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardMembers provides a mock function with given fields: ctx, boardID
+func (_m *TodoService) GetBoardMembers(ctx context.Context, boardID string) ([]dto.BoardMember, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardMembers")
+	}
+
+	var r0 []dto.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.BoardMember, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.BoardMember); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoards provides a mock function with given fields: ctx, userID, archived
+func (_m *TodoService) GetBoards(ctx context.Context, userID string, archived bool) ([]dto.Board, error) {
+	ret := _m.Called(ctx, userID, archived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoards")
+	}
+
+	var r0 []dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]dto.Board, error)); ok {
+		return rf(ctx, userID, archived)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []dto.Board); ok {
+		r0 = rf(ctx, userID, archived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, userID, archived)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardsPage provides a mock function with given fields: ctx, userID, archived, page
+func (_m *TodoService) GetBoardsPage(ctx context.Context, userID string, archived bool, page dto.PageRequest) (*dto.BoardPage, error) {
+	ret := _m.Called(ctx, userID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardsPage")
+	}
+
+	var r0 *dto.BoardPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, dto.PageRequest) (*dto.BoardPage, error)); ok {
+		return rf(ctx, userID, archived, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, dto.PageRequest) *dto.BoardPage); ok {
+		r0 = rf(ctx, userID, archived, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, dto.PageRequest) error); ok {
+		r1 = rf(ctx, userID, archived, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCard provides a mock function with given fields: ctx, id
+func (_m *TodoService) GetCard(ctx context.Context, id string) (*dto.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardActivity provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoService) GetCardActivity(ctx context.Context, cardID string, limit int, offset int) ([]dto.Activity, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardActivity")
+	}
+
+	var r0 []dto.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]dto.Activity, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []dto.Activity); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardRevisions provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoService) GetCardRevisions(ctx context.Context, cardID string, limit int, offset int) ([]dto.CardRevision, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardRevisions")
+	}
+
+	var r0 []dto.CardRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]dto.CardRevision, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []dto.CardRevision); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CardRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCards provides a mock function with given fields: ctx, columnID, labelID
+func (_m *TodoService) GetCards(ctx context.Context, columnID string, labelID string) ([]dto.Card, error) {
+	ret := _m.Called(ctx, columnID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.Card, error)); ok {
+		return rf(ctx, columnID, labelID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []dto.Card); ok {
+		r0 = rf(ctx, columnID, labelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, columnID, labelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsPage provides a mock function with given fields: ctx, columnID, labelID, page
+func (_m *TodoService) GetCardsPage(ctx context.Context, columnID string, labelID string, page dto.PageRequest) (*dto.CardPage, error) {
+	ret := _m.Called(ctx, columnID, labelID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsPage")
+	}
+
+	var r0 *dto.CardPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.PageRequest) (*dto.CardPage, error)); ok {
+		return rf(ctx, columnID, labelID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.PageRequest) *dto.CardPage); ok {
+		r0 = rf(ctx, columnID, labelID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CardPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, dto.PageRequest) error); ok {
+		r1 = rf(ctx, columnID, labelID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChecklists provides a mock function with given fields: ctx, cardID
+func (_m *TodoService) GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklists")
+	}
+
+	var r0 []dto.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Checklist, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Checklist); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumns provides a mock function with given fields: ctx, boardID, archived
+func (_m *TodoService) GetColumns(ctx context.Context, boardID string, archived bool) ([]dto.Column, error) {
+	ret := _m.Called(ctx, boardID, archived)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumns")
+	}
+
+	var r0 []dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]dto.Column, error)); ok {
+		return rf(ctx, boardID, archived)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []dto.Column); ok {
+		r0 = rf(ctx, boardID, archived)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, boardID, archived)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnsPage provides a mock function with given fields: ctx, boardID, archived, page
+func (_m *TodoService) GetColumnsPage(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.ColumnPage, error) {
+	ret := _m.Called(ctx, boardID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnsPage")
+	}
+
+	var r0 *dto.ColumnPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, dto.PageRequest) (*dto.ColumnPage, error)); ok {
+		return rf(ctx, boardID, archived, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, dto.PageRequest) *dto.ColumnPage); ok {
+		r0 = rf(ctx, boardID, archived, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ColumnPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, dto.PageRequest) error); ok {
+		r1 = rf(ctx, boardID, archived, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComments provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoService) GetComments(ctx context.Context, cardID string, limit int, offset int) ([]dto.Comment, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 []dto.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]dto.Comment, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []dto.Comment); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueCards provides a mock function with given fields: ctx, userID, days
+func (_m *TodoService) GetDueCards(ctx context.Context, userID string, days int) ([]dto.Card, error) {
+	ret := _m.Called(ctx, userID, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]dto.Card, error)); ok {
+		return rf(ctx, userID, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []dto.Card); ok {
+		r0 = rf(ctx, userID, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userID, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvitations provides a mock function with given fields: ctx, userID
+func (_m *TodoService) GetInvitations(ctx context.Context, userID string) ([]dto.BoardMember, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitations")
+	}
+
+	var r0 []dto.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.BoardMember, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.BoardMember); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLabels provides a mock function with given fields: ctx, boardID
+func (_m *TodoService) GetLabels(ctx context.Context, boardID string) ([]dto.Label, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabels")
+	}
+
+	var r0 []dto.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Label, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Label); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNewCards provides a mock function with given fields: ctx, from, to
+func (_m *TodoService) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]dto.Card, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]dto.Card, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []dto.Card); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverdueCards provides a mock function with given fields: ctx, userID
+func (_m *TodoService) GetOverdueCards(ctx context.Context, userID string) ([]dto.Card, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueCards")
+	}
+
+	var r0 []dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Card, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Card); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShareTokens provides a mock function with given fields: ctx, boardID
+func (_m *TodoService) GetShareTokens(ctx context.Context, boardID string) ([]dto.ShareToken, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareTokens")
+	}
+
+	var r0 []dto.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.ShareToken, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.ShareToken); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSharedBoard provides a mock function with given fields: ctx, token
+func (_m *TodoService) GetSharedBoard(ctx context.Context, token string) (*dto.BoardTree, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedBoard")
+	}
+
+	var r0 *dto.BoardTree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.BoardTree, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.BoardTree); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardTree)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSharedCard provides a mock function with given fields: ctx, token, cardID
+func (_m *TodoService) GetSharedCard(ctx context.Context, token string, cardID string) (*dto.Card, error) {
+	ret := _m.Called(ctx, token, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Card, error)); ok {
+		return rf(ctx, token, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Card); ok {
+		r0 = rf(ctx, token, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplates provides a mock function with given fields: ctx, userID
+func (_m *TodoService) GetTemplates(ctx context.Context, userID string) ([]dto.Board, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplates")
+	}
+
+	var r0 []dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dto.Board, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dto.Board); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrash provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *TodoService) GetTrash(ctx context.Context, userID string, limit int, offset int) ([]dto.TrashItem, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []dto.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]dto.TrashItem, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []dto.TrashItem); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportBoard provides a mock function with given fields: ctx, export
+func (_m *TodoService) ImportBoard(ctx context.Context, export jsontext.Value) (*dto.Board, error) {
+	ret := _m.Called(ctx, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportBoard")
+	}
+
+	var r0 *dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, jsontext.Value) (*dto.Board, error)); ok {
+		return rf(ctx, export)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, jsontext.Value) *dto.Board); ok {
+		r0 = rf(ctx, export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, jsontext.Value) error); ok {
+		r1 = rf(ctx, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTrelloBoard provides a mock function with given fields: ctx, trello, dryRun
+func (_m *TodoService) ImportTrelloBoard(ctx context.Context, trello jsontext.Value, dryRun bool) (*dto.ImportSummary, error) {
+	ret := _m.Called(ctx, trello, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTrelloBoard")
+	}
+
+	var r0 *dto.ImportSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, jsontext.Value, bool) (*dto.ImportSummary, error)); ok {
+		return rf(ctx, trello, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, jsontext.Value, bool) *dto.ImportSummary); ok {
+		r0 = rf(ctx, trello, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ImportSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, jsontext.Value, bool) error); ok {
+		r1 = rf(ctx, trello, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InviteMember provides a mock function with given fields: ctx, boardID, req
+func (_m *TodoService) InviteMember(ctx context.Context, boardID string, req dto.InviteMemberRequest) (*dto.BoardMember, error) {
+	ret := _m.Called(ctx, boardID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteMember")
+	}
+
+	var r0 *dto.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.InviteMemberRequest) (*dto.BoardMember, error)); ok {
+		return rf(ctx, boardID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.InviteMemberRequest) *dto.BoardMember); ok {
+		r0 = rf(ctx, boardID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.InviteMemberRequest) error); ok {
+		r1 = rf(ctx, boardID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCard provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) MoveCard(ctx context.Context, id string, req dto.MoveCardRequest) (*dto.Card, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.MoveCardRequest) (*dto.Card, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.MoveCardRequest) *dto.Card); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.MoveCardRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: ctx, boardID, userID
+func (_m *TodoService) RemoveMember(ctx context.Context, boardID string, userID string) error {
+	ret := _m.Called(ctx, boardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, boardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositionCard provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) RepositionCard(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Card, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositionCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.RepositionRequest) (*dto.Card, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.RepositionRequest) *dto.Card); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.RepositionRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositionColumn provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) RepositionColumn(ctx context.Context, id string, req dto.RepositionRequest) (*dto.Column, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositionColumn")
+	}
+
+	var r0 *dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.RepositionRequest) (*dto.Column, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.RepositionRequest) *dto.Column); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.RepositionRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreItem provides a mock function with given fields: ctx, id
+func (_m *TodoService) RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreItem")
+	}
+
+	var r0 *dto.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.TrashItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.TrashItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevertCard provides a mock function with given fields: ctx, cardID, number
+func (_m *TodoService) RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error) {
+	ret := _m.Called(ctx, cardID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertCard")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (*dto.Card, error)); ok {
+		return rf(ctx, cardID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *dto.Card); ok {
+		r0 = rf(ctx, cardID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, cardID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeShareToken provides a mock function with given fields: ctx, boardID, token
+func (_m *TodoService) RevokeShareToken(ctx context.Context, boardID string, token string) error {
+	ret := _m.Called(ctx, boardID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, boardID, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunBatch provides a mock function with given fields: ctx, req
+func (_m *TodoService) RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunBatch")
+	}
+
+	var r0 *dto.BatchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.BatchRequest) (*dto.BatchResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.BatchRequest) *dto.BatchResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BatchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.BatchRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchCards provides a mock function with given fields: ctx, userID, search, limit, offset
+func (_m *TodoService) SearchCards(ctx context.Context, userID string, search dto.CardSearch, limit int, offset int) ([]dto.CardMatch, error) {
+	ret := _m.Called(ctx, userID, search, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCards")
+	}
+
+	var r0 []dto.CardMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CardSearch, int, int) ([]dto.CardMatch, error)); ok {
+		return rf(ctx, userID, search, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CardSearch, int, int) []dto.CardMatch); ok {
+		r0 = rf(ctx, userID, search, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CardMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.CardSearch, int, int) error); ok {
+		r1 = rf(ctx, userID, search, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetBoardPublic provides a mock function with given fields: ctx, boardID, isPublic
+func (_m *TodoService) SetBoardPublic(ctx context.Context, boardID string, isPublic bool) error {
+	ret := _m.Called(ctx, boardID, isPublic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBoardPublic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, boardID, isPublic)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCardDates provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) SetCardDates(ctx context.Context, id string, req dto.SetCardDatesRequest) (*dto.Card, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCardDates")
+	}
+
+	var r0 *dto.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.SetCardDatesRequest) (*dto.Card, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.SetCardDatesRequest) *dto.Card); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.SetCardDatesRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetChecklistItemDone provides a mock function with given fields: ctx, itemID, done
+func (_m *TodoService) SetChecklistItemDone(ctx context.Context, itemID string, done bool) (*dto.ChecklistItem, error) {
+	ret := _m.Called(ctx, itemID, done)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChecklistItemDone")
+	}
+
+	var r0 *dto.ChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*dto.ChecklistItem, error)); ok {
+		return rf(ctx, itemID, done)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *dto.ChecklistItem); ok {
+		r0 = rf(ctx, itemID, done)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, itemID, done)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetColumnWIPLimit provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetColumnWIPLimit")
+	}
+
+	var r0 *dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.SetColumnWIPLimitRequest) (*dto.Column, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.SetColumnWIPLimitRequest) *dto.Column); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.SetColumnWIPLimitRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamBoardEvents provides a mock function with given fields: ctx, boardID, lastEventID
+func (_m *TodoService) StreamBoardEvents(ctx context.Context, boardID string, lastEventID string) (<-chan dto.BoardEvent, error) {
+	ret := _m.Called(ctx, boardID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamBoardEvents")
+	}
+
+	var r0 <-chan dto.BoardEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (<-chan dto.BoardEvent, error)); ok {
+		return rf(ctx, boardID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) <-chan dto.BoardEvent); ok {
+		r0 = rf(ctx, boardID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan dto.BoardEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, boardID, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnarchiveBoard provides a mock function with given fields: ctx, id
+func (_m *TodoService) UnarchiveBoard(ctx context.Context, id string) (*dto.Board, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveBoard")
+	}
+
+	var r0 *dto.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Board, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Board); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnarchiveColumn provides a mock function with given fields: ctx, id
+func (_m *TodoService) UnarchiveColumn(ctx context.Context, id string) (*dto.Column, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveColumn")
+	}
+
+	var r0 *dto.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*dto.Column, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.Column); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnassignCard provides a mock function with given fields: ctx, cardID, userID
+func (_m *TodoService) UnassignCard(ctx context.Context, cardID string, userID string) error {
+	ret := _m.Called(ctx, cardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, cardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBoard provides a mock function with given fields: ctx, board
+func (_m *TodoService) UpdateBoard(ctx context.Context, board *dto.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCard provides a mock function with given fields: ctx, card
+func (_m *TodoService) UpdateCard(ctx context.Context, card *dto.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateColumn provides a mock function with given fields: ctx, column
+func (_m *TodoService) UpdateColumn(ctx context.Context, column *dto.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateComment provides a mock function with given fields: ctx, id, body
+func (_m *TodoService) UpdateComment(ctx context.Context, id string, body string) (*dto.Comment, error) {
+	ret := _m.Called(ctx, id, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *dto.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*dto.Comment, error)); ok {
+		return rf(ctx, id, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *dto.Comment); ok {
+		r0 = rf(ctx, id, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, id, req
+func (_m *TodoService) UpdateLabel(ctx context.Context, id string, req dto.LabelRequest) (*dto.Label, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 *dto.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LabelRequest) (*dto.Label, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.LabelRequest) *dto.Label); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.LabelRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMemberRole provides a mock function with given fields: ctx, boardID, userID, role
+func (_m *TodoService) UpdateMemberRole(ctx context.Context, boardID string, userID string, role string) (*dto.BoardMember, error) {
+	ret := _m.Called(ctx, boardID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberRole")
+	}
+
+	var r0 *dto.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*dto.BoardMember, error)); ok {
+		return rf(ctx, boardID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *dto.BoardMember); ok {
+		r0 = rf(ctx, boardID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, boardID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, cardID, filename, size, content
+func (_m *TodoService) UploadAttachment(ctx context.Context, cardID string, filename string, size int64, content io.Reader) (*dto.Attachment, error) {
+	ret := _m.Called(ctx, cardID, filename, size, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 *dto.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, io.Reader) (*dto.Attachment, error)); ok {
+		return rf(ctx, cardID, filename, size, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, io.Reader) *dto.Attachment); ok {
+		r0 = rf(ctx, cardID, filename, size, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, io.Reader) error); ok {
+		r1 = rf(ctx, cardID, filename, size, content)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -46,6 +48,36 @@ func (_m *UserService) GetNewUsers(ctx context.Context, from time.Time, to time.
 	return r0, r1
 }
 
+// GetUsersByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserService) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]dto.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByIDs")
+	}
+
+	var r0 []dto.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]dto.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []dto.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserService(t interface {
@@ -10,6 +10,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
 	"time"
 	_ "time/tzdata"
 
@@ -186,6 +189,51 @@ func main() {
 		},
 	}
 	createCmd.AddCommand(createCardCmd)
+
+	// Create checklist command
+	createChecklistCmd := &cobra.Command{
+		Use:   "checklist [card_id] [title]",
+		Short: "Create a new checklist on a card",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateChecklist(ctx, args[0], args[1])
+		},
+	}
+	createCmd.AddCommand(createChecklistCmd)
+
+	// Create checklist item command
+	createItemCmd := &cobra.Command{
+		Use:   "item [checklist_id] [text]",
+		Short: "Add a new item to a checklist",
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.AddChecklistItem(ctx, args[0], strings.Join(args[1:], " "))
+		},
+	}
+	createCmd.AddCommand(createItemCmd)
+
+	// Create comment command
+	createCommentCmd := &cobra.Command{
+		Use:   "comment [card_id] [text]",
+		Short: "Post a comment on a card",
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.CreateComment(ctx, args[0], strings.Join(args[1:], " "))
+		},
+	}
+	createCmd.AddCommand(createCommentCmd)
 	rootCmd.AddCommand(createCmd)
 
 	// Show command
@@ -195,6 +243,8 @@ func main() {
 	}
 
 	// Show boards command
+	var showArchivedBoards bool
+	var boardsPage dto.PageRequest
 	showBoardsCmd := &cobra.Command{
 		Use:   "boards",
 		Short: "Show all boards",
@@ -203,12 +253,17 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.ShowBoards(ctx)
+			client.ShowBoards(ctx, showArchivedBoards, boardsPage)
 		},
 	}
+	showBoardsCmd.Flags().BoolVar(&showArchivedBoards, "archived", false, "Show archived boards instead")
+	showBoardsCmd.Flags().IntVar(&boardsPage.Limit, "limit", 20, "Number of boards to show")
+	showBoardsCmd.Flags().StringVar(&boardsPage.Cursor, "cursor", "", "Show the page after this cursor")
 	showCmd.AddCommand(showBoardsCmd)
 
 	// Show board command
+	var showArchivedColumns bool
+	var columnsPage dto.PageRequest
 	showBoardCmd := &cobra.Command{
 		Use:   "board [board_id]",
 		Short: "Show a board",
@@ -218,12 +273,16 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.ShowBoard(ctx, args[0])
+			client.ShowBoard(ctx, args[0], showArchivedColumns, columnsPage)
 		},
 	}
+	showBoardCmd.Flags().BoolVar(&showArchivedColumns, "archived", false, "Show archived columns instead")
+	showBoardCmd.Flags().IntVar(&columnsPage.Limit, "limit", 20, "Number of columns to show")
+	showBoardCmd.Flags().StringVar(&columnsPage.Cursor, "cursor", "", "Show the page after this cursor")
 	showCmd.AddCommand(showBoardCmd)
 
 	// Show column command
+	var cardsPage dto.PageRequest
 	showColumnCmd := &cobra.Command{
 		Use:   "column [column_id]",
 		Short: "Show a column",
@@ -233,9 +292,11 @@ func main() {
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.ShowColumn(ctx, args[0])
+			client.ShowColumn(ctx, args[0], cardsPage)
 		},
 	}
+	showColumnCmd.Flags().IntVar(&cardsPage.Limit, "limit", 20, "Number of cards to show")
+	showColumnCmd.Flags().StringVar(&cardsPage.Cursor, "cursor", "", "Show the page after this cursor")
 	showCmd.AddCommand(showColumnCmd)
 
 	// Show card command
@@ -252,6 +313,68 @@ func main() {
 		},
 	}
 	showCmd.AddCommand(showCardCmd)
+
+	// Show comments command
+	var commentsLimit, commentsOffset int
+	showCommentsCmd := &cobra.Command{
+		Use:   "comments [card_id]",
+		Short: "Show comments of a card",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowComments(ctx, args[0], commentsLimit, commentsOffset)
+		},
+	}
+	showCommentsCmd.Flags().IntVar(&commentsLimit, "limit", 20, "Number of comments to show")
+	showCommentsCmd.Flags().IntVar(&commentsOffset, "offset", 0, "Number of comments to skip")
+	showCmd.AddCommand(showCommentsCmd)
+
+	// Show overdue cards command
+	showOverdueCmd := &cobra.Command{
+		Use:   "overdue",
+		Short: "Show overdue cards of all your boards",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowOverdueCards(ctx)
+		},
+	}
+	showCmd.AddCommand(showOverdueCmd)
+
+	// Show due cards command
+	var dueDays int
+	showDueCmd := &cobra.Command{
+		Use:   "due",
+		Short: "Show cards of all your boards that are due soon",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowDueCards(ctx, dueDays)
+		},
+	}
+	showDueCmd.Flags().IntVar(&dueDays, "days", 7, "Show cards due within this many days")
+	showCmd.AddCommand(showDueCmd)
+
+	// Show assigned cards command
+	showAssignedCmd := &cobra.Command{
+		Use:   "assigned",
+		Short: "Show cards assigned to you on all your boards",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowAssignedCards(ctx)
+		},
+	}
+	showCmd.AddCommand(showAssignedCmd)
 	rootCmd.AddCommand(showCmd)
 
 	// Update command
@@ -302,6 +425,29 @@ func main() {
 		},
 	}
 	updateColumnCmd.AddCommand(updateColumnTitleCmd)
+
+	// Update column WIP limit command
+	var wipStrict bool
+	updateColumnWIPCmd := &cobra.Command{
+		Use:   "wip [column_id] [limit]",
+		Short: "Cap the number of cards of a column, 0 lifts the limit",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			limit, err := strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println("Error: the limit should be a number")
+				return
+			}
+
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.SetWIPLimit(ctx, args[0], limit, wipStrict)
+		},
+	}
+	updateColumnWIPCmd.Flags().BoolVar(&wipStrict, "strict", false, "Refuse cards once the column is full instead of warning")
+	updateColumnCmd.AddCommand(updateColumnWIPCmd)
 	updateCmd.AddCommand(updateColumnCmd)
 
 	// Update card command
@@ -339,6 +485,24 @@ func main() {
 		},
 	}
 	updateCardCmd.AddCommand(updateCardDescriptionCmd)
+
+	// Update card dates command
+	var cardStart, cardDue string
+	updateCardDatesCmd := &cobra.Command{
+		Use:   "dates [card_id]",
+		Short: "Set card start and due dates; an omitted date is cleared",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UpdateCardDates(ctx, args[0], cardStart, cardDue)
+		},
+	}
+	updateCardDatesCmd.Flags().StringVar(&cardStart, "start", "", "Start date, DD-MM-YYYY [HH:MM]")
+	updateCardDatesCmd.Flags().StringVar(&cardDue, "due", "", "Due date, DD-MM-YYYY [HH:MM]")
+	updateCardCmd.AddCommand(updateCardDatesCmd)
 	updateCmd.AddCommand(updateCardCmd)
 	rootCmd.AddCommand(updateCmd)
 
@@ -348,21 +512,119 @@ func main() {
 		Short: "Move stuff",
 	}
 
+	var moveBefore, moveAfter string
 	moveCardCmd := &cobra.Command{
 		Use:   "card [card_id] [column_id]",
-		Short: "Move card",
+		Short: "Move card to the end of a column or next to one of its cards",
 		Args:  cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
 			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
 				saveTokens(tokens, cfg.Client.TokensPath)
 			})
-			client.MoveCard(ctx, args[0], args[1])
+			client.MoveCard(ctx, args[0], args[1], moveBefore, moveAfter)
 		},
 	}
+	moveCardCmd.Flags().StringVar(&moveBefore, "before", "", "Place the card right before this card")
+	moveCardCmd.Flags().StringVar(&moveAfter, "after", "", "Place the card right after this card")
+	moveCardCmd.MarkFlagsMutuallyExclusive("before", "after")
 	moveCmd.AddCommand(moveCardCmd)
 	rootCmd.AddCommand(moveCmd)
 
+	// Assign command
+	assignCmd := &cobra.Command{
+		Use:   "assign [card_id] [user_id]",
+		Short: "Assign a board member to a card",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.AssignCard(ctx, args[0], args[1])
+		},
+	}
+	rootCmd.AddCommand(assignCmd)
+
+	// Unassign command
+	unassignCmd := &cobra.Command{
+		Use:   "unassign [card_id] [user_id]",
+		Short: "Remove an assignee from a card",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UnassignCard(ctx, args[0], args[1])
+		},
+	}
+	rootCmd.AddCommand(unassignCmd)
+
+	// Attach command
+	attachCmd := &cobra.Command{
+		Use:   "attach [card_id] [file]",
+		Short: "Upload a file to a card",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.AttachFile(ctx, args[0], args[1])
+		},
+	}
+	rootCmd.AddCommand(attachCmd)
+
+	// Download command
+	downloadCmd := &cobra.Command{
+		Use:   "download [attachment_id] [dest]",
+		Short: "Download an attachment to a file or directory",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			dest := ""
+			if len(args) == 2 {
+				dest = args[1]
+			}
+			client.DownloadAttachment(ctx, args[0], dest)
+		},
+	}
+	rootCmd.AddCommand(downloadCmd)
+
+	// Check command
+	checkCmd := &cobra.Command{
+		Use:   "check [item_id]",
+		Short: "Mark a checklist item as done",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.SetChecklistItemDone(ctx, args[0], true)
+		},
+	}
+	rootCmd.AddCommand(checkCmd)
+
+	// Uncheck command
+	uncheckCmd := &cobra.Command{
+		Use:   "uncheck [item_id]",
+		Short: "Mark a checklist item as not done",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.SetChecklistItemDone(ctx, args[0], false)
+		},
+	}
+	rootCmd.AddCommand(uncheckCmd)
+
 	// Delete command
 	deleteCmd := &cobra.Command{
 		Use:   "delete",
@@ -413,8 +675,427 @@ func main() {
 		},
 	}
 	deleteCmd.AddCommand(deleteCardCmd)
+
+	// Delete attachment command
+	deleteAttachmentCmd := &cobra.Command{
+		Use:   "attachment [attachment_id]",
+		Short: "Delete an attachment",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.DeleteAttachment(ctx, args[0])
+		},
+	}
+	deleteCmd.AddCommand(deleteAttachmentCmd)
 	rootCmd.AddCommand(deleteCmd)
 
+	// Trash command
+	var trashLimit, trashOffset int
+	trashCmd := &cobra.Command{
+		Use:   "trash",
+		Short: "Show deleted boards, columns and cards, newest first",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowTrash(ctx, trashLimit, trashOffset)
+		},
+	}
+	trashCmd.Flags().IntVar(&trashLimit, "limit", 20, "Number of items to show")
+	trashCmd.Flags().IntVar(&trashOffset, "offset", 0, "Number of items to skip")
+	rootCmd.AddCommand(trashCmd)
+
+	// Restore command
+	restoreCmd := &cobra.Command{
+		Use:   "restore [id]",
+		Short: "Restore a deleted board, column or card with everything deleted along with it",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.Restore(ctx, args[0])
+		},
+	}
+	rootCmd.AddCommand(restoreCmd)
+
+	// Archive command
+	archiveCmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Archive a board or column; it is hidden and read-only until unarchived",
+	}
+
+	archiveBoardCmd := &cobra.Command{
+		Use:   "board [board_id]",
+		Short: "Archive a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ArchiveBoard(ctx, args[0])
+		},
+	}
+	archiveCmd.AddCommand(archiveBoardCmd)
+
+	archiveColumnCmd := &cobra.Command{
+		Use:   "column [column_id]",
+		Short: "Archive a column",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ArchiveColumn(ctx, args[0])
+		},
+	}
+	archiveCmd.AddCommand(archiveColumnCmd)
+	rootCmd.AddCommand(archiveCmd)
+
+	// Unarchive command
+	unarchiveCmd := &cobra.Command{
+		Use:   "unarchive",
+		Short: "Unarchive a board or column",
+	}
+
+	unarchiveBoardCmd := &cobra.Command{
+		Use:   "board [board_id]",
+		Short: "Unarchive a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UnarchiveBoard(ctx, args[0])
+		},
+	}
+	unarchiveCmd.AddCommand(unarchiveBoardCmd)
+
+	unarchiveColumnCmd := &cobra.Command{
+		Use:   "column [column_id]",
+		Short: "Unarchive a column",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.UnarchiveColumn(ctx, args[0])
+		},
+	}
+	unarchiveCmd.AddCommand(unarchiveColumnCmd)
+	rootCmd.AddCommand(unarchiveCmd)
+
+	// Clone command
+	var cloneNoCards bool
+	cloneCmd := &cobra.Command{
+		Use:   "clone [board_id] [title]",
+		Short: "Copy a board with its labels, columns and cards into a new board of your own",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			title := ""
+			if len(args) > 1 {
+				title = args[1]
+			}
+			client.CloneBoard(ctx, args[0], title, cloneNoCards)
+		},
+	}
+	cloneCmd.Flags().BoolVar(&cloneNoCards, "no-cards", false, "Copy only the labels and columns")
+	rootCmd.AddCommand(cloneCmd)
+
+	// Template command
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Save boards as templates and create new boards from them",
+	}
+
+	var templateNoCards bool
+	templateSaveCmd := &cobra.Command{
+		Use:   "save [board_id] [title]",
+		Short: "Save a copy of a board as a template",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			title := ""
+			if len(args) > 1 {
+				title = args[1]
+			}
+			client.SaveTemplate(ctx, args[0], title, templateNoCards)
+		},
+	}
+	templateSaveCmd.Flags().BoolVar(&templateNoCards, "no-cards", false, "Keep only the labels and columns in the template")
+	templateCmd.AddCommand(templateSaveCmd)
+
+	templateListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Show your templates and the ones shared with you",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowTemplates(ctx)
+		},
+	}
+	templateCmd.AddCommand(templateListCmd)
+
+	templateUseCmd := &cobra.Command{
+		Use:   "use [template_id] [title]",
+		Short: "Create a board from a template",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			title := ""
+			if len(args) > 1 {
+				title = args[1]
+			}
+			client.CloneBoard(ctx, args[0], title, false)
+		},
+	}
+	templateCmd.AddCommand(templateUseCmd)
+	rootCmd.AddCommand(templateCmd)
+
+	// Export command
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export to a file",
+	}
+
+	exportBoardCmd := &cobra.Command{
+		Use:   "board [board_id] [file]",
+		Short: "Save a board with its labels, columns and cards as JSON",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			dest := ""
+			if len(args) > 1 {
+				dest = args[1]
+			}
+			client.ExportBoard(ctx, args[0], dest)
+		},
+	}
+	exportCmd.AddCommand(exportBoardCmd)
+	rootCmd.AddCommand(exportCmd)
+
+	// Import command
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import from a file",
+	}
+
+	importBoardCmd := &cobra.Command{
+		Use:   "board [file]",
+		Short: "Create a new board of your own from an exported board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ImportBoard(ctx, args[0])
+		},
+	}
+	importCmd.AddCommand(importBoardCmd)
+
+	var trelloDryRun bool
+	importTrelloCmd := &cobra.Command{
+		Use:   "trello [file]",
+		Short: "Create a new board of your own from a Trello board JSON export",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ImportTrelloBoard(ctx, args[0], trelloDryRun)
+		},
+	}
+	importTrelloCmd.Flags().BoolVar(&trelloDryRun, "dry-run", false, "Only show what would be imported and what would be skipped")
+	importCmd.AddCommand(importTrelloCmd)
+	rootCmd.AddCommand(importCmd)
+
+	// Batch command
+	var batchBestEffort bool
+	batchCmd := &cobra.Command{
+		Use:   "batch [file]",
+		Short: "Run the create, update, move and delete operations of a JSON file at once",
+		Long: `Run the operations listed in a JSON file in one go. The file holds an array
+of objects with "op" (create, update, move or delete), "type" (board, column
+or card) and the fields the operation needs, for example:
+
+  [{"op": "move", "type": "card", "id": "...", "column_id": "..."},
+   {"op": "delete", "type": "column", "id": "..."}]
+
+Unless --best-effort is given, nothing is changed when one of them fails.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RunBatch(ctx, args[0], batchBestEffort)
+		},
+	}
+	batchCmd.Flags().BoolVar(&batchBestEffort, "best-effort", false, "Keep the operations that succeed when others fail")
+	rootCmd.AddCommand(batchCmd)
+
+	// Search command
+	var searchBoard, searchFrom, searchTo string
+	var searchLimit, searchOffset int
+	searchCmd := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Find cards on all your boards; quote phrases, use -word to leave a word out",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			search := dto.CardSearch{
+				Query:   strings.Join(args, " "),
+				BoardID: searchBoard,
+				From:    searchFrom,
+				To:      searchTo,
+			}
+			client.Search(ctx, search, searchLimit, searchOffset)
+		},
+	}
+	searchCmd.Flags().StringVar(&searchBoard, "board", "", "Search only the board with this id")
+	searchCmd.Flags().StringVar(&searchFrom, "from", "", "Only cards created on this day (DD-MM-YYYY) or later")
+	searchCmd.Flags().StringVar(&searchTo, "to", "", "Only cards created on this day (DD-MM-YYYY) or earlier")
+	searchCmd.Flags().IntVar(&searchLimit, "limit", 20, "Number of cards to show")
+	searchCmd.Flags().IntVar(&searchOffset, "offset", 0, "Number of cards to skip")
+	rootCmd.AddCommand(searchCmd)
+
+	// History command
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show who changed what, newest first",
+	}
+
+	var historyLimit, historyOffset int
+	historyBoardCmd := &cobra.Command{
+		Use:   "board [board_id]",
+		Short: "Show history of a board",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowBoardHistory(ctx, args[0], historyLimit, historyOffset)
+		},
+	}
+	historyCmd.AddCommand(historyBoardCmd)
+
+	historyCardCmd := &cobra.Command{
+		Use:   "card [card_id]",
+		Short: "Show history of a card, deleted cards included",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowCardHistory(ctx, args[0], historyLimit, historyOffset)
+		},
+	}
+	historyCmd.AddCommand(historyCardCmd)
+	historyCmd.PersistentFlags().IntVar(&historyLimit, "limit", 20, "Number of entries to show")
+	historyCmd.PersistentFlags().IntVar(&historyOffset, "offset", 0, "Number of entries to skip")
+	rootCmd.AddCommand(historyCmd)
+
+	// Watch command
+	watchCmd := &cobra.Command{
+		Use:   "watch [board_id]",
+		Short: "Print changes to a board as they happen",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			interrupted, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+
+			ctx0 := context.WithValue(interrupted, "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.WatchBoard(ctx, args[0])
+		},
+	}
+	rootCmd.AddCommand(watchCmd)
+
+	// Revisions command
+	revisionsCmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "Browse, compare and restore the title and description of a card",
+	}
+
+	var revisionsLimit, revisionsOffset int
+	revisionsListCmd := &cobra.Command{
+		Use:   "list [card_id]",
+		Short: "Show the revisions of a card, newest first",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.ShowRevisions(ctx, args[0], revisionsLimit, revisionsOffset)
+		},
+	}
+	revisionsListCmd.Flags().IntVar(&revisionsLimit, "limit", 20, "Number of revisions to show")
+	revisionsListCmd.Flags().IntVar(&revisionsOffset, "offset", 0, "Number of revisions to skip")
+	revisionsCmd.AddCommand(revisionsListCmd)
+
+	revisionsDiffCmd := &cobra.Command{
+		Use:   "diff [card_id] [from] [to]",
+		Short: "Show what changed between two revisions, line by line",
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.DiffRevisions(ctx, args[0], args[1], args[2])
+		},
+	}
+	revisionsCmd.AddCommand(revisionsDiffCmd)
+
+	revisionsRevertCmd := &cobra.Command{
+		Use:   "revert [card_id] [number]",
+		Short: "Restore a revision of a card as its newest revision",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx0 := context.WithValue(context.Background(), "tokens", &Tokens)
+			ctx := context.WithValue(ctx0, "saveFunc", func(tokens *dto.Tokens) {
+				saveTokens(tokens, cfg.Client.TokensPath)
+			})
+			client.RevertCard(ctx, args[0], args[1])
+		},
+	}
+	revisionsCmd.AddCommand(revisionsRevertCmd)
+	rootCmd.AddCommand(revisionsCmd)
+
 	// Stats command
 	statsCmd := &cobra.Command{
 		Use:   "stats from [DD-MM-YYYY] to [DD-MM-YYYY]",
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"cli/internal/common/logger"
 	"cli/internal/dto"
@@ -9,7 +10,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -36,15 +42,77 @@ var (
 	ErrUpdateBoard  error = errors.New("Failed to update board")
 	ErrUpdateColumn error = errors.New("Failed to update column")
 	ErrUpdateCard   error = errors.New("Failed to update card")
+	ErrCardChanged  error = errors.New("The card was changed by someone else in the meantime, try again")
+	ErrMoveCard     error = errors.New("Failed to move card")
+	ErrCardRefused  error = errors.New("The column takes no more cards: it is at its strict WIP limit or archived")
 	ErrDeleteBoard  error = errors.New("Failed to delete board")
 	ErrDeleteColumn error = errors.New("Failed to delete column")
 	ErrDeleteCard   error = errors.New("Failed to delete card")
+
+	ErrGetTrash          error = errors.New("Failed to get trash")
+	ErrRestoreItem       error = errors.New("Failed to restore item")
+	ErrRestoreParentGone error = errors.New("Restore the board or column it was on first")
+
+	ErrArchiveBoard    error = errors.New("Failed to archive board")
+	ErrUnarchiveBoard  error = errors.New("Failed to unarchive board")
+	ErrArchiveColumn   error = errors.New("Failed to archive column")
+	ErrUnarchiveColumn error = errors.New("Failed to unarchive column")
+	ErrArchived        error = errors.New("The board is archived, unarchive it first")
+
+	ErrSetWIPLimit error = errors.New("Failed to set WIP limit")
+
+	ErrCloneBoard    error = errors.New("Failed to clone board")
+	ErrShowTemplates error = errors.New("Failed to show templates")
+
+	ErrExportBoard   error = errors.New("Failed to export board")
+	ErrImportBoard   error = errors.New("Failed to import board")
+	ErrInvalidExport error = errors.New("The file is not a valid board export")
+
+	ErrImportTrello       error = errors.New("Failed to import Trello board")
+	ErrInvalidTrelloBoard error = errors.New("The file is not a valid Trello board export")
+
+	ErrSearchCards   error = errors.New("Failed to search cards")
+	ErrInvalidSearch error = errors.New("Invalid search: give a query and dates as DD-MM-YYYY")
+
+	ErrRunBatch     error = errors.New("Failed to run batch")
+	ErrInvalidBatch error = errors.New("Invalid batch: check the op, type and ids of the operations")
+
+	ErrGetChecklists       error = errors.New("Failed to get checklists")
+	ErrCreateChecklist     error = errors.New("Failed to create checklist")
+	ErrCreateChecklistItem error = errors.New("Failed to add checklist item")
+	ErrUpdateChecklistItem error = errors.New("Failed to update checklist item")
+
+	ErrGetComments   error = errors.New("Failed to get comments")
+	ErrCreateComment error = errors.New("Failed to post comment")
+	ErrGetHistory    error = errors.New("Failed to get history")
+	ErrWatchBoard    error = errors.New("Failed to watch board")
+
+	ErrGetRevisions     error = errors.New("Failed to get revisions")
+	ErrDiffRevisions    error = errors.New("Failed to compare revisions")
+	ErrRevertCard       error = errors.New("Failed to revert card")
+	ErrRevisionNotFound error = errors.New("The card or the revision does not exist")
+
+	ErrSetCardDates    error = errors.New("Failed to set card dates")
+	ErrGetOverdueCards error = errors.New("Failed to get overdue cards")
+	ErrGetDueCards     error = errors.New("Failed to get due cards")
+
+	ErrAssignCard       error = errors.New("Failed to assign card")
+	ErrUnassignCard     error = errors.New("Failed to unassign card")
+	ErrGetAssignedCards error = errors.New("Failed to get assigned cards")
+
+	ErrUploadAttachment   error = errors.New("Failed to upload attachment")
+	ErrAttachmentTooLarge error = errors.New("Attachment is too large")
+	ErrGetAttachment      error = errors.New("Failed to download attachment")
+	ErrDeleteAttachment   error = errors.New("Failed to delete attachment")
 )
 
 type AggregatorService struct {
 	baseURL    string
 	httpClient *http.Client
-	log        logger.Logger
+	// streamClient carries attachments, which may take longer than the
+	// timeout of httpClient
+	streamClient *http.Client
+	log          logger.Logger
 }
 
 func NewAggregatorService(baseURL string, timeout time.Duration, logger logger.Logger) service.AggregatorService {
@@ -53,7 +121,8 @@ func NewAggregatorService(baseURL string, timeout time.Duration, logger logger.L
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		log: logger,
+		streamClient: &http.Client{},
+		log:          logger,
 	}
 }
 
@@ -247,9 +316,26 @@ func (s *AggregatorService) Logout(ctx context.Context, refreshToken string) err
 	return nil
 }
 
-// ShowBoards(ctx context.Context) ([]dto.Board, error)
-func (s *AggregatorService) ShowBoards(ctx context.Context) ([]dto.Board, error) {
-	url := fmt.Sprintf("%s/boards", s.baseURL)
+// pageQuery always carries the cursor, since an empty one is what asks for
+// the first page of a listing paged by cursor
+func pageQuery(page dto.PageRequest) neturl.Values {
+	query := neturl.Values{}
+	query.Set("cursor", page.Cursor)
+	if page.Limit > 0 {
+		query.Set("limit", strconv.Itoa(page.Limit))
+	}
+
+	return query
+}
+
+// ShowBoards(ctx context.Context, archived bool, page dto.PageRequest) (*dto.BoardPage, error)
+func (s *AggregatorService) ShowBoards(ctx context.Context, archived bool, page dto.PageRequest) (*dto.BoardPage, error) {
+	query := pageQuery(page)
+	if archived {
+		query.Set("archived", "true")
+	}
+
+	url := fmt.Sprintf("%s/boards?%s", s.baseURL, query.Encode())
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -271,19 +357,24 @@ func (s *AggregatorService) ShowBoards(ctx context.Context) ([]dto.Board, error)
 		return nil, err
 	}
 
-	var boards []dto.Board
+	var boards dto.BoardPage
 	if err := json.NewDecoder(resp.Body).Decode(&boards); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return boards, nil
+	return &boards, nil
 }
 
-// ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error)
-func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error) {
-	url := fmt.Sprintf("%s/board/%s", s.baseURL, boardID)
+// ShowBoard(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.BoardView, error)
+func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.BoardView, error) {
+	query := pageQuery(page)
+	if archived {
+		query.Set("archived", "true")
+	}
+
+	url := fmt.Sprintf("%s/board/%s?%s", s.baseURL, boardID, query.Encode())
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -305,19 +396,19 @@ func (s *AggregatorService) ShowBoard(ctx context.Context, boardID string) ([]dt
 		return nil, err
 	}
 
-	var columns []dto.Column
-	if err := json.NewDecoder(resp.Body).Decode(&columns); err != nil {
+	var board dto.BoardView
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return columns, nil
+	return &board, nil
 }
 
-// ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error)
-func (s *AggregatorService) ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error) {
-	url := fmt.Sprintf("%s/column/%s", s.baseURL, columnID)
+// ShowColumn(ctx context.Context, columnID string, page dto.PageRequest) (*dto.CardPage, error)
+func (s *AggregatorService) ShowColumn(ctx context.Context, columnID string, page dto.PageRequest) (*dto.CardPage, error) {
+	url := fmt.Sprintf("%s/column/%s?%s", s.baseURL, columnID, pageQuery(page).Encode())
 
 	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -339,14 +430,14 @@ func (s *AggregatorService) ShowColumn(ctx context.Context, columnID string) ([]
 		return nil, err
 	}
 
-	var cards []dto.Card
+	var cards dto.CardPage
 	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
 		err = ErrDecodeResponse(err)
 		s.log.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	return cards, nil
+	return &cards, nil
 }
 
 // ShowCard(ctx context.Context, cardID string) (*dto.Card, error)
@@ -383,6 +474,266 @@ func (s *AggregatorService) ShowCard(ctx context.Context, cardID string) (*dto.C
 	return &card, nil
 }
 
+// ShowComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error)
+func (s *AggregatorService) ShowComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error) {
+	url := fmt.Sprintf("%s/card/%s/comments?limit=%d&offset=%d", s.baseURL, cardID, limit, offset)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetComments
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var comments []dto.Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// ShowBoardHistory(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error)
+func (s *AggregatorService) ShowBoardHistory(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error) {
+	return s.showHistory(ctx, fmt.Sprintf("%s/board/%s/activity?limit=%d&offset=%d", s.baseURL, boardID, limit, offset))
+}
+
+// ShowCardHistory(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error)
+func (s *AggregatorService) ShowCardHistory(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error) {
+	return s.showHistory(ctx, fmt.Sprintf("%s/card/%s/activity?limit=%d&offset=%d", s.baseURL, cardID, limit, offset))
+}
+
+func (s *AggregatorService) showHistory(ctx context.Context, url string) ([]dto.Activity, error) {
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetHistory
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var activities []dto.Activity
+	if err := json.NewDecoder(resp.Body).Decode(&activities); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+// WatchBoard(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error)
+func (s *AggregatorService) WatchBoard(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error) {
+	url := fmt.Sprintf("%s/board/%s/events", s.baseURL, boardID)
+
+	method := http.MethodGet
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err = ErrWatchBoard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	events := make(chan dto.BoardEvent)
+
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		// Only the data lines are read, the event carries its id and type
+		// as well; comments are heartbeats
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+
+		for scanner.Scan() {
+			data, ok := strings.CutPrefix(scanner.Text(), "data:")
+			if !ok {
+				continue
+			}
+
+			var event dto.BoardEvent
+			if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+				s.log.Error(ctx, ErrDecodeResponse(err).Error())
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// ShowRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error)
+func (s *AggregatorService) ShowRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error) {
+	url := fmt.Sprintf("%s/card/%s/revisions?limit=%d&offset=%d", s.baseURL, cardID, limit, offset)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetRevisions
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var revisions []dto.CardRevision
+	if err := json.NewDecoder(resp.Body).Decode(&revisions); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// DiffRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error)
+func (s *AggregatorService) DiffRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error) {
+	url := fmt.Sprintf("%s/card/%s/revisions/diff?from=%d&to=%d", s.baseURL, cardID, from, to)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = ErrRevisionNotFound
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDiffRevisions
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var diff dto.RevisionDiff
+	if err := json.NewDecoder(resp.Body).Decode(&diff); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &diff, nil
+}
+
+// RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error)
+func (s *AggregatorService) RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/card/%s/revisions/%d/revert", s.baseURL, cardID, number)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		err = ErrRevisionNotFound
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrRevertCard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
 // CreateBoard(ctx context.Context, board dto.Board) error
 func (s *AggregatorService) CreateBoard(ctx context.Context, board dto.Board) error {
 	url := fmt.Sprintf("%s/board", s.baseURL)
@@ -441,8 +792,8 @@ func (s *AggregatorService) CreateColumn(ctx context.Context, column dto.Column)
 	return nil
 }
 
-// CreateCard(ctx context.Context, card dto.Card) error
-func (s *AggregatorService) CreateCard(ctx context.Context, card dto.Card) error {
+// CreateCard(ctx context.Context, card *dto.Card) error
+func (s *AggregatorService) CreateCard(ctx context.Context, card *dto.Card) error {
 	url := fmt.Sprintf("%s/card", s.baseURL)
 
 	data := card
@@ -461,12 +812,51 @@ func (s *AggregatorService) CreateCard(ctx context.Context, card dto.Card) error
 		return err
 	}
 
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrCardRefused
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusCreated {
 		err = ErrCreateBoard
 		s.log.Error(ctx, err.Error())
 		return err
 	}
 
+	if err := json.NewDecoder(resp.Body).Decode(card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateComment(ctx context.Context, cardID string, req dto.CreateCommentRequest) error
+func (s *AggregatorService) CreateComment(ctx context.Context, cardID string, req dto.CreateCommentRequest) error {
+	url := fmt.Sprintf("%s/card/%s/comments", s.baseURL, cardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateComment
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -548,6 +938,15 @@ func (s *AggregatorService) UpdateCard(ctx context.Context, card *dto.Card) erro
 		return err
 	}
 
+	// A stale version is answered with the current card; the other
+	// conflicts come as plain text
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if resp.StatusCode == http.StatusConflict && mediaType == "application/json" {
+		err = ErrCardChanged
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		err = ErrUpdateCard
 		s.log.Error(ctx, err.Error())
@@ -557,63 +956,976 @@ func (s *AggregatorService) UpdateCard(ctx context.Context, card *dto.Card) erro
 	return nil
 }
 
-// DeleteBoard(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteBoard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/board/%s", s.baseURL, id)
+// MoveCard(ctx context.Context, cardID string, req dto.MoveCardRequest) (*dto.Card, error)
+func (s *AggregatorService) MoveCard(ctx context.Context, cardID string, req dto.MoveCardRequest) (*dto.Card, error) {
+	url := fmt.Sprintf("%s/card/%s/move", s.baseURL, cardID)
 
-	method := http.MethodDelete
-	resp, err := s.makeRequest(ctx, method, url, nil)
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
 	if err != nil {
-		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteBoard
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrCardRefused
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrMoveCard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var card dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&card); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+// SetCardDates(ctx context.Context, cardID string, req dto.SetCardDatesRequest) error
+func (s *AggregatorService) SetCardDates(ctx context.Context, cardID string, req dto.SetCardDatesRequest) error {
+	url := fmt.Sprintf("%s/card/%s/dates", s.baseURL, cardID)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSetCardDates
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ShowOverdueCards(ctx context.Context) ([]dto.Card, error)
+func (s *AggregatorService) ShowOverdueCards(ctx context.Context) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/overdue", s.baseURL)
+
+	return s.showCards(ctx, url, ErrGetOverdueCards)
+}
+
+// ShowDueCards(ctx context.Context, days int) ([]dto.Card, error)
+func (s *AggregatorService) ShowDueCards(ctx context.Context, days int) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/due?days=%d", s.baseURL, days)
+
+	return s.showCards(ctx, url, ErrGetDueCards)
+}
+
+// AssignCard(ctx context.Context, cardID, userID string) error
+func (s *AggregatorService) AssignCard(ctx context.Context, cardID, userID string) error {
+	url := fmt.Sprintf("%s/card/%s/assignees/%s", s.baseURL, cardID, userID)
+
+	return s.setAssignee(ctx, http.MethodPut, url, ErrAssignCard)
+}
+
+// UnassignCard(ctx context.Context, cardID, userID string) error
+func (s *AggregatorService) UnassignCard(ctx context.Context, cardID, userID string) error {
+	url := fmt.Sprintf("%s/card/%s/assignees/%s", s.baseURL, cardID, userID)
+
+	return s.setAssignee(ctx, http.MethodDelete, url, ErrUnassignCard)
+}
+
+func (s *AggregatorService) setAssignee(ctx context.Context, method, url string, failure error) error {
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = failure
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ShowAssignedCards(ctx context.Context) ([]dto.Card, error)
+func (s *AggregatorService) ShowAssignedCards(ctx context.Context) ([]dto.Card, error) {
+	url := fmt.Sprintf("%s/cards/assigned", s.baseURL)
+
+	return s.showCards(ctx, url, ErrGetAssignedCards)
+}
+
+func (s *AggregatorService) showCards(ctx context.Context, url string, failure error) ([]dto.Card, error) {
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = failure
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var cards []dto.Card
+	if err := json.NewDecoder(resp.Body).Decode(&cards); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error)
+func (s *AggregatorService) GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error) {
+	url := fmt.Sprintf("%s/card/%s/checklists", s.baseURL, cardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetChecklists
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var checklists []dto.Checklist
+	if err := json.NewDecoder(resp.Body).Decode(&checklists); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return checklists, nil
+}
+
+// CreateChecklist(ctx context.Context, cardID string, req dto.CreateChecklistRequest) error
+func (s *AggregatorService) CreateChecklist(ctx context.Context, cardID string, req dto.CreateChecklistRequest) error {
+	url := fmt.Sprintf("%s/card/%s/checklists", s.baseURL, cardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateChecklist
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// CreateChecklistItem(ctx context.Context, checklistID string, req dto.CreateChecklistItemRequest) error
+func (s *AggregatorService) CreateChecklistItem(ctx context.Context, checklistID string, req dto.CreateChecklistItemRequest) error {
+	url := fmt.Sprintf("%s/checklist/%s/items", s.baseURL, checklistID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCreateChecklistItem
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// SetChecklistItemDone(ctx context.Context, itemID string, req dto.SetChecklistItemDoneRequest) error
+func (s *AggregatorService) SetChecklistItemDone(ctx context.Context, itemID string, req dto.SetChecklistItemDoneRequest) error {
+	url := fmt.Sprintf("%s/checklist-item/%s/done", s.baseURL, itemID)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUpdateChecklistItem
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteBoard(ctx context.Context, id string) error
+func (s *AggregatorService) DeleteBoard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/board/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DeleteColumn(ctx context.Context, id string) error
+func (s *AggregatorService) DeleteColumn(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/column/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteColumn
+		s.log.Error(ctx, err.Error())
+		return err
 	}
 
 	return nil
 }
 
-// DeleteColumn(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteColumn(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/column/%s", s.baseURL, id)
+// DeleteCard(ctx context.Context, id string) error
+func (s *AggregatorService) DeleteCard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/card/%s", s.baseURL, id)
+
+	method := http.MethodDelete
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrDeleteCard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ShowTrash(ctx context.Context, limit, offset int) ([]dto.TrashItem, error)
+func (s *AggregatorService) ShowTrash(ctx context.Context, limit, offset int) ([]dto.TrashItem, error) {
+	url := fmt.Sprintf("%s/trash?limit=%d&offset=%d", s.baseURL, limit, offset)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrGetTrash
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var items []dto.TrashItem
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error)
+func (s *AggregatorService) RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error) {
+	url := fmt.Sprintf("%s/trash/%s/restore", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrRestoreParentGone
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrRestoreItem
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var item dto.TrashItem
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ArchiveBoard(ctx context.Context, id string) error
+func (s *AggregatorService) ArchiveBoard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/board/%s/archive", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrArchived
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrArchiveBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UnarchiveBoard(ctx context.Context, id string) error
+func (s *AggregatorService) UnarchiveBoard(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/board/%s/unarchive", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrArchived
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUnarchiveBoard
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ArchiveColumn(ctx context.Context, id string) error
+func (s *AggregatorService) ArchiveColumn(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/column/%s/archive", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrArchived
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrArchiveColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UnarchiveColumn(ctx context.Context, id string) error
+func (s *AggregatorService) UnarchiveColumn(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/column/%s/unarchive", s.baseURL, id)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		err = ErrArchived
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrUnarchiveColumn
+		s.log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error)
+func (s *AggregatorService) SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error) {
+	url := fmt.Sprintf("%s/column/%s/wip-limit", s.baseURL, id)
+
+	method := http.MethodPut
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSetWIPLimit
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var column dto.Column
+	if err := json.NewDecoder(resp.Body).Decode(&column); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+// CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error)
+func (s *AggregatorService) CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/board/%s/clone", s.baseURL, boardID)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url, "data", req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrCloneBoard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+// ShowTemplates(ctx context.Context) ([]dto.Board, error)
+func (s *AggregatorService) ShowTemplates(ctx context.Context) ([]dto.Board, error) {
+	url := fmt.Sprintf("%s/templates", s.baseURL)
 
-	method := http.MethodDelete
+	method := http.MethodGet
 	resp, err := s.makeRequest(ctx, method, url, nil)
 	if err != nil {
 		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		err = ErrUnauthorized
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteColumn
+		err = ErrShowTemplates
 		s.log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	var templates []dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&templates); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return templates, nil
 }
 
-// DeleteCard(ctx context.Context, id string) error
-func (s *AggregatorService) DeleteCard(ctx context.Context, id string) error {
-	url := fmt.Sprintf("%s/card/%s", s.baseURL, id)
+// ExportBoard(ctx context.Context, boardID string) (json.RawMessage, error)
+func (s *AggregatorService) ExportBoard(ctx context.Context, boardID string) (json.RawMessage, error) {
+	url := fmt.Sprintf("%s/board/%s/export", s.baseURL, boardID)
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrExportBoard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var export json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&export); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error)
+func (s *AggregatorService) ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error) {
+	url := fmt.Sprintf("%s/boards/import", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, export)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrInvalidExport
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrImportBoard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var board dto.Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+// ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error)
+func (s *AggregatorService) ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error) {
+	url := fmt.Sprintf("%s/boards/import/trello?dry_run=%t", s.baseURL, dryRun)
+
+	want := http.StatusCreated
+	if dryRun {
+		want = http.StatusOK
+	}
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, trello)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrInvalidTrelloBoard
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != want {
+		err = ErrImportTrello
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var summary dto.ImportSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error)
+func (s *AggregatorService) RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error) {
+	url := fmt.Sprintf("%s/batch", s.baseURL)
+
+	method := http.MethodPost
+	resp, err := s.makeRequest(ctx, method, url, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrInvalidBatch
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrRunBatch
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var batch dto.BatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &batch, nil
+}
+
+// SearchCards(ctx context.Context, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error)
+func (s *AggregatorService) SearchCards(ctx context.Context, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error) {
+	query := neturl.Values{}
+	query.Set("q", search.Query)
+	if search.BoardID != "" {
+		query.Set("board_id", search.BoardID)
+	}
+	if search.From != "" {
+		query.Set("from", search.From)
+	}
+	if search.To != "" {
+		query.Set("to", search.To)
+	}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+
+	url := fmt.Sprintf("%s/search?%s", s.baseURL, query.Encode())
+
+	method := http.MethodGet
+	resp, err := s.makeRequest(ctx, method, url, nil)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		err = ErrInvalidSearch
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err = ErrSearchCards
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var matches []dto.CardMatch
+	if err := json.NewDecoder(resp.Body).Decode(&matches); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error)
+func (s *AggregatorService) UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error) {
+	url := fmt.Sprintf("%s/card/%s/attachments?filename=%s", s.baseURL, cardID, neturl.QueryEscape(filename))
+
+	method := http.MethodPost
+	req, err := http.NewRequestWithContext(ctx, method, url, content)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		err = ErrAttachmentTooLarge
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		err = ErrUploadAttachment
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var attachment dto.Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		err = ErrDecodeResponse(err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error)
+func (s *AggregatorService) GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error) {
+	url := fmt.Sprintf("%s/attachment/%s", s.baseURL, id)
+
+	method := http.MethodGet
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		err = fmt.Errorf("error creating request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	resp, err := s.doStream(ctx, req)
+	if err != nil {
+		s.log.Error(ctx, "Error making the request", "method", method, "url", url)
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		err = ErrUnauthorized
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err = ErrGetAttachment
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	var filename string
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		filename = params["filename"]
+	}
+
+	return &dto.AttachmentContent{
+		Filename: filename,
+		Size:     resp.ContentLength,
+		Body:     resp.Body,
+	}, nil
+}
+
+// DeleteAttachment(ctx context.Context, id string) error
+func (s *AggregatorService) DeleteAttachment(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/attachment/%s", s.baseURL, id)
 
 	method := http.MethodDelete
 	resp, err := s.makeRequest(ctx, method, url, nil)
@@ -630,7 +1942,7 @@ func (s *AggregatorService) DeleteCard(ctx context.Context, id string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err = ErrDeleteCard
+		err = ErrDeleteAttachment
 		s.log.Error(ctx, err.Error())
 		return err
 	}
@@ -703,3 +2015,20 @@ func (s *AggregatorService) makeRequest(ctx context.Context, method, url string,
 
 	return resp, nil
 }
+
+// doStream sends a request whose body is streamed rather than marshaled
+func (s *AggregatorService) doStream(ctx context.Context, req *http.Request) (*http.Response, error) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if ok {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tokens.AccessToken))
+	}
+
+	resp, err := s.streamClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("error sending request: %w", err)
+		s.log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,19 +15,150 @@ type User struct {
 }
 
 type Card struct {
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ColumnID    uuid.UUID          `json:"column_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description,omitempty"`
+	Position    float64            `json:"position"`
+	StartDate   *time.Time         `json:"start_date,omitempty"`
+	DueDate     *time.Time         `json:"due_date,omitempty"`
+	Labels      []Label            `json:"labels,omitempty"`
+	Checklist   *ChecklistProgress `json:"checklist,omitempty"`
+	AssigneeIDs []uuid.UUID        `json:"assignee_ids,omitempty"`
+	Assignees   []Assignee         `json:"assignees,omitempty"`
+	Attachments []Attachment       `json:"attachments,omitempty"`
+	// Version is sent back with an update so that it does not overwrite
+	// changes made since the card was read
+	Version   int       `json:"version,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// OverWIPLimit is set when the card was just created in or moved to a
+	// column that was already at its soft WIP limit
+	OverWIPLimit bool `json:"over_wip_limit,omitempty"`
+}
+
+type Assignee struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+type Attachment struct {
 	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
+	CardID      uuid.UUID `json:"card_id"`
+	UploaderID  uuid.UUID `json:"uploader_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+// AttachmentContent is a downloaded attachment; Body has to be closed
+type AttachmentContent struct {
+	Filename string
+	Size     int64
+	Body     io.ReadCloser
+}
+
+type Label struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Color string    `json:"color"`
+}
+
+type Checklist struct {
+	ID     uuid.UUID       `json:"id"`
+	CardID uuid.UUID       `json:"card_id"`
+	Title  string          `json:"title"`
+	Items  []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID `json:"id"`
+	ChecklistID uuid.UUID `json:"checklist_id"`
+	Text        string    `json:"text"`
+	Done        bool      `json:"done"`
+}
+
+type Comment struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	AuthorID  uuid.UUID  `json:"author_id"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
+
+// Activity is an entry of the activity log of a board; Actor is empty when
+// the aggregator could not tell the username
+type Activity struct {
+	ID         uuid.UUID     `json:"id"`
+	TargetType string        `json:"target_type"`
+	TargetID   uuid.UUID     `json:"target_id"`
+	Action     string        `json:"action"`
+	ActorID    uuid.UUID     `json:"actor_id"`
+	Actor      string        `json:"actor,omitempty"`
+	Changes    []FieldChange `json:"changes"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// BoardEvent is a change pushed on the live feed of a board; a "reset" event
+// carries no change and means some changes were missed
+type BoardEvent struct {
+	ID     string    `json:"id"`
+	Type   string    `json:"type"`
+	Change *Activity `json:"change,omitempty"`
+}
+
+// CardRevision is a snapshot of the title and description of a card; Author
+// is empty when the aggregator could not tell the username
+type CardRevision struct {
+	Number       int       `json:"number"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	Author       string    `json:"author,omitempty"`
+	RevertedFrom *int      `json:"reverted_from,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiff struct {
+	From        CardRevision `json:"from"`
+	To          CardRevision `json:"to"`
+	Title       []DiffLine   `json:"title"`
+	Description []DiffLine   `json:"description"`
+}
+
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// TrashItem is a deleted board, column or card that can still be restored
+type TrashItem struct {
+	ID        uuid.UUID `json:"id"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	BoardID   uuid.UUID `json:"board_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
 type Board struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
-	Title  string    `json:"title"`
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Title      string     `json:"title"`
+	IsTemplate bool       `json:"is_template"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 }
 
 type Column struct {
@@ -35,6 +167,95 @@ type Column struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	// WIPLimit is the most cards the column takes, zero for no limit
+	WIPLimit  int  `json:"wip_limit,omitempty"`
+	WIPStrict bool `json:"wip_strict,omitempty"`
+	CardCount int  `json:"card_count"`
+}
+
+// SetColumnWIPLimitRequest replaces the WIP limit of a column; a zero limit
+// lifts it
+type SetColumnWIPLimitRequest struct {
+	Limit  int  `json:"limit"`
+	Strict bool `json:"strict"`
+}
+
+// MoveCardRequest sends a card to a column, optionally right before or right
+// after one of the cards already there; without an anchor the card goes last
+type MoveCardRequest struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	BeforeID uuid.UUID `json:"before_id,omitempty"`
+	AfterID  uuid.UUID `json:"after_id,omitempty"`
+}
+
+// SetCardDatesRequest replaces both dates of a card; a nil date is cleared
+type SetCardDatesRequest struct {
+	StartDate *time.Time `json:"start_date"`
+	DueDate   *time.Time `json:"due_date"`
+}
+
+// CardSearch looks for cards by their title and description; the dates are
+// DD-MM-YYYY and empty fields are left out
+type CardSearch struct {
+	Query   string
+	BoardID string
+	From    string
+	To      string
+}
+
+// CardMatch is a card found by a search with the matched words wrapped in
+// <b></b> in its headlines
+type CardMatch struct {
+	Card                Card      `json:"card"`
+	BoardID             uuid.UUID `json:"board_id"`
+	Rank                float64   `json:"rank"`
+	TitleHeadline       string    `json:"title_headline"`
+	DescriptionHeadline string    `json:"description_headline,omitempty"`
+}
+
+// ImportSummary tells what an import created, or would create on a dry run,
+// and what it had to leave out. Board is only set when a board was created.
+type ImportSummary struct {
+	Title   string        `json:"title"`
+	Board   *Board        `json:"board,omitempty"`
+	DryRun  bool          `json:"dry_run"`
+	Labels  int           `json:"labels"`
+	Columns int           `json:"columns"`
+	Cards   int           `json:"cards"`
+	Skipped ImportSkipped `json:"skipped"`
+}
+
+type ImportSkipped struct {
+	ArchivedCards int `json:"archived_cards"`
+	Attachments   int `json:"attachments"`
+	Members       int `json:"members"`
+	CardMembers   int `json:"card_members"`
+	Checklists    int `json:"checklists"`
+	Comments      int `json:"comments"`
+}
+
+// CloneBoardRequest copies a board with its labels, columns and cards; the
+// copy keeps the title of the original unless one is given
+type CloneBoardRequest struct {
+	Title        string `json:"title,omitempty"`
+	ExcludeCards bool   `json:"exclude_cards"`
+	Template     bool   `json:"template"`
+}
+
+type CreateChecklistRequest struct {
+	Title string `json:"title"`
+}
+
+type CreateChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+type SetChecklistItemDoneRequest struct {
+	Done bool `json:"done"`
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body"`
 }
 
 type RegisterRequest struct {
@@ -120,3 +341,66 @@ type NewUsersAndCardsStats struct {
 	Cards              []CardBase `json:"cards"`
 	NumCardsByNewUsers int        `json:"num_cards_by_new_users"`
 }
+
+// PageRequest asks for Limit items after the cursor handed out with the
+// previous page; an empty cursor asks for the first page
+type PageRequest struct {
+	Cursor string
+	Limit  int
+}
+
+type BoardPage struct {
+	Boards     []Board `json:"boards"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+// BoardView is a board's columns along with its label palette
+type BoardView struct {
+	Columns    []Column `json:"columns"`
+	Labels     []Label  `json:"labels"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+type CardPage struct {
+	Cards      []Card `json:"cards"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// BatchRequest runs its operations in order in one go; Mode is atomic, the
+// default, or best_effort
+type BatchRequest struct {
+	Mode       string           `json:"mode,omitempty"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation is one step of a batch. Op is create, update, move or
+// delete and Type is board, column or card; the other fields are those of
+// the single command doing the same.
+type BatchOperation struct {
+	Op          string     `json:"op"`
+	Type        string     `json:"type"`
+	ID          uuid.UUID  `json:"id,omitempty"`
+	BoardID     uuid.UUID  `json:"board_id,omitempty"`
+	ColumnID    uuid.UUID  `json:"column_id,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	BeforeID    uuid.UUID  `json:"before_id,omitempty"`
+	AfterID     uuid.UUID  `json:"after_id,omitempty"`
+	Version     int        `json:"version,omitempty"`
+}
+
+type BatchResult struct {
+	Status  string    `json:"status"`
+	ID      uuid.UUID `json:"id,omitempty"`
+	Version int       `json:"version,omitempty"`
+	Code    int       `json:"code,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Mode    string        `json:"mode"`
+	Results []BatchResult `json:"results"`
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"cli/internal/dto"
 	"context"
+	"encoding/json"
+	"io"
 )
 
 type AggregatorService interface {
@@ -12,22 +14,70 @@ type AggregatorService interface {
 	Validate(ctx context.Context, token string) (*dto.ValidateTokenResponse, error)
 	Logout(ctx context.Context, refreshToken string) error
 
-	ShowBoards(ctx context.Context) ([]dto.Board, error)
-	ShowBoard(ctx context.Context, boardID string) ([]dto.Column, error)
-	ShowColumn(ctx context.Context, columnID string) ([]dto.Card, error)
+	ShowBoards(ctx context.Context, archived bool, page dto.PageRequest) (*dto.BoardPage, error)
+	ShowBoard(ctx context.Context, boardID string, archived bool, page dto.PageRequest) (*dto.BoardView, error)
+	ShowColumn(ctx context.Context, columnID string, page dto.PageRequest) (*dto.CardPage, error)
 	ShowCard(ctx context.Context, cardID string) (*dto.Card, error)
+	ShowComments(ctx context.Context, cardID string, limit, offset int) ([]dto.Comment, error)
+	ShowBoardHistory(ctx context.Context, boardID string, limit, offset int) ([]dto.Activity, error)
+	ShowCardHistory(ctx context.Context, cardID string, limit, offset int) ([]dto.Activity, error)
+	// WatchBoard follows the live changes to the board from after
+	// lastEventID; the channel is closed when the connection ends
+	WatchBoard(ctx context.Context, boardID, lastEventID string) (<-chan dto.BoardEvent, error)
+	ShowRevisions(ctx context.Context, cardID string, limit, offset int) ([]dto.CardRevision, error)
+	DiffRevisions(ctx context.Context, cardID string, from, to int) (*dto.RevisionDiff, error)
+	RevertCard(ctx context.Context, cardID string, number int) (*dto.Card, error)
 
 	CreateBoard(ctx context.Context, board dto.Board) error
 	CreateColumn(ctx context.Context, column dto.Column) error
-	CreateCard(ctx context.Context, card dto.Card) error
+	CreateCard(ctx context.Context, card *dto.Card) error
+	CreateComment(ctx context.Context, cardID string, req dto.CreateCommentRequest) error
 
 	UpdateBoard(ctx context.Context, board *dto.Board) error
 	UpdateColumn(ctx context.Context, column *dto.Column) error
 	UpdateCard(ctx context.Context, card *dto.Card) error
+	MoveCard(ctx context.Context, cardID string, req dto.MoveCardRequest) (*dto.Card, error)
+	SetCardDates(ctx context.Context, cardID string, req dto.SetCardDatesRequest) error
+
+	ShowOverdueCards(ctx context.Context) ([]dto.Card, error)
+	ShowDueCards(ctx context.Context, days int) ([]dto.Card, error)
+
+	AssignCard(ctx context.Context, cardID, userID string) error
+	UnassignCard(ctx context.Context, cardID, userID string) error
+	ShowAssignedCards(ctx context.Context) ([]dto.Card, error)
+
+	UploadAttachment(ctx context.Context, cardID, filename string, size int64, content io.Reader) (*dto.Attachment, error)
+	GetAttachment(ctx context.Context, id string) (*dto.AttachmentContent, error)
+	DeleteAttachment(ctx context.Context, id string) error
+
+	GetChecklists(ctx context.Context, cardID string) ([]dto.Checklist, error)
+	CreateChecklist(ctx context.Context, cardID string, req dto.CreateChecklistRequest) error
+	CreateChecklistItem(ctx context.Context, checklistID string, req dto.CreateChecklistItemRequest) error
+	SetChecklistItemDone(ctx context.Context, itemID string, req dto.SetChecklistItemDoneRequest) error
 
 	DeleteBoard(ctx context.Context, id string) error
 	DeleteColumn(ctx context.Context, id string) error
 	DeleteCard(ctx context.Context, id string) error
 
+	ShowTrash(ctx context.Context, limit, offset int) ([]dto.TrashItem, error)
+	RestoreItem(ctx context.Context, id string) (*dto.TrashItem, error)
+
+	ArchiveBoard(ctx context.Context, id string) error
+	UnarchiveBoard(ctx context.Context, id string) error
+	ArchiveColumn(ctx context.Context, id string) error
+	UnarchiveColumn(ctx context.Context, id string) error
+	SetColumnWIPLimit(ctx context.Context, id string, req dto.SetColumnWIPLimitRequest) (*dto.Column, error)
+
+	CloneBoard(ctx context.Context, boardID string, req dto.CloneBoardRequest) (*dto.Board, error)
+	ShowTemplates(ctx context.Context) ([]dto.Board, error)
+
+	ExportBoard(ctx context.Context, boardID string) (json.RawMessage, error)
+	ImportBoard(ctx context.Context, export json.RawMessage) (*dto.Board, error)
+	ImportTrelloBoard(ctx context.Context, trello json.RawMessage, dryRun bool) (*dto.ImportSummary, error)
+
+	SearchCards(ctx context.Context, search dto.CardSearch, limit, offset int) ([]dto.CardMatch, error)
+
+	RunBatch(ctx context.Context, req dto.BatchRequest) (*dto.BatchResponse, error)
+
 	Stats(ctx context.Context, from, to string) ([]dto.NewUsersAndCardsStats, error)
 }
@@ -13,24 +13,69 @@ type Client interface {
 	Logout(ctx context.Context, refreshToken string) error
 
 	// context with value tokens
-	ShowBoards(ctx context.Context)
-	ShowBoard(ctx context.Context, boardID string)
-	ShowColumn(ctx context.Context, columnID string)
+	ShowBoards(ctx context.Context, archived bool, page dto.PageRequest)
+	ShowBoard(ctx context.Context, boardID string, archived bool, page dto.PageRequest)
+	ShowColumn(ctx context.Context, columnID string, page dto.PageRequest)
 	ShowCard(ctx context.Context, cardID string)
+	ShowComments(ctx context.Context, cardID string, limit, offset int)
+	ShowBoardHistory(ctx context.Context, boardID string, limit, offset int)
+	ShowCardHistory(ctx context.Context, cardID string, limit, offset int)
+	WatchBoard(ctx context.Context, boardID string)
+	ShowRevisions(ctx context.Context, cardID string, limit, offset int)
+	DiffRevisions(ctx context.Context, cardID, from, to string)
+	RevertCard(ctx context.Context, cardID, number string)
 
 	CreateBoard(ctx context.Context, title string)
 	CreateColumn(ctx context.Context, boardID, title string)
 	CreateCard(ctx context.Context, columnID, title, description string)
+	CreateComment(ctx context.Context, cardID, body string)
 
 	UpdateBoard(ctx context.Context, boardID, title string)
 	UpdateColumn(ctx context.Context, columnID, title string)
 	UpdateCardTitle(ctx context.Context, cardID, title string)
 	UpdateCardDescription(ctx context.Context, cardID, description string)
-	MoveCard(ctx context.Context, cardIDstr, columnIDstr string)
+	MoveCard(ctx context.Context, cardIDstr, columnIDstr, beforeIDstr, afterIDstr string)
+	SetWIPLimit(ctx context.Context, columnID string, limit int, strict bool)
+	UpdateCardDates(ctx context.Context, cardIDstr, start, due string)
+
+	ShowOverdueCards(ctx context.Context)
+	ShowDueCards(ctx context.Context, days int)
+
+	AssignCard(ctx context.Context, cardIDstr, userIDstr string)
+	UnassignCard(ctx context.Context, cardIDstr, userIDstr string)
+	ShowAssignedCards(ctx context.Context)
+
+	AttachFile(ctx context.Context, cardIDstr, path string)
+	DownloadAttachment(ctx context.Context, idStr, dest string)
+	DeleteAttachment(ctx context.Context, id string)
+
+	CreateChecklist(ctx context.Context, cardID, title string)
+	AddChecklistItem(ctx context.Context, checklistID, text string)
+	SetChecklistItemDone(ctx context.Context, itemID string, done bool)
 
 	DeleteBoard(ctx context.Context, id string)
 	DeleteColumn(ctx context.Context, id string)
 	DeleteCard(ctx context.Context, id string)
 
+	ShowTrash(ctx context.Context, limit, offset int)
+	Restore(ctx context.Context, id string)
+
+	ArchiveBoard(ctx context.Context, id string)
+	UnarchiveBoard(ctx context.Context, id string)
+	ArchiveColumn(ctx context.Context, id string)
+	UnarchiveColumn(ctx context.Context, id string)
+
+	CloneBoard(ctx context.Context, boardID, title string, excludeCards bool)
+	SaveTemplate(ctx context.Context, boardID, title string, excludeCards bool)
+	ShowTemplates(ctx context.Context)
+
+	ExportBoard(ctx context.Context, boardID, dest string)
+	ImportBoard(ctx context.Context, path string)
+	ImportTrelloBoard(ctx context.Context, path string, dryRun bool)
+
+	Search(ctx context.Context, search dto.CardSearch, limit, offset int)
+
+	RunBatch(ctx context.Context, path string, bestEffort bool)
+
 	Stats(ctx context.Context, from, to string)
 }
@@ -5,11 +5,25 @@ import (
 	"cli/internal/service"
 	"cli/internal/usecase"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	dateLayout     = "02-01-2006"
+	dateTimeLayout = "02-01-2006 15:04"
+	// watchRetryDelay is how long watch waits before reconnecting
+	watchRetryDelay = 2 * time.Second
+)
+
 type ClientUseCase struct {
 	svc service.AggregatorService
 }
@@ -35,17 +49,1888 @@ func (uc *ClientUseCase) Refresh(ctx context.Context, refreshToken string) (*dto
 	return resp, err
 }
 
-func (uc *ClientUseCase) Validate(ctx context.Context, token string) (*dto.ValidateTokenResponse, error) {
-	resp, err := uc.svc.Validate(ctx, token)
-	return resp, err
-}
+func (uc *ClientUseCase) Validate(ctx context.Context, token string) (*dto.ValidateTokenResponse, error) {
+	resp, err := uc.svc.Validate(ctx, token)
+	return resp, err
+}
+
+func (uc *ClientUseCase) Logout(ctx context.Context, refreshToken string) error {
+	err := uc.svc.Logout(ctx, refreshToken)
+	return err
+}
+
+func (uc *ClientUseCase) ShowBoards(ctx context.Context, archived bool, page dto.PageRequest) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = resp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	boards, err := uc.svc.ShowBoards(ctx, archived, page)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	for i, board := range boards.Boards {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, board.ID, board.Title)
+		if board.ArchivedAt != nil {
+			fmt.Printf("Archived: %s\n", board.ArchivedAt.Local().Format("02-01-2006 15:04"))
+		}
+	}
+
+	printNextCursor(boards.NextCursor)
+}
+
+func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string, archived bool, page dto.PageRequest) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = resp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	board, err := uc.svc.ShowBoard(ctx, boardID, archived, page)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	printLabels(board.Labels)
+
+	for i, column := range board.Columns {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, column.ID, column.Title)
+		printCardCount(column)
+	}
+
+	printNextCursor(board.NextCursor)
+}
+
+func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string, page dto.PageRequest) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cards, err := uc.svc.ShowColumn(ctx, columnID, page)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	for i, card := range cards.Cards {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
+		printLabels(card.Labels)
+		printAssignees(card)
+		printCardDates(card)
+		if card.Checklist != nil {
+			fmt.Printf("Checklist: %d/%d\n", card.Checklist.Done, card.Checklist.Total)
+		}
+	}
+
+	printNextCursor(cards.NextCursor)
+}
+
+func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	card, err := uc.svc.ShowCard(ctx, cardID)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Title: %s\nDescription: %s\n", card.Title, card.Description)
+	printLabels(card.Labels)
+	printAssignees(*card)
+	printCardDates(*card)
+	printAttachments(card.Attachments)
+
+	checklists, err := uc.svc.GetChecklists(ctx, cardID)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	printChecklists(checklists)
+}
+
+func (uc *ClientUseCase) ShowComments(ctx context.Context, cardID string, limit, offset int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	comments, err := uc.svc.ShowComments(ctx, cardID, limit, offset)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(comments) == 0 {
+		fmt.Println("No comments.")
+		return
+	}
+
+	for i, comment := range comments {
+		edited := ""
+		if comment.EditedAt != nil {
+			edited = " (edited)"
+		}
+
+		fmt.Printf("%d. %s at %s%s\n%s\n", offset+i+1, comment.AuthorID, comment.CreatedAt.Format("02-01-2006 15:04"), edited, comment.Body)
+	}
+}
+
+func (uc *ClientUseCase) ShowBoardHistory(ctx context.Context, boardID string, limit, offset int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	activities, err := uc.svc.ShowBoardHistory(ctx, boardID, limit, offset)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	printHistory(activities)
+}
+
+func (uc *ClientUseCase) ShowCardHistory(ctx context.Context, cardID string, limit, offset int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	activities, err := uc.svc.ShowCardHistory(ctx, cardID, limit, offset)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	printHistory(activities)
+}
+
+func (uc *ClientUseCase) WatchBoard(ctx context.Context, boardID string) {
+	lastEventID := ""
+
+	for {
+		tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+		if !ok {
+			fmt.Println("failed to get tokens from context")
+			return
+		}
+
+		_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+		if err != nil {
+			// fmt.Println("Access token expired. Refreshing.")
+			refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+			if err != nil {
+				fmt.Println("Please log in again.")
+				return
+			}
+
+			tokens.AccessToken = refreshResp.AccessToken
+
+			fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+			if !ok {
+				fmt.Println("failed to get saveFunc from context")
+				return
+			}
+
+			fn(tokens)
+		}
+
+		events, err := uc.svc.WatchBoard(ctx, boardID, lastEventID)
+
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			return
+		}
+
+		if lastEventID == "" {
+			fmt.Println("Watching the board, press Ctrl+C to stop.")
+		}
+
+		for event := range events {
+			lastEventID = event.ID
+
+			if event.Change == nil {
+				fmt.Println("Some changes were missed, show the board again to catch up.")
+				continue
+			}
+
+			printHistory([]dto.Activity{*event.Change})
+		}
+
+		// The connection ended; pick up after the last change seen unless
+		// the watch was stopped
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+func printHistory(activities []dto.Activity) {
+	if len(activities) == 0 {
+		fmt.Println("No history.")
+		return
+	}
+
+	for _, activity := range activities {
+		actor := activity.Actor
+		if actor == "" {
+			actor = activity.ActorID.String()
+		}
+
+		fmt.Printf("%s %s %sd %s %s\n", activity.CreatedAt.Local().Format("02-01-2006 15:04"), actor, activity.Action, activity.TargetType, activity.TargetID)
+
+		for _, change := range activity.Changes {
+			fmt.Printf("    %s: %s -> %s\n", change.Field, historyValue(change.Before), historyValue(change.After))
+		}
+	}
+}
+
+func historyValue(value *string) string {
+	if value == nil {
+		return "-"
+	}
+
+	return strconv.Quote(*value)
+}
+
+func (uc *ClientUseCase) ShowRevisions(ctx context.Context, cardID string, limit, offset int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	revisions, err := uc.svc.ShowRevisions(ctx, cardID, limit, offset)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(revisions) == 0 {
+		fmt.Println("No revisions.")
+		return
+	}
+
+	for _, revision := range revisions {
+		fmt.Printf("#%d %s\n", revision.Number, revisionLabel(revision))
+		fmt.Printf("    Title: %s\n", strconv.Quote(revision.Title))
+	}
+}
+
+func (uc *ClientUseCase) DiffRevisions(ctx context.Context, cardID, fromStr, toStr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	from, err := strconv.Atoi(strings.TrimPrefix(fromStr, "#"))
+	if err != nil {
+		fmt.Println("failed parsing revision number")
+		return
+	}
+
+	to, err := strconv.Atoi(strings.TrimPrefix(toStr, "#"))
+	if err != nil {
+		fmt.Println("failed parsing revision number")
+		return
+	}
+
+	diff, err := uc.svc.DiffRevisions(ctx, cardID, from, to)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("--- #%d %s\n", diff.From.Number, revisionLabel(diff.From))
+	fmt.Printf("+++ #%d %s\n", diff.To.Number, revisionLabel(diff.To))
+	fmt.Println("Title:")
+	printDiff(diff.Title)
+	fmt.Println("Description:")
+	printDiff(diff.Description)
+}
+
+func (uc *ClientUseCase) RevertCard(ctx context.Context, cardID, numberStr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	number, err := strconv.Atoi(strings.TrimPrefix(numberStr, "#"))
+	if err != nil {
+		fmt.Println("failed parsing revision number")
+		return
+	}
+
+	card, err := uc.svc.RevertCard(ctx, cardID, number)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Card successfully reverted to revision #%d.\nTitle: %s\n", number, card.Title)
+}
+
+// revisionLabel tells when and by whom the revision was made
+func revisionLabel(revision dto.CardRevision) string {
+	author := revision.Author
+	if author == "" {
+		author = revision.AuthorID.String()
+	}
+
+	label := revision.CreatedAt.Local().Format("02-01-2006 15:04") + " " + author
+	if revision.RevertedFrom != nil {
+		label += fmt.Sprintf(" (reverted to #%d)", *revision.RevertedFrom)
+	}
+
+	return label
+}
+
+// printDiff prints the lines the way diff -u does
+func printDiff(lines []dto.DiffLine) {
+	for _, line := range lines {
+		switch line.Op {
+		case "insert":
+			fmt.Printf("+%s\n", line.Text)
+		case "delete":
+			fmt.Printf("-%s\n", line.Text)
+		default:
+			fmt.Printf(" %s\n", line.Text)
+		}
+	}
+}
+
+// printCardCount shows how full the column is against its WIP limit
+func printCardCount(column dto.Column) {
+	if column.WIPLimit == 0 {
+		fmt.Printf("Cards: %d\n", column.CardCount)
+		return
+	}
+
+	kind := "soft"
+	if column.WIPStrict {
+		kind = "strict"
+	}
+
+	fmt.Printf("Cards: %d/%d (%s limit)\n", column.CardCount, column.WIPLimit, kind)
+}
+
+// printWIPWarning warns when a card went past the soft WIP limit of its column
+func printWIPWarning(card *dto.Card) {
+	if card.OverWIPLimit {
+		fmt.Println("Warning: the column is over its WIP limit now.")
+	}
+}
+
+// printNextCursor tells how to get the next page, if there is one
+func printNextCursor(cursor string) {
+	if cursor == "" {
+		return
+	}
+
+	fmt.Printf("More on the next page: --cursor %s\n", cursor)
+}
+
+func printLabels(labels []dto.Label) {
+	if len(labels) == 0 {
+		return
+	}
+
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = fmt.Sprintf("%s (%s)", label.Name, label.Color)
+	}
+
+	fmt.Printf("Labels: %s\n", strings.Join(names, ", "))
+}
+
+// printAssignees prints the usernames of the assignees, falling back to their
+// ids when the aggregator could not look them up
+func printAssignees(card dto.Card) {
+	if len(card.AssigneeIDs) == 0 {
+		return
+	}
+
+	usernames := make(map[uuid.UUID]string, len(card.Assignees))
+	for _, assignee := range card.Assignees {
+		usernames[assignee.ID] = assignee.Username
+	}
+
+	names := make([]string, len(card.AssigneeIDs))
+	for i, id := range card.AssigneeIDs {
+		names[i] = id.String()
+		if usernames[id] != "" {
+			names[i] = usernames[id]
+		}
+	}
+
+	fmt.Printf("Assignees: %s\n", strings.Join(names, ", "))
+}
+
+func printCardDates(card dto.Card) {
+	if card.StartDate != nil {
+		fmt.Printf("Start: %s\n", card.StartDate.Local().Format(dateTimeLayout))
+	}
+
+	if card.DueDate != nil {
+		fmt.Printf("Due: %s\n", card.DueDate.Local().Format(dateTimeLayout))
+	}
+}
+
+func printAttachments(attachments []dto.Attachment) {
+	for _, attachment := range attachments {
+		fmt.Printf("Attachment %s: %s (%s, %d bytes)\n", attachment.ID, attachment.Filename, attachment.ContentType, attachment.Size)
+	}
+}
+
+func printChecklists(checklists []dto.Checklist) {
+	for _, checklist := range checklists {
+		fmt.Printf("Checklist %s: %s\n", checklist.ID, checklist.Title)
+
+		for _, item := range checklist.Items {
+			mark := " "
+			if item.Done {
+				mark = "x"
+			}
+
+			fmt.Printf("  [%s] %s %s\n", mark, item.ID, item.Text)
+		}
+	}
+}
+
+func (uc *ClientUseCase) CreateBoard(ctx context.Context, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	board := dto.Board{
+		UserID: userID,
+		Title:  title,
+	}
+
+	err = uc.svc.CreateBoard(ctx, board)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board successfully created.")
+}
+
+func (uc *ClientUseCase) CreateColumn(ctx context.Context, boardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	column := dto.Column{
+		UserID:  userID,
+		BoardID: boardID,
+		Title:   title,
+	}
+
+	err = uc.svc.CreateColumn(ctx, column)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully created.")
+}
+
+func (uc *ClientUseCase) CreateCard(ctx context.Context, columnIDstr, title, description string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		fmt.Println("access token expired")
+		return
+	}
+
+	userID, err := uuid.Parse(resp.UserID)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	card := dto.Card{
+		UserID:      userID,
+		ColumnID:    columnID,
+		Title:       title,
+		Description: description,
+	}
+
+	err = uc.svc.CreateCard(ctx, &card)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully created.")
+	printWIPWarning(&card)
+}
+
+func (uc *ClientUseCase) CreateComment(ctx context.Context, cardIDstr, body string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	req := dto.CreateCommentRequest{
+		Body: body,
+	}
+
+	err = uc.svc.CreateComment(ctx, cardID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Comment successfully posted.")
+}
+
+func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	boardID, err := uuid.Parse(boardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing board uuid")
+		return
+	}
+
+	board := dto.Board{
+		ID:    boardID,
+		Title: title,
+	}
+
+	err = uc.svc.UpdateBoard(ctx, &board)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	column := dto.Column{
+		ID:    columnID,
+		Title: title,
+	}
+
+	err = uc.svc.UpdateColumn(ctx, &column)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column successfully updated.")
+}
+
+func (uc *ClientUseCase) SetWIPLimit(ctx context.Context, columnIDstr string, limit int, strict bool) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	if limit < 0 {
+		fmt.Println("Error: the limit cannot be negative")
+		return
+	}
+
+	req := dto.SetColumnWIPLimitRequest{
+		Limit:  limit,
+		Strict: strict,
+	}
+
+	column, err := uc.svc.SetColumnWIPLimit(ctx, columnID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if limit == 0 {
+		fmt.Println("WIP limit successfully lifted.")
+	} else {
+		fmt.Println("WIP limit successfully set.")
+	}
+
+	printCardCount(*column)
+}
+
+func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	// The update replaces the whole card, so it is made on top of the
+	// current one and only goes through if nobody changed it since
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Title = title
+
+	err = uc.svc.UpdateCard(ctx, card)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card title successfully updated.")
+}
+
+func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, description string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	// The update replaces the whole card, so it is made on top of the
+	// current one and only goes through if nobody changed it since
+	card, err := uc.svc.ShowCard(ctx, cardID.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	card.Description = description
+
+	err = uc.svc.UpdateCard(ctx, card)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card description successfully updated.")
+}
+
+func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr, beforeIDstr, afterIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	columnID, err := uuid.Parse(columnIDstr)
+	if err != nil {
+		fmt.Println("failed parsing column uuid")
+		return
+	}
+
+	req := dto.MoveCardRequest{
+		ColumnID: columnID,
+	}
+
+	if beforeIDstr != "" {
+		req.BeforeID, err = uuid.Parse(beforeIDstr)
+		if err != nil {
+			fmt.Println("failed parsing before card uuid")
+			return
+		}
+	}
+
+	if afterIDstr != "" {
+		req.AfterID, err = uuid.Parse(afterIDstr)
+		if err != nil {
+			fmt.Println("failed parsing after card uuid")
+			return
+		}
+	}
+
+	card, err := uc.svc.MoveCard(ctx, cardID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully moved.")
+	printWIPWarning(card)
+}
+
+func (uc *ClientUseCase) UpdateCardDates(ctx context.Context, cardIDstr, start, due string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	var req dto.SetCardDatesRequest
+
+	req.StartDate, err = parseCardDate(start, false)
+	if err != nil {
+		fmt.Println("failed parsing start date")
+		return
+	}
+
+	req.DueDate, err = parseCardDate(due, true)
+	if err != nil {
+		fmt.Println("failed parsing due date")
+		return
+	}
+
+	err = uc.svc.SetCardDates(ctx, cardID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card dates successfully updated.")
+}
+
+// parseCardDate accepts DD-MM-YYYY or DD-MM-YYYY HH:MM in local time; an
+// empty string is no date. A bare due date means the end of that day.
+func parseCardDate(s string, endOfDay bool) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if t, err := time.ParseInLocation(dateTimeLayout, s, time.Local); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.ParseInLocation(dateLayout, s, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Minute)
+	}
+
+	return &t, nil
+}
+
+func (uc *ClientUseCase) ShowOverdueCards(ctx context.Context) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cards, err := uc.svc.ShowOverdueCards(ctx)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(cards) == 0 {
+		fmt.Println("No overdue cards.")
+		return
+	}
+
+	printDueCards(cards)
+}
+
+func (uc *ClientUseCase) ShowDueCards(ctx context.Context, days int) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cards, err := uc.svc.ShowDueCards(ctx, days)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(cards) == 0 {
+		fmt.Printf("No cards due within %d days.\n", days)
+		return
+	}
+
+	printDueCards(cards)
+}
+
+func printDueCards(cards []dto.Card) {
+	for i, card := range cards {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
+		printCardDates(card)
+	}
+}
+
+func (uc *ClientUseCase) AssignCard(ctx context.Context, cardIDstr, userIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	err = uc.svc.AssignCard(ctx, cardID.String(), userID.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully assigned.")
+}
+
+func (uc *ClientUseCase) UnassignCard(ctx context.Context, cardIDstr, userIDstr string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDstr)
+	if err != nil {
+		fmt.Println("failed parsing user uuid")
+		return
+	}
+
+	err = uc.svc.UnassignCard(ctx, cardID.String(), userID.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Card successfully unassigned.")
+}
+
+func (uc *ClientUseCase) ShowAssignedCards(ctx context.Context) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cards, err := uc.svc.ShowAssignedCards(ctx)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if len(cards) == 0 {
+		fmt.Println("No cards assigned to you.")
+		return
+	}
+
+	for i, card := range cards {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
+		printAssignees(card)
+		printCardDates(card)
+	}
+}
+
+func (uc *ClientUseCase) AttachFile(ctx context.Context, cardIDstr, path string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if !info.Mode().IsRegular() {
+		fmt.Printf("Error: %s is not a regular file\n", path)
+		return
+	}
+
+	attachment, err := uc.svc.UploadAttachment(ctx, cardID.String(), filepath.Base(path), info.Size(), file)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Attachment successfully uploaded. ID: %s\n", attachment.ID)
+}
+
+// DownloadAttachment saves an attachment to dest. Without dest the file is
+// named after the attachment and saved to the current directory; existing
+// files are never overwritten.
+func (uc *ClientUseCase) DownloadAttachment(ctx context.Context, idStr, dest string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		fmt.Println("failed parsing attachment uuid")
+		return
+	}
+
+	content, err := uc.svc.GetAttachment(ctx, id.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	defer content.Body.Close()
+
+	// The name comes from the server, so only its last element is used
+	name := filepath.Base(content.Filename)
+	if name == "." || name == string(filepath.Separator) {
+		name = id.String()
+	}
+
+	path := dest
+	if info, err := os.Stat(dest); dest == "" || err == nil && info.IsDir() {
+		path = filepath.Join(dest, name)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	_, err = io.Copy(file, content.Body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(path)
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Attachment saved to %s.\n", path)
+}
+
+func (uc *ClientUseCase) DeleteAttachment(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.DeleteAttachment(ctx, id)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Attachment successfully deleted.")
+}
+
+func (uc *ClientUseCase) CreateChecklist(ctx context.Context, cardIDstr, title string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	cardID, err := uuid.Parse(cardIDstr)
+	if err != nil {
+		fmt.Println("failed parsing card uuid")
+		return
+	}
+
+	req := dto.CreateChecklistRequest{
+		Title: title,
+	}
+
+	err = uc.svc.CreateChecklist(ctx, cardID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Checklist successfully created.")
+}
+
+func (uc *ClientUseCase) AddChecklistItem(ctx context.Context, checklistIDstr, text string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	checklistID, err := uuid.Parse(checklistIDstr)
+	if err != nil {
+		fmt.Println("failed parsing checklist uuid")
+		return
+	}
+
+	req := dto.CreateChecklistItemRequest{
+		Text: text,
+	}
+
+	err = uc.svc.CreateChecklistItem(ctx, checklistID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Item successfully added.")
+}
+
+func (uc *ClientUseCase) SetChecklistItemDone(ctx context.Context, itemIDstr string, done bool) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	itemID, err := uuid.Parse(itemIDstr)
+	if err != nil {
+		fmt.Println("failed parsing item uuid")
+		return
+	}
+
+	req := dto.SetChecklistItemDoneRequest{
+		Done: done,
+	}
+
+	err = uc.svc.SetChecklistItemDone(ctx, itemID.String(), req)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if done {
+		fmt.Println("Item successfully checked.")
+	} else {
+		fmt.Println("Item successfully unchecked.")
+	}
+}
+
+func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.DeleteBoard(ctx, id)
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Board moved to the trash.")
+}
+
+func (uc *ClientUseCase) DeleteColumn(ctx context.Context, id string) {
+	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
+	if !ok {
+		fmt.Println("failed to get tokens from context")
+		return
+	}
+
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	if err != nil {
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
+
+		tokens.AccessToken = refreshResp.AccessToken
+
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
+	}
+
+	err = uc.svc.DeleteColumn(ctx, id)
 
-func (uc *ClientUseCase) Logout(ctx context.Context, refreshToken string) error {
-	err := uc.svc.Logout(ctx, refreshToken)
-	return err
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Println("Column moved to the trash.")
 }
 
-func (uc *ClientUseCase) ShowBoards(ctx context.Context) {
+func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -55,13 +1940,13 @@ func (uc *ClientUseCase) ShowBoards(ctx context.Context) {
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = resp.AccessToken
+		tokens.AccessToken = refreshResp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -72,34 +1957,33 @@ func (uc *ClientUseCase) ShowBoards(ctx context.Context) {
 		fn(tokens)
 	}
 
-	boards, err := uc.svc.ShowBoards(ctx)
+	err = uc.svc.DeleteCard(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	for i, board := range boards {
-		fmt.Printf("%d. %s\nTitle: %s\n", i+1, board.ID, board.Title)
-	}
+	fmt.Println("Card moved to the trash.")
 }
 
-func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string) {
+func (uc *ClientUseCase) ShowTrash(ctx context.Context, limit, offset int) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
+		return
 	}
 
 	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
 		// fmt.Println("Access token expired. Refreshing.")
-		resp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
 		if err != nil {
 			fmt.Println("Please log in again.")
 			return
 		}
 
-		tokens.AccessToken = resp.AccessToken
+		tokens.AccessToken = refreshResp.AccessToken
 
 		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
 		if !ok {
@@ -110,19 +1994,24 @@ func (uc *ClientUseCase) ShowBoard(ctx context.Context, boardID string) {
 		fn(tokens)
 	}
 
-	columns, err := uc.svc.ShowBoard(ctx, boardID)
+	items, err := uc.svc.ShowTrash(ctx, limit, offset)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	for i, column := range columns {
-		fmt.Printf("%d. %s\nTitle: %s\n", i+1, column.ID, column.Title)
+	if len(items) == 0 {
+		fmt.Println("Trash is empty.")
+		return
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s %-6s %s %s\n", item.DeletedAt.Local().Format("02-01-2006 15:04"), item.Type, item.ID, item.Title)
 	}
 }
 
-func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string) {
+func (uc *ClientUseCase) Restore(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -149,19 +2038,17 @@ func (uc *ClientUseCase) ShowColumn(ctx context.Context, columnID string) {
 		fn(tokens)
 	}
 
-	cards, err := uc.svc.ShowColumn(ctx, columnID)
+	item, err := uc.svc.RestoreItem(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	for i, card := range cards {
-		fmt.Printf("%d. %s\nTitle: %s\n", i+1, card.ID, card.Title)
-	}
+	fmt.Printf("Restored %s %s.\n", item.Type, strconv.Quote(item.Title))
 }
 
-func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
+func (uc *ClientUseCase) ArchiveBoard(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -188,134 +2075,128 @@ func (uc *ClientUseCase) ShowCard(ctx context.Context, cardID string) {
 		fn(tokens)
 	}
 
-	card, err := uc.svc.ShowCard(ctx, cardID)
+	err = uc.svc.ArchiveBoard(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Printf("Title: %s\nDescription: %s\n", card.Title, card.Description)
+	fmt.Println("Board archived.")
 }
 
-func (uc *ClientUseCase) CreateBoard(ctx context.Context, title string) {
+func (uc *ClientUseCase) UnarchiveBoard(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
 		return
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
-		fmt.Println("access token expired")
-		return
-	}
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
 
-	userID, err := uuid.Parse(resp.UserID)
-	if err != nil {
-		fmt.Println("failed parsing user uuid")
-		return
-	}
+		tokens.AccessToken = refreshResp.AccessToken
 
-	board := dto.Board{
-		UserID: userID,
-		Title:  title,
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
+
+		fn(tokens)
 	}
 
-	err = uc.svc.CreateBoard(ctx, board)
+	err = uc.svc.UnarchiveBoard(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Board successfully created.")
+	fmt.Println("Board unarchived.")
 }
 
-func (uc *ClientUseCase) CreateColumn(ctx context.Context, boardIDstr, title string) {
+func (uc *ClientUseCase) ArchiveColumn(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
 		return
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
-		fmt.Println("access token expired")
-		return
-	}
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
 
-	userID, err := uuid.Parse(resp.UserID)
-	if err != nil {
-		fmt.Println("failed parsing user uuid")
-		return
-	}
+		tokens.AccessToken = refreshResp.AccessToken
 
-	boardID, err := uuid.Parse(boardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing board uuid")
-		return
-	}
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
 
-	column := dto.Column{
-		UserID:  userID,
-		BoardID: boardID,
-		Title:   title,
+		fn(tokens)
 	}
 
-	err = uc.svc.CreateColumn(ctx, column)
+	err = uc.svc.ArchiveColumn(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Column successfully created.")
+	fmt.Println("Column archived.")
 }
 
-func (uc *ClientUseCase) CreateCard(ctx context.Context, columnIDstr, title, description string) {
+func (uc *ClientUseCase) UnarchiveColumn(ctx context.Context, id string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
 		return
 	}
 
-	resp, err := uc.svc.Validate(ctx, tokens.AccessToken)
+	_, err := uc.svc.Validate(ctx, tokens.AccessToken)
 	if err != nil {
-		fmt.Println("access token expired")
-		return
-	}
+		// fmt.Println("Access token expired. Refreshing.")
+		refreshResp, err := uc.svc.Refresh(ctx, tokens.RefreshToken)
+		if err != nil {
+			fmt.Println("Please log in again.")
+			return
+		}
 
-	userID, err := uuid.Parse(resp.UserID)
-	if err != nil {
-		fmt.Println("failed parsing user uuid")
-		return
-	}
+		tokens.AccessToken = refreshResp.AccessToken
 
-	columnID, err := uuid.Parse(columnIDstr)
-	if err != nil {
-		fmt.Println("failed parsing column uuid")
-		return
-	}
+		fn, ok := ctx.Value("saveFunc").(func(*dto.Tokens))
+		if !ok {
+			fmt.Println("failed to get saveFunc from context")
+			return
+		}
 
-	card := dto.Card{
-		UserID:      userID,
-		ColumnID:    columnID,
-		Title:       title,
-		Description: description,
+		fn(tokens)
 	}
 
-	err = uc.svc.CreateCard(ctx, card)
+	err = uc.svc.UnarchiveColumn(ctx, id)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card successfully created.")
+	fmt.Println("Column unarchived.")
 }
 
-func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title string) {
+func (uc *ClientUseCase) CloneBoard(ctx context.Context, boardID, title string, excludeCards bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -342,28 +2223,17 @@ func (uc *ClientUseCase) UpdateBoard(ctx context.Context, boardIDstr, title stri
 		fn(tokens)
 	}
 
-	boardID, err := uuid.Parse(boardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing board uuid")
-		return
-	}
-
-	board := dto.Board{
-		ID:    boardID,
-		Title: title,
-	}
-
-	err = uc.svc.UpdateBoard(ctx, &board)
+	board, err := uc.svc.CloneBoard(ctx, boardID, dto.CloneBoardRequest{Title: title, ExcludeCards: excludeCards})
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Board successfully updated.")
+	fmt.Printf("Board created.\n%s\nTitle: %s\n", board.ID, board.Title)
 }
 
-func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title string) {
+func (uc *ClientUseCase) SaveTemplate(ctx context.Context, boardID, title string, excludeCards bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -390,28 +2260,17 @@ func (uc *ClientUseCase) UpdateColumn(ctx context.Context, columnIDstr, title st
 		fn(tokens)
 	}
 
-	columnID, err := uuid.Parse(columnIDstr)
-	if err != nil {
-		fmt.Println("failed parsing column uuid")
-		return
-	}
-
-	column := dto.Column{
-		ID:    columnID,
-		Title: title,
-	}
-
-	err = uc.svc.UpdateColumn(ctx, &column)
+	template, err := uc.svc.CloneBoard(ctx, boardID, dto.CloneBoardRequest{Title: title, ExcludeCards: excludeCards, Template: true})
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Column successfully updated.")
+	fmt.Printf("Template saved.\n%s\nTitle: %s\n", template.ID, template.Title)
 }
 
-func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title string) {
+func (uc *ClientUseCase) ShowTemplates(ctx context.Context) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -438,28 +2297,21 @@ func (uc *ClientUseCase) UpdateCardTitle(ctx context.Context, cardIDstr, title s
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
-	if err != nil {
-		fmt.Println("failed parsing card uuid")
-		return
-	}
-
-	card := dto.Card{
-		ID:    cardID,
-		Title: title,
-	}
-
-	err = uc.svc.UpdateCard(ctx, &card)
+	templates, err := uc.svc.ShowTemplates(ctx)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card title successfully updated.")
+	for i, template := range templates {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, template.ID, template.Title)
+	}
 }
 
-func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, description string) {
+// ExportBoard saves the export of a board to dest, or to board-<id>.json in
+// the current directory without it; existing files are never overwritten.
+func (uc *ClientUseCase) ExportBoard(ctx context.Context, boardIDstr, dest string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -486,28 +2338,45 @@ func (uc *ClientUseCase) UpdateCardDescription(ctx context.Context, cardIDstr, d
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
+	boardID, err := uuid.Parse(boardIDstr)
 	if err != nil {
-		fmt.Println("failed parsing card uuid")
+		fmt.Println("failed parsing board uuid")
 		return
 	}
 
-	card := dto.Card{
-		ID:          cardID,
-		Description: description,
+	export, err := uc.svc.ExportBoard(ctx, boardID.String())
+
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
 	}
 
-	err = uc.svc.UpdateCard(ctx, &card)
+	path := dest
+	if path == "" {
+		path = fmt.Sprintf("board-%s.json", boardID)
+	}
 
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card description successfully updated.")
+	_, err = file.Write(export)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(path)
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	fmt.Printf("Board exported to %s.\n", path)
 }
 
-func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr string) {
+func (uc *ClientUseCase) ImportBoard(ctx context.Context, path string) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -534,34 +2403,30 @@ func (uc *ClientUseCase) MoveCard(ctx context.Context, cardIDstr, columnIDstr st
 		fn(tokens)
 	}
 
-	cardID, err := uuid.Parse(cardIDstr)
+	export, err := os.ReadFile(path)
 	if err != nil {
-		fmt.Println("failed parsing card uuid")
+		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	columnID, err := uuid.Parse(columnIDstr)
-	if err != nil {
-		fmt.Println("failed parsing column uuid")
+	if !json.Valid(export) {
+		fmt.Printf("Error: %s is not a JSON file\n", path)
 		return
 	}
 
-	card := dto.Card{
-		ID:       cardID,
-		ColumnID: columnID,
-	}
-
-	err = uc.svc.UpdateCard(ctx, &card)
+	board, err := uc.svc.ImportBoard(ctx, export)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card successfully moved.")
+	fmt.Printf("Board imported.\n%s\nTitle: %s\n", board.ID, board.Title)
 }
 
-func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
+// ImportTrelloBoard creates a board from a Trello board JSON export and
+// prints what was imported and what was left out. A dry run only prints.
+func (uc *ClientUseCase) ImportTrelloBoard(ctx context.Context, path string, dryRun bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -588,17 +2453,60 @@ func (uc *ClientUseCase) DeleteBoard(ctx context.Context, id string) {
 		fn(tokens)
 	}
 
-	err = uc.svc.DeleteBoard(ctx, id)
+	trello, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	if !json.Valid(trello) {
+		fmt.Printf("Error: %s is not a JSON file\n", path)
+		return
+	}
+
+	summary, err := uc.svc.ImportTrelloBoard(ctx, trello, dryRun)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Board successfully deleted.")
+	if summary.DryRun {
+		fmt.Println("Dry run, nothing was imported.")
+	} else {
+		fmt.Printf("Board imported.\n%s\n", summary.Board.ID)
+	}
+
+	fmt.Printf("Title: %s\nLabels: %d\nColumns: %d\nCards: %d\n", summary.Title, summary.Labels, summary.Columns, summary.Cards)
+
+	skipped := []struct {
+		what  string
+		count int
+	}{
+		{"archived cards", summary.Skipped.ArchivedCards},
+		{"attachments", summary.Skipped.Attachments},
+		{"board members", summary.Skipped.Members},
+		{"card members", summary.Skipped.CardMembers},
+		{"checklists", summary.Skipped.Checklists},
+		{"comments", summary.Skipped.Comments},
+	}
+
+	header := false
+	for _, s := range skipped {
+		if s.count == 0 {
+			continue
+		}
+
+		if !header {
+			fmt.Println("Skipped:")
+			header = true
+		}
+
+		fmt.Printf("  %s: %d\n", s.what, s.count)
+	}
 }
 
-func (uc *ClientUseCase) DeleteColumn(ctx context.Context, id string) {
+func (uc *ClientUseCase) Search(ctx context.Context, search dto.CardSearch, limit, offset int) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -625,17 +2533,33 @@ func (uc *ClientUseCase) DeleteColumn(ctx context.Context, id string) {
 		fn(tokens)
 	}
 
-	err = uc.svc.DeleteColumn(ctx, id)
+	matches, err := uc.svc.SearchCards(ctx, search, limit, offset)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Column successfully deleted.")
+	if len(matches) == 0 {
+		fmt.Println("Nothing found.")
+		return
+	}
+
+	// The terminal has no bold, so the matched words are starred instead
+	highlight := strings.NewReplacer("<b>", "*", "</b>", "*")
+
+	for i, match := range matches {
+		fmt.Printf("%d. %s\nTitle: %s\n", i+1, match.Card.ID, highlight.Replace(match.TitleHeadline))
+		if match.Card.Description != "" {
+			fmt.Printf("Description: %s\n", highlight.Replace(match.DescriptionHeadline))
+		}
+		fmt.Printf("Board: %s\nColumn: %s\n", match.BoardID, match.Card.ColumnID)
+	}
 }
 
-func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
+// RunBatch runs the operations listed in the JSON file at path, an array of
+// objects with op, type and the fields of the matching command
+func (uc *ClientUseCase) RunBatch(ctx context.Context, path string, bestEffort bool) {
 	tokens, ok := ctx.Value("tokens").(*dto.Tokens)
 	if !ok {
 		fmt.Println("failed to get tokens from context")
@@ -662,14 +2586,48 @@ func (uc *ClientUseCase) DeleteCard(ctx context.Context, id string) {
 		fn(tokens)
 	}
 
-	err = uc.svc.DeleteCard(ctx, id)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+
+	var req dto.BatchRequest
+	if err := json.Unmarshal(data, &req.Operations); err != nil {
+		fmt.Printf("Error: %s is not a JSON array of operations\n", path)
+		return
+	}
+
+	if bestEffort {
+		req.Mode = "best_effort"
+	}
+
+	batch, err := uc.svc.RunBatch(ctx, req)
 
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return
 	}
 
-	fmt.Println("Card successfully deleted.")
+	applied := 0
+	for i, result := range batch.Results {
+		op := req.Operations[i]
+		fmt.Printf("%d. %s %s: %s", i+1, op.Op, op.Type, strings.ReplaceAll(result.Status, "_", " "))
+		if result.ID != uuid.Nil {
+			fmt.Printf(" (%s)", result.ID)
+		}
+		fmt.Println()
+
+		if result.Error != "" {
+			fmt.Printf("   %s\n", result.Error)
+		}
+
+		if result.Status == "applied" {
+			applied++
+		}
+	}
+
+	fmt.Printf("%d of %d operations applied.\n", applied, len(batch.Results))
 }
 
 func (uc *ClientUseCase) Stats(ctx context.Context, from, to string) {
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 	_ "time/tzdata"
+	"todo/internal/adapter/blob"
 	"todo/internal/adapter/database"
+	feedBroker "todo/internal/adapter/feed"
 	"todo/internal/adapter/logger"
+	reminderSink "todo/internal/adapter/reminder"
+	"todo/internal/repository"
 
 	"log"
 	"net/http"
@@ -14,6 +19,9 @@ import (
 	"todo/internal/config"
 	handler "todo/internal/handler/v1"
 	"todo/internal/middleware"
+	"todo/internal/outbox"
+	"todo/internal/reminder"
+	"todo/internal/trash"
 	usecase "todo/internal/usecase/v1"
 
 	"github.com/gorilla/mux"
@@ -44,13 +52,73 @@ func main() {
 	boardRepo := sqlxRepo.NewSQLXBoardRepository(db)
 	columnRepo := sqlxRepo.NewSQLXColumnRepository(db)
 	cardRepo := sqlxRepo.NewSQLXCardRepository(db)
+	shareTokenRepo := sqlxRepo.NewSQLXShareTokenRepository(db)
+	memberRepo := sqlxRepo.NewSQLXBoardMemberRepository(db)
+	labelRepo := sqlxRepo.NewSQLXLabelRepository(db)
+	checklistRepo := sqlxRepo.NewSQLXChecklistRepository(db)
+	commentRepo := sqlxRepo.NewSQLXCommentRepository(db)
+	assigneeRepo := sqlxRepo.NewSQLXAssigneeRepository(db)
+	attachmentRepo := sqlxRepo.NewSQLXAttachmentRepository(db)
+	activityRepo := sqlxRepo.NewSQLXActivityRepository(db)
+	trashRepo := sqlxRepo.NewSQLXTrashRepository(db)
+	revisionRepo := sqlxRepo.NewSQLXRevisionRepository(db)
+	outboxRepo := sqlxRepo.NewSQLXOutboxRepository(db)
+	transactor := sqlxRepo.NewSQLXTransactor(db)
 
-	uc := usecase.NewTodoUseCase(boardRepo, columnRepo, cardRepo, logger)
+	attachmentsConfig := config.Todo.Attachments
 
-	userHandler := handler.NewTodoHandler(uc, config.Pagination)
+	var blobStore repository.BlobStore = blob.NewLocalBlobStore(attachmentsConfig.LocalPath)
+	if attachmentsConfig.Store == "s3" {
+		s3 := attachmentsConfig.S3
+		blobStore = blob.NewS3BlobStore(s3.Endpoint, s3.Region, s3.Bucket, s3.AccessKey, s3.SecretKey)
+	}
+
+	if config.Todo.Reminder.Enabled {
+		reminderConfig := config.Todo.Reminder
+
+		var sink reminder.Sink = reminderSink.NewLogSink(logger)
+		if reminderConfig.Sink == "webhook" {
+			timeout := time.Duration(reminderConfig.WebhookTimeoutSec) * time.Second
+			sink = reminderSink.NewWebhookSink(reminderConfig.WebhookURL, timeout)
+		}
+
+		interval := time.Duration(reminderConfig.IntervalSec) * time.Second
+		lead := time.Duration(reminderConfig.LeadSec) * time.Second
+		scheduler := reminder.NewScheduler(cardRepo, sink, interval, lead, logger)
+
+		go scheduler.Run(context.Background())
+	}
+
+	if config.Todo.Trash.RetentionDays > 0 {
+		trashConfig := config.Todo.Trash
+
+		interval := time.Duration(trashConfig.PurgeIntervalSec) * time.Second
+		retention := time.Duration(trashConfig.RetentionDays) * 24 * time.Hour
+		purger := trash.NewPurger(trashRepo, blobStore, transactor, interval, retention, logger)
+
+		go purger.Run(context.Background())
+	}
+
+	broker := feedBroker.NewMemoryBroker(config.Todo.Events.Backlog)
+
+	outboxConfig := config.Todo.Outbox
+
+	relayInterval := time.Duration(outboxConfig.IntervalMs) * time.Millisecond
+	retryBase := time.Duration(outboxConfig.RetryBaseSec) * time.Second
+	retryMax := time.Duration(outboxConfig.RetryMaxSec) * time.Second
+	relay := outbox.NewRelay(outboxRepo, broker, transactor, relayInterval, outboxConfig.BatchSize, retryBase, retryMax, logger)
+
+	go relay.Run(context.Background())
+
+	uc := usecase.NewTodoUseCase(boardRepo, columnRepo, cardRepo, shareTokenRepo, memberRepo, labelRepo, checklistRepo, commentRepo, assigneeRepo, attachmentRepo, blobStore, activityRepo, trashRepo, revisionRepo, outboxRepo, broker, transactor, logger)
+
+	heartbeat := time.Duration(config.Todo.Events.HeartbeatSec) * time.Second
+	userHandler := handler.NewTodoHandler(uc, config.Pagination, heartbeat)
 	router := mux.NewRouter()
 	loggingMiddleware := middleware.NewLoggingMiddleware(logger)
+	identityMiddleware := middleware.NewIdentityMiddleware()
 	router.Use(loggingMiddleware.Middleware)
+	router.Use(identityMiddleware.Middleware)
 	api.InitializeV1Routes(router, userHandler)
 
 	localPort := fmt.Sprintf("%d", config.Todo.LocalPort)
@@ -0,0 +1,98 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"todo/internal/repository"
+)
+
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// LocalBlobStore keeps blobs as files under a root directory; the key is the
+// path of the file relative to the root
+type LocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) *LocalBlobStore {
+	return &LocalBlobStore{root: root}
+}
+
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	// The content is written next to its final place and renamed only once
+	// it is complete, so readers never see a partial file
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	written, err := io.Copy(tmp, io.LimitReader(content, size+1))
+	if err != nil {
+		return err
+	}
+
+	if written != size {
+		return fmt.Errorf("expected %d bytes, got %d", size, written)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, repository.ErrNotFound
+	}
+
+	return file, err
+}
+
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// path resolves the key under the root and refuses keys that would escape it
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if key == "" || filepath.IsAbs(key) || !filepath.IsLocal(key) || strings.Contains(key, `\`) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
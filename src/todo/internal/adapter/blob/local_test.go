@@ -0,0 +1,60 @@
+package blob_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"todo/internal/adapter/blob"
+	"todo/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := blob.NewLocalBlobStore(t.TempDir())
+
+	err := store.Put(ctx, "cards/1/2", strings.NewReader("hello"), 5, "text/plain")
+	assert.Nil(t, err)
+
+	content, err := store.Get(ctx, "cards/1/2")
+	assert.Nil(t, err)
+	data, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "hello", string(data))
+
+	assert.Nil(t, store.Delete(ctx, "cards/1/2"))
+	assert.Nil(t, store.Delete(ctx, "cards/1/2"))
+
+	_, err = store.Get(ctx, "cards/1/2")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestLocalBlobStoreSizeMismatch(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store := blob.NewLocalBlobStore(root)
+
+	for _, content := range []string{"hell", "hello!"} {
+		err := store.Put(ctx, "cards/1/2", strings.NewReader(content), 5, "text/plain")
+		assert.NotNil(t, err, content)
+	}
+
+	// Neither the blob nor the temporary file is left behind
+	entries, err := os.ReadDir(filepath.Join(root, "cards", "1"))
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLocalBlobStoreKeyOutsideRoot(t *testing.T) {
+	ctx := context.Background()
+	store := blob.NewLocalBlobStore(t.TempDir())
+
+	for _, key := range []string{"", "../escape", "/etc/passwd", "cards/../../escape"} {
+		err := store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain")
+		assert.ErrorIs(t, err, blob.ErrInvalidKey, key)
+	}
+}
@@ -0,0 +1,156 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// subscriberBuffer is how many events a subscriber can fall behind before it
+// is dropped
+const subscriberBuffer = 64
+
+// published is an event kept for replay along with its place in the feed
+type published struct {
+	seq   uint64
+	event entity.Event
+}
+
+// MemoryBroker is an in-process broker that keeps the last events of all
+// boards for subscribers resuming after a dropped connection. Event ids carry
+// the epoch of the broker, so ids handed out before a restart are told apart
+// and answered with a reset.
+type MemoryBroker struct {
+	mu      sync.Mutex
+	epoch   string
+	seq     uint64
+	backlog int
+	events  []published
+	// evicted is the sequence of the newest event no longer kept
+	evicted     uint64
+	subscribers map[uuid.UUID]map[chan entity.Event]struct{}
+}
+
+func NewMemoryBroker(backlog int) *MemoryBroker {
+	return &MemoryBroker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		backlog:     backlog,
+		subscribers: make(map[uuid.UUID]map[chan entity.Event]struct{}),
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, events []entity.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		b.seq++
+		event.ID = b.eventID(b.seq)
+
+		b.events = append(b.events, published{seq: b.seq, event: event})
+		if len(b.events) > b.backlog {
+			b.evicted = b.events[0].seq
+			b.events = append(b.events[:0], b.events[1:]...)
+		}
+
+		for ch := range b.subscribers[event.BoardID] {
+			select {
+			case ch <- event:
+			default:
+				// A subscriber that cannot keep up is let go; it resumes
+				// with the id of the last event it got
+				b.unsubscribe(event.BoardID, ch)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []entity.Event
+	if lastEventID != "" {
+		replay = b.replay(boardID, lastEventID)
+	}
+
+	ch := make(chan entity.Event, len(replay)+subscriberBuffer)
+	for _, event := range replay {
+		ch <- event
+	}
+
+	if b.subscribers[boardID] == nil {
+		b.subscribers[boardID] = make(map[chan entity.Event]struct{})
+	}
+	b.subscribers[boardID][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.unsubscribe(boardID, ch)
+	}()
+
+	return ch, nil
+}
+
+// replay returns the kept events of the board published after lastEventID,
+// or a reset when some of them are gone or the id is not one of this broker
+func (b *MemoryBroker) replay(boardID uuid.UUID, lastEventID string) []entity.Event {
+	after, ok := b.parseEventID(lastEventID)
+	if !ok || after < b.evicted || after > b.seq {
+		return []entity.Event{{ID: b.eventID(b.seq), BoardID: boardID, Reset: true}}
+	}
+
+	var replay []entity.Event
+	for _, p := range b.events {
+		if p.seq > after && p.event.BoardID == boardID {
+			replay = append(replay, p.event)
+		}
+	}
+
+	return replay
+}
+
+// unsubscribe closes the channel unless it is already gone
+func (b *MemoryBroker) unsubscribe(boardID uuid.UUID, ch chan entity.Event) {
+	subscribers := b.subscribers[boardID]
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+
+	delete(subscribers, ch)
+	close(ch)
+
+	if len(subscribers) == 0 {
+		delete(b.subscribers, boardID)
+	}
+}
+
+func (b *MemoryBroker) eventID(seq uint64) string {
+	return fmt.Sprintf("%s-%d", b.epoch, seq)
+}
+
+func (b *MemoryBroker) parseEventID(id string) (uint64, bool) {
+	epoch, seq, found := strings.Cut(id, "-")
+	if !found || epoch != b.epoch {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
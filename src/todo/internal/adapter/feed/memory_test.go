@@ -0,0 +1,147 @@
+package feed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todo/internal/adapter/feed"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func event(boardID uuid.UUID) entity.Event {
+	return entity.Event{BoardID: boardID, Change: entity.Activity{ID: uuid.New(), BoardIDs: []uuid.UUID{boardID}}}
+}
+
+func receive(t *testing.T, events <-chan entity.Event) entity.Event {
+	t.Helper()
+
+	select {
+	case e, ok := <-events:
+		assert.True(t, ok, "channel closed")
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return entity.Event{}
+	}
+}
+
+func TestMemoryBrokerDeliversToBoardSubscribers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	broker := feed.NewMemoryBroker(10)
+	boardID, otherID := uuid.New(), uuid.New()
+
+	events, err := broker.Subscribe(ctx, boardID, "")
+	assert.Nil(t, err)
+
+	e1, e2 := event(boardID), event(boardID)
+	assert.Nil(t, broker.Publish(ctx, []entity.Event{e1, event(otherID), e2}))
+
+	got1 := receive(t, events)
+	got2 := receive(t, events)
+	assert.Equal(t, e1.Change.ID, got1.Change.ID)
+	assert.Equal(t, e2.Change.ID, got2.Change.ID)
+	assert.NotEmpty(t, got1.ID)
+	assert.NotEqual(t, got1.ID, got2.ID)
+	assert.Empty(t, events)
+}
+
+func TestMemoryBrokerResumesAfterLastEventID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	broker := feed.NewMemoryBroker(10)
+	boardID := uuid.New()
+
+	first, _ := broker.Subscribe(ctx, boardID, "")
+	e1, e2, e3 := event(boardID), event(boardID), event(boardID)
+	broker.Publish(ctx, []entity.Event{e1, e2, e3})
+	lastID := receive(t, first).ID
+
+	resumed, err := broker.Subscribe(ctx, boardID, lastID)
+	assert.Nil(t, err)
+
+	assert.Equal(t, e2.Change.ID, receive(t, resumed).Change.ID)
+	assert.Equal(t, e3.Change.ID, receive(t, resumed).Change.ID)
+	assert.Empty(t, resumed)
+}
+
+func TestMemoryBrokerResetsOnGap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	broker := feed.NewMemoryBroker(2)
+	boardID := uuid.New()
+
+	first, _ := broker.Subscribe(ctx, boardID, "")
+	broker.Publish(ctx, []entity.Event{event(boardID)})
+	lastID := receive(t, first).ID
+
+	broker.Publish(ctx, []entity.Event{event(boardID), event(boardID), event(boardID)})
+
+	tests := []struct {
+		name        string
+		lastEventID string
+	}{
+		{"evicted events", lastID},
+		{"earlier broker", "0-1"},
+		{"malformed id", "garbage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := broker.Subscribe(ctx, boardID, tt.lastEventID)
+			assert.Nil(t, err)
+
+			reset := receive(t, events)
+			assert.True(t, reset.Reset)
+			assert.Equal(t, boardID, reset.BoardID)
+			assert.NotEmpty(t, reset.ID)
+			assert.Empty(t, events)
+		})
+	}
+}
+
+func TestMemoryBrokerDropsSlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	broker := feed.NewMemoryBroker(1000)
+	boardID := uuid.New()
+
+	events, _ := broker.Subscribe(ctx, boardID, "")
+
+	for i := 0; i < 100; i++ {
+		broker.Publish(ctx, []entity.Event{event(boardID)})
+	}
+
+	count := 0
+	for range events {
+		count++
+	}
+
+	assert.Less(t, count, 100)
+}
+
+func TestMemoryBrokerUnsubscribesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	broker := feed.NewMemoryBroker(10)
+	boardID := uuid.New()
+
+	events, _ := broker.Subscribe(ctx, boardID, "")
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed")
+	}
+
+	assert.Nil(t, broker.Publish(context.TODO(), []entity.Event{event(boardID)}))
+}
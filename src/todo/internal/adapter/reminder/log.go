@@ -0,0 +1,28 @@
+package reminder
+
+import (
+	"context"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+)
+
+// LogSink writes reminders to the service log
+type LogSink struct {
+	log logger.Logger
+}
+
+func NewLogSink(log logger.Logger) *LogSink {
+	return &LogSink{log: log}
+}
+
+func (s *LogSink) Send(ctx context.Context, reminder entity.Reminder) error {
+	s.log.Info(ctx, "Card is due soon",
+		"cardID", reminder.CardID,
+		"columnID", reminder.ColumnID,
+		"userID", reminder.UserID,
+		"title", reminder.Title,
+		"dueDate", reminder.DueDate,
+	)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const EventCardDueSoon = "card.due_soon"
+
+type webhookPayload struct {
+	Event    string    `json:"event"`
+	CardID   uuid.UUID `json:"card_id"`
+	ColumnID uuid.UUID `json:"column_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Title    string    `json:"title"`
+	DueDate  time.Time `json:"due_date"`
+}
+
+// WebhookSink posts reminders as JSON to a configured URL
+type WebhookSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *WebhookSink) Send(ctx context.Context, reminder entity.Reminder) error {
+	payload := webhookPayload{
+		Event:    EventCardDueSoon,
+		CardID:   reminder.CardID,
+		ColumnID: reminder.ColumnID,
+		UserID:   reminder.UserID,
+		Title:    reminder.Title,
+		DueDate:  reminder.DueDate,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
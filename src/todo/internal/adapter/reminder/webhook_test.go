@@ -0,0 +1,72 @@
+package reminder_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo/internal/adapter/reminder"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSinkSend(t *testing.T) {
+	received := make(chan map[string]any, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		received <- payload
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	due := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := entity.Reminder{CardID: uuid.New(), ColumnID: uuid.New(), UserID: uuid.New(), Title: "Card", DueDate: due}
+
+	sink := reminder.NewWebhookSink(server.URL, time.Second)
+	err := sink.Send(context.TODO(), r)
+
+	assert.Nil(t, err)
+
+	payload := <-received
+	assert.Equal(t, reminder.EventCardDueSoon, payload["event"])
+	assert.Equal(t, r.CardID.String(), payload["card_id"])
+	assert.Equal(t, r.UserID.String(), payload["user_id"])
+	assert.Equal(t, "Card", payload["title"])
+	assert.Equal(t, "2025-01-01T12:00:00Z", payload["due_date"])
+}
+
+func TestWebhookSinkRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := reminder.NewWebhookSink(server.URL, time.Second)
+	err := sink.Send(context.TODO(), entity.Reminder{CardID: uuid.New()})
+
+	assert.EqualError(t, err, "webhook responded with status 503")
+}
+
+func TestWebhookSinkTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	sink := reminder.NewWebhookSink(server.URL, 10*time.Millisecond)
+	err := sink.Send(context.TODO(), entity.Reminder{CardID: uuid.New()})
+
+	assert.NotNil(t, err)
+}
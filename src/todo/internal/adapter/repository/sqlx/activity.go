@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXActivityRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXActivityRepository(db *sqlx.DB) *SQLXActivityRepository {
+	return &SQLXActivityRepository{db: db}
+}
+
+func (r *SQLXActivityRepository) CreateActivity(ctx context.Context, activity *entity.Activity) error {
+	query := `
+	INSERT INTO activities (id, board_ids, target_type, target_id, action, actor_id, changes, created_at)
+	VALUES (:id, :board_ids, :target_type, :target_id, :action, :actor_id, :changes, :created_at)
+	`
+
+	repoActivity := repository.RepoActivity(*activity)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoActivity)
+
+	return err
+}
+
+func (r *SQLXActivityRepository) GetActivityByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Activity, error) {
+	query := `
+	SELECT * FROM activities WHERE board_ids @> ARRAY[$1]::UUID[]
+	ORDER BY created_at DESC, id DESC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	return r.selectActivities(ctx, query, boardID, limit, offset)
+}
+
+func (r *SQLXActivityRepository) GetActivityByTarget(ctx context.Context, targetID uuid.UUID, limit, offset int) ([]entity.Activity, error) {
+	query := `
+	SELECT * FROM activities WHERE target_id = $1
+	ORDER BY created_at DESC, id DESC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	return r.selectActivities(ctx, query, targetID, limit, offset)
+}
+
+func (r *SQLXActivityRepository) selectActivities(ctx context.Context, query string, args ...any) ([]entity.Activity, error) {
+	var repoActivities []repository.Activity
+	err := conn(ctx, r.db).SelectContext(ctx, &repoActivities, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entity.Activity, len(repoActivities))
+	for i, a := range repoActivities {
+		activities[i] = repository.ActivityToEntity(a)
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type SQLXAssigneeRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXAssigneeRepository(db *sqlx.DB) *SQLXAssigneeRepository {
+	return &SQLXAssigneeRepository{db: db}
+}
+
+func (r *SQLXAssigneeRepository) AddAssignee(ctx context.Context, cardID, userID uuid.UUID) error {
+	query := `
+	INSERT INTO card_assignees (card_id, user_id) VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, cardID, userID)
+
+	return err
+}
+
+func (r *SQLXAssigneeRepository) RemoveAssignee(ctx context.Context, cardID, userID uuid.UUID) error {
+	query := `
+	DELETE FROM card_assignees WHERE card_id = $1 AND user_id = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, cardID, userID)
+
+	return err
+}
+
+func (r *SQLXAssigneeRepository) GetAssigneesByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	query := `
+	SELECT card_id, user_id FROM card_assignees
+	WHERE card_id = ANY($1)
+	ORDER BY assigned_at ASC, user_id ASC
+	`
+
+	ids := make([]string, len(cardIDs))
+	for i, id := range cardIDs {
+		ids[i] = id.String()
+	}
+
+	var repoAssignees []repository.CardAssignee
+	err := conn(ctx, r.db).SelectContext(ctx, &repoAssignees, query, pq.Array(ids))
+
+	if err != nil {
+		return nil, err
+	}
+
+	assignees := make(map[uuid.UUID][]uuid.UUID)
+	for _, a := range repoAssignees {
+		assignees[a.CardID] = append(assignees[a.CardID], a.UserID)
+	}
+
+	return assignees, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXAttachmentRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXAttachmentRepository(db *sqlx.DB) *SQLXAttachmentRepository {
+	return &SQLXAttachmentRepository{db: db}
+}
+
+func (r *SQLXAttachmentRepository) CreateAttachment(ctx context.Context, attachment *entity.Attachment) error {
+	query := `
+	INSERT INTO attachments (id, card_id, uploader_id, filename, content_type, size, storage_key, created_at)
+	VALUES (:id, :card_id, :uploader_id, :filename, :content_type, :size, :storage_key, :created_at)
+	`
+
+	repoAttachment := repository.RepoAttachment(*attachment)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoAttachment)
+
+	return err
+}
+
+func (r *SQLXAttachmentRepository) GetAttachmentByID(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	query := `
+	SELECT * FROM attachments WHERE id = $1
+	`
+
+	var repoAttachment repository.Attachment
+	err := conn(ctx, r.db).GetContext(ctx, &repoAttachment, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := repository.AttachmentToEntity(repoAttachment)
+
+	return &attachment, nil
+}
+
+func (r *SQLXAttachmentRepository) GetAttachmentsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Attachment, error) {
+	query := `
+	SELECT * FROM attachments WHERE card_id = $1
+	ORDER BY created_at ASC, id ASC
+	`
+
+	var repoAttachments []repository.Attachment
+	err := conn(ctx, r.db).SelectContext(ctx, &repoAttachments, query, cardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]entity.Attachment, len(repoAttachments))
+	for i, a := range repoAttachments {
+		attachments[i] = repository.AttachmentToEntity(a)
+	}
+
+	return attachments, nil
+}
+
+func (r *SQLXAttachmentRepository) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM attachments WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
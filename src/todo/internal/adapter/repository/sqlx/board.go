@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 	"todo/internal/entity"
 	"todo/internal/repository"
 
@@ -21,22 +24,32 @@ func (r *SQLXBoardRepository) CreateBoard(ctx context.Context, board *entity.Boa
 	repoBoard := repository.RepoBoard(*board)
 
 	query := `
-    INSERT INTO boards (id, user_id, title, created_at, updated_at)
-	VALUES (:id, :user_id, :title, :created_at, :updated_at)
+    INSERT INTO boards (id, user_id, title, is_public, is_template, created_at, updated_at)
+	VALUES (:id, :user_id, :title, :is_public, :is_template, :created_at, :updated_at)
     `
 
-	_, err := r.db.NamedExecContext(ctx, query, repoBoard)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoBoard)
+	if err != nil {
+		return err
+	}
+
+	// New rows start at the version the schema defaults to
+	board.Version = 1
 
-	return err
+	return nil
 }
 
 func (r *SQLXBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
 	query := `
-	SELECT * FROM boards WHERE id = $1
+	SELECT * FROM boards WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var repoBoard repository.Board
-	err := r.db.GetContext(ctx, &repoBoard, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoBoard, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
 
 	if err != nil {
 		return nil, err
@@ -47,16 +60,81 @@ func (r *SQLXBoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*
 	return &board, err
 }
 
-func (r *SQLXBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+func (r *SQLXBoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit, offset int) ([]entity.Board, error) {
 	query := `
-	SELECT * FROM boards WHERE user_id = $1
-	ORDER BY created_at ASC
+	SELECT b.* FROM boards b
+	WHERE b.deleted_at IS NULL AND NOT b.is_template
+	AND (b.archived_at IS NOT NULL) = $4
+	AND (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	ORDER BY b.created_at ASC, b.id ASC
 	LIMIT $2
 	OFFSET $3
 	`
 
 	var repoBoards []repository.Board
-	err := r.db.SelectContext(ctx, &repoBoards, query, userID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, userID, limit, offset, archived)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.Board, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.BoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+func (r *SQLXBoardRepository) GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, error) {
+	query := `
+	SELECT b.* FROM boards b
+	WHERE b.deleted_at IS NULL AND NOT b.is_template
+	AND (b.archived_at IS NOT NULL) = $3
+	AND (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	AND ($4::timestamp IS NULL OR (b.created_at, b.id) > ($4::timestamp, $5::uuid))
+	ORDER BY b.created_at ASC, b.id ASC
+	LIMIT $2
+	`
+
+	_, createdAt, id := cursorArgs(page.After)
+
+	var repoBoards []repository.Board
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, userID, page.Limit, archived, createdAt, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.Board, len(repoBoards))
+	for i, b := range repoBoards {
+		boards[i] = repository.BoardToEntity(b)
+	}
+
+	return boards, nil
+}
+
+func (r *SQLXBoardRepository) GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+	query := `
+	SELECT b.* FROM boards b
+	WHERE b.deleted_at IS NULL AND b.is_template AND b.archived_at IS NULL
+	AND (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	ORDER BY b.title ASC, b.created_at ASC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	var repoBoards []repository.Board
+	err := conn(ctx, r.db).SelectContext(ctx, &repoBoards, query, userID, limit, offset)
 
 	if err != nil {
 		return nil, err
@@ -76,21 +154,83 @@ func (r *SQLXBoardRepository) UpdateBoard(ctx context.Context, board *entity.Boa
 	query := `
     UPDATE boards SET
 	title = :title,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version AND deleted_at IS NULL
     `
 
-	_, err := r.db.NamedExecContext(ctx, query, repoBoard)
+	err := updateVersioned(ctx, r.db, query, repoBoard)
+	if err != nil {
+		return err
+	}
+
+	board.Version++
 
-	return err
+	return nil
 }
 
-func (r *SQLXBoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXBoardRepository) UpdateBoardVisibility(ctx context.Context, board *entity.Board) error {
+	repoBoard := repository.RepoBoard(*board)
+
 	query := `
-	DELETE FROM boards WHERE id = $1
-	`
+    UPDATE boards SET
+	is_public = :is_public,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND deleted_at IS NULL
+    `
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoBoard)
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *SQLXBoardRepository) UpdateBoardArchived(ctx context.Context, board *entity.Board) error {
+	repoBoard := repository.RepoBoard(*board)
+
+	query := `
+    UPDATE boards SET
+	archived_at = :archived_at,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND deleted_at IS NULL
+    `
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoBoard)
+	if err != nil {
+		return err
+	}
+
+	board.Version++
+
+	return nil
+}
+
+func (r *SQLXBoardRepository) DeleteBoard(ctx context.Context, id, userID uuid.UUID) error {
+	queries := []string{`
+	UPDATE boards SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE id = $1 AND deleted_at IS NULL
+	`, `
+	UPDATE columns SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE board_id = $1 AND deleted_at IS NULL
+	`, `
+	UPDATE cards SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE column_id IN (SELECT id FROM columns WHERE deleted_with = $1)
+	AND deleted_at IS NULL
+	`}
+
+	return execAll(ctx, r.db, queries, id, time.Now(), userID)
+}
+
+func (r *SQLXBoardRepository) LockBoard(ctx context.Context, id uuid.UUID) error {
+	query := `
+	SELECT id FROM boards WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`
 
-	return err
+	return lockRow(ctx, r.db, query, id)
 }
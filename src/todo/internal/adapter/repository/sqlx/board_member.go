@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXBoardMemberRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXBoardMemberRepository(db *sqlx.DB) *SQLXBoardMemberRepository {
+	return &SQLXBoardMemberRepository{db: db}
+}
+
+func (r *SQLXBoardMemberRepository) AddMember(ctx context.Context, member *entity.BoardMember) error {
+	repoMember := repository.RepoBoardMember(*member)
+
+	query := `
+	INSERT INTO board_members (board_id, user_id, role, accepted, invited_by, created_at, updated_at)
+	VALUES (:board_id, :user_id, :role, :accepted, :invited_by, :created_at, :updated_at)
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoMember)
+
+	return err
+}
+
+func (r *SQLXBoardMemberRepository) GetMember(ctx context.Context, boardID, userID uuid.UUID) (*entity.BoardMember, error) {
+	query := `
+	SELECT * FROM board_members WHERE board_id = $1 AND user_id = $2
+	`
+
+	var repoMember repository.BoardMember
+	err := conn(ctx, r.db).GetContext(ctx, &repoMember, query, boardID, userID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	member := repository.BoardMemberToEntity(repoMember)
+
+	return &member, nil
+}
+
+func (r *SQLXBoardMemberRepository) GetMembersByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error) {
+	query := `
+	SELECT * FROM board_members WHERE board_id = $1
+	ORDER BY created_at ASC
+	`
+
+	return r.selectMembers(ctx, query, boardID)
+}
+
+func (r *SQLXBoardMemberRepository) GetInvitationsByUser(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error) {
+	query := `
+	SELECT m.* FROM board_members m
+	JOIN boards b ON b.id = m.board_id
+	WHERE m.user_id = $1 AND NOT m.accepted AND b.deleted_at IS NULL
+	ORDER BY m.created_at ASC
+	`
+
+	return r.selectMembers(ctx, query, userID)
+}
+
+func (r *SQLXBoardMemberRepository) selectMembers(ctx context.Context, query string, args ...any) ([]entity.BoardMember, error) {
+	var repoMembers []repository.BoardMember
+	err := conn(ctx, r.db).SelectContext(ctx, &repoMembers, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entity.BoardMember, len(repoMembers))
+	for i, m := range repoMembers {
+		members[i] = repository.BoardMemberToEntity(m)
+	}
+
+	return members, nil
+}
+
+func (r *SQLXBoardMemberRepository) UpdateMember(ctx context.Context, member *entity.BoardMember) error {
+	repoMember := repository.RepoBoardMember(*member)
+
+	query := `
+	UPDATE board_members
+	SET role = :role, accepted = :accepted, updated_at = :updated_at
+	WHERE board_id = :board_id AND user_id = :user_id
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoMember)
+
+	return err
+}
+
+func (r *SQLXBoardMemberRepository) DeleteMember(ctx context.Context, boardID, userID uuid.UUID) error {
+	query := `
+	DELETE FROM board_members WHERE board_id = $1 AND user_id = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, boardID, userID)
+
+	return err
+}
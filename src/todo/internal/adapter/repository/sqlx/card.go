@@ -2,12 +2,15 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 	"todo/internal/entity"
 	"todo/internal/repository"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type SQLXCardRepository struct {
@@ -20,24 +23,34 @@ func NewSQLXCardRepository(db *sqlx.DB) *SQLXCardRepository {
 
 func (r *SQLXCardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
 	query := `
-	INSERT INTO cards (id, column_id, user_id, title, description, position, created_at, updated_at)
-	VALUES (:id, :column_id, :user_id, :title, :description, :position, :created_at, :updated_at)
+	INSERT INTO cards (id, column_id, user_id, title, description, position, start_date, due_date, created_at, updated_at)
+	VALUES (:id, :column_id, :user_id, :title, :description, :position, :start_date, :due_date, :created_at, :updated_at)
 	`
 
 	repoCard := repository.RepoCard(*card)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoCard)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// New rows start at the version the schema defaults to
+	card.Version = 1
+
+	return nil
 }
 
 func (r *SQLXCardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
 	query := `
-	SELECT * FROM cards WHERE id = $1
+	SELECT * FROM cards WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var repoCard repository.Card
-	err := r.db.GetContext(ctx, &repoCard, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoCard, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
 
 	if err != nil {
 		return nil, err
@@ -48,16 +61,52 @@ func (r *SQLXCardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*en
 	return &card, nil
 }
 
-func (r *SQLXCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+func (r *SQLXCardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit, offset int) ([]entity.Card, error) {
 	query := `
-	SELECT * FROM cards WHERE column_id = $1
-	ORDER BY created_at ASC
-	LIMIT $2
-	OFFSET $3
+	SELECT * FROM cards WHERE column_id = $1 AND deleted_at IS NULL
+	AND ($2::uuid IS NULL OR EXISTS (
+		SELECT 1 FROM card_labels
+		WHERE card_labels.card_id = cards.id AND card_labels.label_id = $2
+	))
+	ORDER BY position ASC, created_at ASC, id ASC
+	LIMIT $3
+	OFFSET $4
 	`
 
+	labelID := uuid.NullUUID{UUID: filter.LabelID, Valid: filter.LabelID != uuid.Nil}
+
 	var repoCards []repository.Card
-	err := r.db.SelectContext(ctx, &repoCards, query, columnID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, columnID, labelID, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.Card, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.CardToEntity(c)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXCardRepository) GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, error) {
+	query := `
+	SELECT * FROM cards WHERE column_id = $1 AND deleted_at IS NULL
+	AND ($2::uuid IS NULL OR EXISTS (
+		SELECT 1 FROM card_labels
+		WHERE card_labels.card_id = cards.id AND card_labels.label_id = $2
+	))
+	AND ($4::float8 IS NULL OR (position, created_at, id) > ($4::float8, $5::timestamp, $6::uuid))
+	ORDER BY position ASC, created_at ASC, id ASC
+	LIMIT $3
+	`
+
+	labelID := uuid.NullUUID{UUID: filter.LabelID, Valid: filter.LabelID != uuid.Nil}
+	position, createdAt, id := cursorArgs(page.After)
+
+	var repoCards []repository.Card
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, columnID, labelID, page.Limit, position, createdAt, id)
 
 	if err != nil {
 		return nil, err
@@ -77,50 +126,229 @@ func (r *SQLXCardRepository) UpdateCard(ctx context.Context, card *entity.Card)
 	title = :title,
 	description = :description,
 	position = :position,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version AND deleted_at IS NULL
     `
 
 	repoCard := repository.RepoCard(*card)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	err := updateVersioned(ctx, r.db, query, repoCard)
+	if err != nil {
+		return err
+	}
 
-	return err
+	card.Version++
+
+	return nil
 }
 
 func (r *SQLXCardRepository) MoveCard(ctx context.Context, card *entity.Card) error {
 	query := `
     UPDATE cards SET
 	column_id = :column_id,
+	position = :position,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND deleted_at IS NULL
     `
 
 	repoCard := repository.RepoCard(*card)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoCard)
+	res, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoCard)
+	if err != nil {
+		return err
+	}
 
-	return err
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	card.Version++
+
+	return nil
 }
 
-func (r *SQLXCardRepository) DeleteCard(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXCardRepository) DeleteCard(ctx context.Context, id, userID uuid.UUID) error {
 	query := `
-	DELETE FROM cards WHERE id = $1
+	UPDATE cards SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, time.Now(), userID)
 
 	return err
 }
 
+func (r *SQLXCardRepository) LockCard(ctx context.Context, id uuid.UUID) error {
+	query := `
+	SELECT id FROM cards WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`
+
+	return lockRow(ctx, r.db, query, id)
+}
+
 func (r *SQLXCardRepository) GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
 	query := `
 	SELECT * FROM cards
 	WHERE $1 <= created_at AND created_at <= $2
+	AND deleted_at IS NULL
+	`
+
+	var repoCards []repository.Card
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.Card, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.CardToEntity(c)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXCardRepository) GetCardPositions(ctx context.Context, columnID uuid.UUID) ([]entity.Position, error) {
+	query := `
+	SELECT id, position FROM cards WHERE column_id = $1 AND deleted_at IS NULL
+	ORDER BY position ASC, created_at ASC
+	`
+
+	return selectPositions(ctx, r.db, query, columnID)
+}
+
+func (r *SQLXCardRepository) UpdateCardPosition(ctx context.Context, id uuid.UUID, position float64) error {
+	query := `
+	UPDATE cards SET position = $2, version = version + 1, updated_at = $3 WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, position, time.Now())
+
+	return err
+}
+
+func (r *SQLXCardRepository) CountCardsByColumns(ctx context.Context, columnIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	query := `
+	SELECT column_id, COUNT(*) AS count FROM cards
+	WHERE column_id = ANY($1) AND deleted_at IS NULL
+	GROUP BY column_id
+	`
+
+	ids := make([]string, len(columnIDs))
+	for i, id := range columnIDs {
+		ids[i] = id.String()
+	}
+
+	var repoCounts []repository.CardCount
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCounts, query, pq.Array(ids))
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(repoCounts))
+	for _, c := range repoCounts {
+		counts[c.ColumnID] = c.Count
+	}
+
+	return counts, nil
+}
+
+func (r *SQLXCardRepository) SetCardDates(ctx context.Context, card *entity.Card) error {
+	query := `
+    UPDATE cards SET
+	reminded_at = CASE WHEN due_date IS DISTINCT FROM :due_date THEN NULL ELSE reminded_at END,
+	start_date = :start_date,
+	due_date = :due_date,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND deleted_at IS NULL
+    `
+
+	repoCard := repository.RepoCard(*card)
+
+	res, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoCard)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	card.Version++
+
+	return nil
+}
+
+func (r *SQLXCardRepository) GetDueCardsByUser(ctx context.Context, userID uuid.UUID, from, to time.Time, limit, offset int) ([]entity.Card, error) {
+	query := `
+	SELECT c.* FROM cards c
+	JOIN columns col ON col.id = c.column_id
+	JOIN boards b ON b.id = col.board_id
+	WHERE (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	AND c.deleted_at IS NULL
+	AND col.archived_at IS NULL AND b.archived_at IS NULL AND NOT b.is_template
+	AND c.due_date IS NOT NULL
+	AND ($2::timestamp IS NULL OR c.due_date >= $2)
+	AND c.due_date < $3
+	ORDER BY c.due_date ASC, c.created_at ASC
+	LIMIT $4
+	OFFSET $5
+	`
+
+	lower := sql.NullTime{Time: from, Valid: !from.IsZero()}
+
+	var repoCards []repository.Card
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, userID, lower, to, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.Card, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.CardToEntity(c)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXCardRepository) GetCardsByAssignee(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+	query := `
+	SELECT c.* FROM cards c
+	JOIN card_assignees a ON a.card_id = c.id
+	JOIN columns col ON col.id = c.column_id
+	JOIN boards b ON b.id = col.board_id
+	WHERE a.user_id = $1
+	AND c.deleted_at IS NULL
+	AND col.archived_at IS NULL AND b.archived_at IS NULL AND NOT b.is_template
+	AND (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	ORDER BY c.due_date ASC NULLS LAST, c.created_at ASC
+	LIMIT $2
+	OFFSET $3
 	`
 
 	var repoCards []repository.Card
-	err := r.db.SelectContext(ctx, &repoCards, query, from, to)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, userID, limit, offset)
 
 	if err != nil {
 		return nil, err
@@ -133,3 +361,92 @@ func (r *SQLXCardRepository) GetNewCards(ctx context.Context, from, to time.Time
 
 	return cards, nil
 }
+
+func (r *SQLXCardRepository) SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit, offset int) ([]entity.CardMatch, error) {
+	// The document has to be the expression of idx_cards_search. Postgres
+	// makes the headlines after the limit, so only for the returned page.
+	query := `
+	SELECT c.*, col.board_id,
+		ts_rank(
+			setweight(to_tsvector('simple', c.title), 'A') ||
+			setweight(to_tsvector('simple', coalesce(c.description, '')), 'B'),
+			q
+		) AS rank,
+		ts_headline('simple', c.title, q) AS title_headline,
+		ts_headline('simple', coalesce(c.description, ''), q, 'MaxWords=20, MinWords=5, MaxFragments=2') AS description_headline
+	FROM cards c
+	JOIN columns col ON col.id = c.column_id
+	JOIN boards b ON b.id = col.board_id
+	CROSS JOIN websearch_to_tsquery('simple', $2) q
+	WHERE (
+		setweight(to_tsvector('simple', c.title), 'A') ||
+		setweight(to_tsvector('simple', coalesce(c.description, '')), 'B')
+	) @@ q
+	AND (b.user_id = $1 OR EXISTS (
+		SELECT 1 FROM board_members m
+		WHERE m.board_id = b.id AND m.user_id = $1 AND m.accepted
+	))
+	AND c.deleted_at IS NULL
+	AND col.archived_at IS NULL AND b.archived_at IS NULL AND NOT b.is_template
+	AND ($3::uuid IS NULL OR b.id = $3)
+	AND ($4::timestamp IS NULL OR c.created_at >= $4)
+	AND ($5::timestamp IS NULL OR c.created_at < $5)
+	ORDER BY rank DESC, c.created_at DESC
+	LIMIT $6
+	OFFSET $7
+	`
+
+	boardID := uuid.NullUUID{UUID: search.BoardID, Valid: search.BoardID != uuid.Nil}
+	from := sql.NullTime{Time: search.From, Valid: !search.From.IsZero()}
+	to := sql.NullTime{Time: search.To, Valid: !search.To.IsZero()}
+
+	var repoMatches []repository.CardMatch
+	err := conn(ctx, r.db).SelectContext(ctx, &repoMatches, query, userID, search.Query, boardID, from, to, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]entity.CardMatch, len(repoMatches))
+	for i, m := range repoMatches {
+		matches[i] = repository.CardMatchToEntity(m)
+	}
+
+	return matches, nil
+}
+
+func (r *SQLXCardRepository) GetCardsToRemind(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
+	query := `
+	SELECT c.* FROM cards c
+	JOIN columns col ON col.id = c.column_id
+	JOIN boards b ON b.id = col.board_id
+	WHERE c.reminded_at IS NULL AND c.deleted_at IS NULL
+	AND col.archived_at IS NULL AND b.archived_at IS NULL AND NOT b.is_template
+	AND $1 < c.due_date AND c.due_date <= $2
+	ORDER BY c.due_date ASC
+	`
+
+	var repoCards []repository.Card
+	err := conn(ctx, r.db).SelectContext(ctx, &repoCards, query, from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]entity.Card, len(repoCards))
+	for i, c := range repoCards {
+		cards[i] = repository.CardToEntity(c)
+	}
+
+	return cards, nil
+}
+
+func (r *SQLXCardRepository) MarkCardReminded(ctx context.Context, id uuid.UUID, dueDate, at time.Time) error {
+	query := `
+	UPDATE cards SET reminded_at = $3 WHERE id = $1 AND due_date = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, dueDate, at)
+
+	return err
+}
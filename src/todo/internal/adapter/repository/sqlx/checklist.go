@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type SQLXChecklistRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXChecklistRepository(db *sqlx.DB) *SQLXChecklistRepository {
+	return &SQLXChecklistRepository{db: db}
+}
+
+func (r *SQLXChecklistRepository) CreateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	query := `
+	INSERT INTO checklists (id, card_id, title, position, created_at, updated_at)
+	VALUES (:id, :card_id, :title, :position, :created_at, :updated_at)
+	`
+
+	repoChecklist := repository.RepoChecklist(*checklist)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoChecklist)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) GetChecklistByID(ctx context.Context, id uuid.UUID) (*entity.Checklist, error) {
+	query := `
+	SELECT * FROM checklists WHERE id = $1
+	`
+
+	var repoChecklist repository.Checklist
+	err := conn(ctx, r.db).GetContext(ctx, &repoChecklist, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	checklist := repository.ChecklistToEntity(repoChecklist)
+
+	return &checklist, nil
+}
+
+func (r *SQLXChecklistRepository) GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error) {
+	query := `
+	SELECT * FROM checklists WHERE card_id = $1
+	ORDER BY position ASC, created_at ASC
+	`
+
+	var repoChecklists []repository.Checklist
+	err := conn(ctx, r.db).SelectContext(ctx, &repoChecklists, query, cardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	checklists := make([]entity.Checklist, len(repoChecklists))
+	for i, c := range repoChecklists {
+		checklists[i] = repository.ChecklistToEntity(c)
+	}
+
+	return checklists, nil
+}
+
+func (r *SQLXChecklistRepository) UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	query := `
+	UPDATE checklists SET title = :title, updated_at = :updated_at
+	WHERE id = :id
+	`
+
+	repoChecklist := repository.RepoChecklist(*checklist)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoChecklist)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) DeleteChecklist(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM checklists WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) LockChecklist(ctx context.Context, id uuid.UUID) error {
+	query := `
+	SELECT id FROM checklists WHERE id = $1 FOR UPDATE
+	`
+
+	return lockRow(ctx, r.db, query, id)
+}
+
+func (r *SQLXChecklistRepository) GetChecklistPositions(ctx context.Context, cardID uuid.UUID) ([]entity.Position, error) {
+	query := `
+	SELECT id, position FROM checklists WHERE card_id = $1
+	ORDER BY position ASC, created_at ASC
+	`
+
+	return selectPositions(ctx, r.db, query, cardID)
+}
+
+func (r *SQLXChecklistRepository) CreateItem(ctx context.Context, item *entity.ChecklistItem) error {
+	query := `
+	INSERT INTO checklist_items (id, checklist_id, text, done, position, created_at, updated_at)
+	VALUES (:id, :checklist_id, :text, :done, :position, :created_at, :updated_at)
+	`
+
+	repoItem := repository.RepoChecklistItem(*item)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoItem)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) GetItemByID(ctx context.Context, id uuid.UUID) (*entity.ChecklistItem, error) {
+	query := `
+	SELECT * FROM checklist_items WHERE id = $1
+	`
+
+	var repoItem repository.ChecklistItem
+	err := conn(ctx, r.db).GetContext(ctx, &repoItem, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := repository.ChecklistItemToEntity(repoItem)
+
+	return &item, nil
+}
+
+func (r *SQLXChecklistRepository) GetItemsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.ChecklistItem, error) {
+	query := `
+	SELECT checklist_items.* FROM checklist_items
+	JOIN checklists ON checklists.id = checklist_items.checklist_id
+	WHERE checklists.card_id = $1
+	ORDER BY checklist_items.position ASC, checklist_items.created_at ASC
+	`
+
+	var repoItems []repository.ChecklistItem
+	err := conn(ctx, r.db).SelectContext(ctx, &repoItems, query, cardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]entity.ChecklistItem, len(repoItems))
+	for i, item := range repoItems {
+		items[i] = repository.ChecklistItemToEntity(item)
+	}
+
+	return items, nil
+}
+
+func (r *SQLXChecklistRepository) UpdateItem(ctx context.Context, item *entity.ChecklistItem) error {
+	query := `
+	UPDATE checklist_items SET text = :text, done = :done, updated_at = :updated_at
+	WHERE id = :id
+	`
+
+	repoItem := repository.RepoChecklistItem(*item)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoItem)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM checklist_items WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *SQLXChecklistRepository) GetItemPositions(ctx context.Context, checklistID uuid.UUID) ([]entity.Position, error) {
+	query := `
+	SELECT id, position FROM checklist_items WHERE checklist_id = $1
+	ORDER BY position ASC, created_at ASC
+	`
+
+	return selectPositions(ctx, r.db, query, checklistID)
+}
+
+func (r *SQLXChecklistRepository) GetProgressByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID]entity.ChecklistProgress, error) {
+	query := `
+	SELECT checklists.card_id,
+		COUNT(*) FILTER (WHERE checklist_items.done) AS done,
+		COUNT(*) AS total
+	FROM checklist_items
+	JOIN checklists ON checklists.id = checklist_items.checklist_id
+	WHERE checklists.card_id = ANY($1)
+	GROUP BY checklists.card_id
+	`
+
+	ids := make([]string, len(cardIDs))
+	for i, id := range cardIDs {
+		ids[i] = id.String()
+	}
+
+	var repoProgress []repository.ChecklistProgress
+	err := conn(ctx, r.db).SelectContext(ctx, &repoProgress, query, pq.Array(ids))
+
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make(map[uuid.UUID]entity.ChecklistProgress, len(repoProgress))
+	for _, p := range repoProgress {
+		progress[p.CardID] = entity.ChecklistProgress{Done: p.Done, Total: p.Total}
+	}
+
+	return progress, nil
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 	"todo/internal/entity"
 	"todo/internal/repository"
 
@@ -21,22 +24,32 @@ func (r *SQLXColumnRepository) CreateColumn(ctx context.Context, column *entity.
 	repoColumn := repository.RepoColumn(*column)
 
 	query := `
-	INSERT INTO columns (id, board_id, user_id, title, position, created_at, updated_at)
-	VALUES (:id, :board_id, :user_id, :title, :position, :created_at, :updated_at)
+	INSERT INTO columns (id, board_id, user_id, title, position, wip_limit, wip_strict, created_at, updated_at)
+	VALUES (:id, :board_id, :user_id, :title, :position, :wip_limit, :wip_strict, :created_at, :updated_at)
 	`
 
-	_, err := r.db.NamedExecContext(ctx, query, repoColumn)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoColumn)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// New rows start at the version the schema defaults to
+	column.Version = 1
+
+	return nil
 }
 
 func (r *SQLXColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
 	query := `
-	SELECT * FROM columns WHERE id = $1
+	SELECT * FROM columns WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var repoColumn repository.Column
-	err := r.db.GetContext(ctx, &repoColumn, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &repoColumn, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
 
 	if err != nil {
 		return nil, err
@@ -47,16 +60,43 @@ func (r *SQLXColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID)
 	return &column, nil
 }
 
-func (r *SQLXColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error) {
+func (r *SQLXColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit, offset int) ([]entity.Column, error) {
 	query := `
-	SELECT * FROM columns WHERE board_id = $1
-	ORDER BY created_at ASC
+	SELECT * FROM columns WHERE board_id = $1 AND deleted_at IS NULL
+	AND (archived_at IS NOT NULL) = $4
+	ORDER BY position ASC, created_at ASC, id ASC
 	LIMIT $2
 	OFFSET $3
 	`
 
 	var repoColumns []repository.Column
-	err := r.db.SelectContext(ctx, &repoColumns, query, boardID, limit, offset)
+	err := conn(ctx, r.db).SelectContext(ctx, &repoColumns, query, boardID, limit, offset, archived)
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.Column, len(repoColumns))
+	for i, c := range repoColumns {
+		columns[i] = repository.ColumnToEntity(c)
+	}
+
+	return columns, nil
+}
+
+func (r *SQLXColumnRepository) GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, error) {
+	query := `
+	SELECT * FROM columns WHERE board_id = $1 AND deleted_at IS NULL
+	AND (archived_at IS NOT NULL) = $3
+	AND ($4::float8 IS NULL OR (position, created_at, id) > ($4::float8, $5::timestamp, $6::uuid))
+	ORDER BY position ASC, created_at ASC, id ASC
+	LIMIT $2
+	`
+
+	position, createdAt, id := cursorArgs(page.After)
+
+	var repoColumns []repository.Column
+	err := conn(ctx, r.db).SelectContext(ctx, &repoColumns, query, boardID, page.Limit, archived, position, createdAt, id)
 
 	if err != nil {
 		return nil, err
@@ -75,23 +115,101 @@ func (r *SQLXColumnRepository) UpdateColumn(ctx context.Context, column *entity.
     UPDATE columns SET
 	title = :title,
 	position = :position,
+	version = version + 1,
 	updated_at = :updated_at
-    WHERE id = :id
+    WHERE id = :id AND version = :version AND deleted_at IS NULL
     `
 
 	repoColumn := repository.RepoColumn(*column)
 
-	_, err := r.db.NamedExecContext(ctx, query, repoColumn)
+	err := updateVersioned(ctx, r.db, query, repoColumn)
+	if err != nil {
+		return err
+	}
 
-	return err
+	column.Version++
+
+	return nil
+}
+
+func (r *SQLXColumnRepository) UpdateColumnArchived(ctx context.Context, column *entity.Column) error {
+	query := `
+    UPDATE columns SET
+	archived_at = :archived_at,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND deleted_at IS NULL
+    `
+
+	repoColumn := repository.RepoColumn(*column)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoColumn)
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *SQLXColumnRepository) SetColumnWIPLimit(ctx context.Context, column *entity.Column) error {
+	query := `
+    UPDATE columns SET
+	wip_limit = :wip_limit,
+	wip_strict = :wip_strict,
+	version = version + 1,
+	updated_at = :updated_at
+    WHERE id = :id AND deleted_at IS NULL
+    `
+
+	repoColumn := repository.RepoColumn(*column)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoColumn)
+	if err != nil {
+		return err
+	}
+
+	column.Version++
+
+	return nil
+}
+
+func (r *SQLXColumnRepository) DeleteColumn(ctx context.Context, id, userID uuid.UUID) error {
+	queries := []string{`
+	UPDATE columns SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE id = $1 AND deleted_at IS NULL
+	`, `
+	UPDATE cards SET deleted_at = $2, deleted_by = $3, deleted_with = $1
+	WHERE column_id = $1 AND deleted_at IS NULL
+	`}
+
+	return execAll(ctx, r.db, queries, id, time.Now(), userID)
+}
+
+func (r *SQLXColumnRepository) LockColumn(ctx context.Context, id uuid.UUID) error {
+	query := `
+	SELECT id FROM columns WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`
+
+	return lockRow(ctx, r.db, query, id)
+}
+
+func (r *SQLXColumnRepository) GetColumnPositions(ctx context.Context, boardID uuid.UUID) ([]entity.Position, error) {
+	query := `
+	SELECT id, position FROM columns WHERE board_id = $1 AND deleted_at IS NULL
+	ORDER BY position ASC, created_at ASC
+	`
+
+	return selectPositions(ctx, r.db, query, boardID)
 }
 
-func (r *SQLXColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID) error {
+func (r *SQLXColumnRepository) UpdateColumnPosition(ctx context.Context, id uuid.UUID, position float64) error {
 	query := `
-	DELETE FROM columns WHERE id = $1
+	UPDATE columns SET position = $2, version = version + 1, updated_at = $3 WHERE id = $1
 	`
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, position, time.Now())
 
 	return err
 }
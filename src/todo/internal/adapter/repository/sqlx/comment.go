@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXCommentRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXCommentRepository(db *sqlx.DB) *SQLXCommentRepository {
+	return &SQLXCommentRepository{db: db}
+}
+
+func (r *SQLXCommentRepository) CreateComment(ctx context.Context, comment *entity.Comment) error {
+	query := `
+	INSERT INTO comments (id, card_id, author_id, body, created_at, edited_at)
+	VALUES (:id, :card_id, :author_id, :body, :created_at, :edited_at)
+	`
+
+	repoComment := repository.RepoComment(*comment)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoComment)
+
+	return err
+}
+
+func (r *SQLXCommentRepository) GetCommentByID(ctx context.Context, id uuid.UUID) (*entity.Comment, error) {
+	query := `
+	SELECT * FROM comments WHERE id = $1
+	`
+
+	var repoComment repository.Comment
+	err := conn(ctx, r.db).GetContext(ctx, &repoComment, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	comment := repository.CommentToEntity(repoComment)
+
+	return &comment, nil
+}
+
+func (r *SQLXCommentRepository) GetCommentsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Comment, error) {
+	query := `
+	SELECT * FROM comments WHERE card_id = $1
+	ORDER BY created_at ASC, id ASC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	var repoComments []repository.Comment
+	err := conn(ctx, r.db).SelectContext(ctx, &repoComments, query, cardID, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]entity.Comment, len(repoComments))
+	for i, c := range repoComments {
+		comments[i] = repository.CommentToEntity(c)
+	}
+
+	return comments, nil
+}
+
+func (r *SQLXCommentRepository) UpdateComment(ctx context.Context, comment *entity.Comment) error {
+	query := `
+	UPDATE comments SET body = :body, edited_at = :edited_at
+	WHERE id = :id
+	`
+
+	repoComment := repository.RepoComment(*comment)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoComment)
+
+	return err
+}
+
+func (r *SQLXCommentRepository) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM comments WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type SQLXLabelRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXLabelRepository(db *sqlx.DB) *SQLXLabelRepository {
+	return &SQLXLabelRepository{db: db}
+}
+
+func (r *SQLXLabelRepository) CreateLabel(ctx context.Context, label *entity.Label) error {
+	query := `
+	INSERT INTO labels (id, board_id, name, color, created_at, updated_at)
+	VALUES (:id, :board_id, :name, :color, :created_at, :updated_at)
+	`
+
+	repoLabel := repository.RepoLabel(*label)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoLabel)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) GetLabelByID(ctx context.Context, id uuid.UUID) (*entity.Label, error) {
+	query := `
+	SELECT * FROM labels WHERE id = $1
+	`
+
+	var repoLabel repository.Label
+	err := conn(ctx, r.db).GetContext(ctx, &repoLabel, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	label := repository.LabelToEntity(repoLabel)
+
+	return &label, nil
+}
+
+func (r *SQLXLabelRepository) GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error) {
+	query := `
+	SELECT * FROM labels WHERE board_id = $1
+	ORDER BY name ASC
+	`
+
+	var repoLabels []repository.Label
+	err := conn(ctx, r.db).SelectContext(ctx, &repoLabels, query, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]entity.Label, len(repoLabels))
+	for i, l := range repoLabels {
+		labels[i] = repository.LabelToEntity(l)
+	}
+
+	return labels, nil
+}
+
+func (r *SQLXLabelRepository) UpdateLabel(ctx context.Context, label *entity.Label) error {
+	query := `
+	UPDATE labels SET name = :name, color = :color, updated_at = :updated_at
+	WHERE id = :id
+	`
+
+	repoLabel := repository.RepoLabel(*label)
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoLabel)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) DeleteLabel(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM labels WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) AttachLabel(ctx context.Context, cardID, labelID uuid.UUID) error {
+	query := `
+	INSERT INTO card_labels (card_id, label_id) VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, cardID, labelID)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) DetachLabel(ctx context.Context, cardID, labelID uuid.UUID) error {
+	query := `
+	DELETE FROM card_labels WHERE card_id = $1 AND label_id = $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, cardID, labelID)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) DetachForeignLabels(ctx context.Context, cardID, boardID uuid.UUID) error {
+	query := `
+	DELETE FROM card_labels USING labels
+	WHERE card_labels.label_id = labels.id
+	AND card_labels.card_id = $1 AND labels.board_id <> $2
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, cardID, boardID)
+
+	return err
+}
+
+func (r *SQLXLabelRepository) GetLabelsByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]entity.Label, error) {
+	query := `
+	SELECT card_labels.card_id, labels.* FROM card_labels
+	JOIN labels ON labels.id = card_labels.label_id
+	WHERE card_labels.card_id = ANY($1)
+	ORDER BY labels.name ASC
+	`
+
+	ids := make([]string, len(cardIDs))
+	for i, id := range cardIDs {
+		ids[i] = id.String()
+	}
+
+	var repoLabels []repository.CardLabel
+	err := conn(ctx, r.db).SelectContext(ctx, &repoLabels, query, pq.Array(ids))
+
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[uuid.UUID][]entity.Label)
+	for _, l := range repoLabels {
+		labels[l.CardID] = append(labels[l.CardID], repository.LabelToEntity(l.Label))
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXOutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXOutboxRepository(db *sqlx.DB) *SQLXOutboxRepository {
+	return &SQLXOutboxRepository{db: db}
+}
+
+func (r *SQLXOutboxRepository) CreateOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error {
+	// seq is left to the sequence, which numbers the events of a transaction
+	// in the order they are inserted
+	query := `
+	INSERT INTO outbox_events (id, type, aggregate_type, aggregate_id, board_id, payload, attempts, next_attempt_at, last_error, created_at)
+	VALUES (:id, :type, :aggregate_type, :aggregate_id, :board_id, :payload, :attempts, :next_attempt_at, :last_error, :created_at)
+	`
+
+	for _, event := range events {
+		if _, err := conn(ctx, r.db).NamedExecContext(ctx, query, repository.RepoOutboxEvent(event)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLXOutboxRepository) GetDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error) {
+	query := `
+	SELECT * FROM outbox_events e
+	WHERE NOT EXISTS (
+		SELECT 1 FROM outbox_events w
+		WHERE w.aggregate_id = e.aggregate_id AND w.seq <= e.seq AND w.next_attempt_at > $1
+	)
+	ORDER BY seq
+	LIMIT $2
+	`
+
+	var repoEvents []repository.OutboxEvent
+	err := conn(ctx, r.db).SelectContext(ctx, &repoEvents, query, now, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]entity.OutboxEvent, len(repoEvents))
+	for i, e := range repoEvents {
+		events[i] = repository.OutboxEventToEntity(e)
+	}
+
+	return events, nil
+}
+
+func (r *SQLXOutboxRepository) DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error {
+	query := `
+	DELETE FROM outbox_events WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *SQLXOutboxRepository) RescheduleOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	query := `
+	UPDATE outbox_events SET attempts = $2, next_attempt_at = $3, last_error = $4
+	WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, event.ID, event.Attempts, event.NextAttemptAt, event.LastError)
+
+	return err
+}
+
+func (r *SQLXOutboxRepository) LockOutbox(ctx context.Context) (bool, error) {
+	// The advisory lock goes away with the transaction, so a relay that dies
+	// midway leaves the outbox to the next one
+	query := `
+	SELECT pg_try_advisory_xact_lock(hashtext('outbox_events'))
+	`
+
+	var locked bool
+	err := conn(ctx, r.db).GetContext(ctx, &locked, query)
+
+	return locked, err
+}
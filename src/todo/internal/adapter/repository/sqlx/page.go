@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"database/sql"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// cursorArgs are the query arguments of the cursor a page starts after; they
+// are all NULL for the first page
+func cursorArgs(after *entity.Cursor) (sql.NullFloat64, sql.NullTime, uuid.NullUUID) {
+	if after == nil {
+		return sql.NullFloat64{}, sql.NullTime{}, uuid.NullUUID{}
+	}
+
+	return sql.NullFloat64{Float64: after.Position, Valid: true},
+		sql.NullTime{Time: after.CreatedAt, Valid: true},
+		uuid.NullUUID{UUID: after.ID, Valid: true}
+}
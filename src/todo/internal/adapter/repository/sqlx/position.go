@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+func selectPositions(ctx context.Context, db *sqlx.DB, query string, parentID uuid.UUID) ([]entity.Position, error) {
+	var repoPositions []repository.Position
+	err := conn(ctx, db).SelectContext(ctx, &repoPositions, query, parentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]entity.Position, len(repoPositions))
+	for i, p := range repoPositions {
+		positions[i] = repository.PositionToEntity(p)
+	}
+
+	return positions, nil
+}
+
+// lockRow runs a SELECT ... FOR UPDATE query for a single row. The lock is
+// only useful inside a transaction.
+func lockRow(ctx context.Context, db *sqlx.DB, query string, id uuid.UUID) error {
+	var lockedID uuid.UUID
+	err := conn(ctx, db).GetContext(ctx, &lockedID, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXRevisionRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXRevisionRepository(db *sqlx.DB) *SQLXRevisionRepository {
+	return &SQLXRevisionRepository{db: db}
+}
+
+func (r *SQLXRevisionRepository) CreateRevision(ctx context.Context, revision *entity.CardRevision) error {
+	// Concurrent revisions of a card are kept apart by the lock the update
+	// of the card holds; the unique key turns any other race into an error
+	query := `
+	INSERT INTO card_revisions (id, card_id, number, title, description, author_id, reverted_from, created_at)
+	VALUES ($1, $2, (SELECT COALESCE(MAX(number), 0) + 1 FROM card_revisions WHERE card_id = $2), $3, $4, $5, $6, $7)
+	RETURNING number
+	`
+
+	return conn(ctx, r.db).GetContext(ctx, &revision.Number, query,
+		revision.ID, revision.CardID, revision.Title, revision.Description,
+		revision.AuthorID, revision.RevertedFrom, revision.CreatedAt)
+}
+
+func (r *SQLXRevisionRepository) GetRevision(ctx context.Context, cardID uuid.UUID, number int) (*entity.CardRevision, error) {
+	query := `
+	SELECT * FROM card_revisions WHERE card_id = $1 AND number = $2
+	`
+
+	var repoRevision repository.CardRevision
+	err := conn(ctx, r.db).GetContext(ctx, &repoRevision, query, cardID, number)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	revision := repository.CardRevisionToEntity(repoRevision)
+
+	return &revision, nil
+}
+
+func (r *SQLXRevisionRepository) GetRevisionsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.CardRevision, error) {
+	query := `
+	SELECT * FROM card_revisions WHERE card_id = $1
+	ORDER BY number DESC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	var repoRevisions []repository.CardRevision
+	err := conn(ctx, r.db).SelectContext(ctx, &repoRevisions, query, cardID, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]entity.CardRevision, len(repoRevisions))
+	for i, rev := range repoRevisions {
+		revisions[i] = repository.CardRevisionToEntity(rev)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type SQLXShareTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXShareTokenRepository(db *sqlx.DB) *SQLXShareTokenRepository {
+	return &SQLXShareTokenRepository{db: db}
+}
+
+func (r *SQLXShareTokenRepository) CreateShareToken(ctx context.Context, token *entity.ShareToken) error {
+	repoToken := repository.RepoShareToken(*token)
+
+	query := `
+	INSERT INTO share_tokens (token, board_id, created_at)
+	VALUES (:token, :board_id, :created_at)
+	`
+
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, repoToken)
+
+	return err
+}
+
+func (r *SQLXShareTokenRepository) GetShareToken(ctx context.Context, token string) (*entity.ShareToken, error) {
+	query := `
+	SELECT * FROM share_tokens WHERE token = $1
+	`
+
+	var repoToken repository.ShareToken
+	err := conn(ctx, r.db).GetContext(ctx, &repoToken, query, token)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := repository.ShareTokenToEntity(repoToken)
+
+	return &shareToken, nil
+}
+
+func (r *SQLXShareTokenRepository) GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error) {
+	query := `
+	SELECT * FROM share_tokens WHERE board_id = $1
+	ORDER BY created_at ASC
+	`
+
+	var repoTokens []repository.ShareToken
+	err := conn(ctx, r.db).SelectContext(ctx, &repoTokens, query, boardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]entity.ShareToken, len(repoTokens))
+	for i, t := range repoTokens {
+		tokens[i] = repository.ShareTokenToEntity(t)
+	}
+
+	return tokens, nil
+}
+
+func (r *SQLXShareTokenRepository) DeleteShareToken(ctx context.Context, token string) error {
+	query := `
+	DELETE FROM share_tokens WHERE token = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, token)
+
+	return err
+}
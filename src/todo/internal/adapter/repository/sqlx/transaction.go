@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// executor is implemented by both *sqlx.DB and *sqlx.Tx
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+}
+
+type txKey struct{}
+
+// savepointKey holds how deep the nested transactions of a context go
+type savepointKey struct{}
+
+// conn returns the transaction started by SQLXTransactor for this context,
+// or the database itself outside of a transaction
+func conn(ctx context.Context, db *sqlx.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+type SQLXTransactor struct {
+	db *sqlx.DB
+}
+
+func NewSQLXTransactor(db *sqlx.DB) *SQLXTransactor {
+	return &SQLXTransactor{db: db}
+}
+
+// WithinTransaction runs fn in a transaction that every SQLX repository joins
+// when given the context passed to fn. Nested calls run in a savepoint of the
+// outer transaction, so a failed nested call is undone on its own and the
+// outer one can carry on.
+func (t *SQLXTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return withinSavepoint(ctx, tx, fn)
+	}
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func withinSavepoint(ctx context.Context, tx *sqlx.Tx, fn func(ctx context.Context) error) error {
+	depth, _ := ctx.Value(savepointKey{}).(int)
+	depth++
+	name := fmt.Sprintf("nested_%d", depth)
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, savepointKey{}, depth)); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+
+	return err
+}
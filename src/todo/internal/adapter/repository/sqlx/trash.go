@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// trashItems lists the deleted items that were not deleted along with
+// another one, together with the owner of their board
+const trashItems = `
+	SELECT b.id, 'board' AS type, b.title, NULL::uuid AS parent_id, b.id AS board_id,
+	b.user_id, b.deleted_by, b.deleted_at, b.user_id AS owner_id
+	FROM boards b
+	WHERE b.deleted_with = b.id
+	UNION ALL
+	SELECT c.id, 'column', c.title, c.board_id, c.board_id,
+	c.user_id, c.deleted_by, c.deleted_at, b.user_id
+	FROM columns c
+	JOIN boards b ON b.id = c.board_id
+	WHERE c.deleted_with = c.id
+	UNION ALL
+	SELECT ca.id, 'card', ca.title, ca.column_id, col.board_id,
+	ca.user_id, ca.deleted_by, ca.deleted_at, b.user_id
+	FROM cards ca
+	JOIN columns col ON col.id = ca.column_id
+	JOIN boards b ON b.id = col.board_id
+	WHERE ca.deleted_with = ca.id
+`
+
+type SQLXTrashRepository struct {
+	db *sqlx.DB
+}
+
+func NewSQLXTrashRepository(db *sqlx.DB) *SQLXTrashRepository {
+	return &SQLXTrashRepository{db: db}
+}
+
+func (r *SQLXTrashRepository) GetTrashByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.TrashItem, error) {
+	query := `
+	SELECT id, type, title, parent_id, board_id, user_id, deleted_by, deleted_at
+	FROM (` + trashItems + `) t
+	WHERE t.deleted_by = $1 OR t.owner_id = $1
+	ORDER BY t.deleted_at DESC, t.id ASC
+	LIMIT $2
+	OFFSET $3
+	`
+
+	var repoItems []repository.TrashItem
+	err := conn(ctx, r.db).SelectContext(ctx, &repoItems, query, userID, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]entity.TrashItem, len(repoItems))
+	for i, item := range repoItems {
+		items[i] = repository.TrashItemToEntity(item)
+	}
+
+	return items, nil
+}
+
+func (r *SQLXTrashRepository) GetTrashItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error) {
+	query := `
+	SELECT id, type, title, parent_id, board_id, user_id, deleted_by, deleted_at
+	FROM (` + trashItems + `) t
+	WHERE t.id = $1
+	`
+
+	var repoItem repository.TrashItem
+	err := conn(ctx, r.db).GetContext(ctx, &repoItem, query, id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := repository.TrashItemToEntity(repoItem)
+
+	return &item, nil
+}
+
+func (r *SQLXTrashRepository) RestoreItem(ctx context.Context, id uuid.UUID) error {
+	queries := []string{`
+	UPDATE boards SET deleted_at = NULL, deleted_by = NULL, deleted_with = NULL, version = version + 1
+	WHERE deleted_with = $1
+	`, `
+	UPDATE columns SET deleted_at = NULL, deleted_by = NULL, deleted_with = NULL, version = version + 1
+	WHERE deleted_with = $1
+	`, `
+	UPDATE cards SET deleted_at = NULL, deleted_by = NULL, deleted_with = NULL, version = version + 1
+	WHERE deleted_with = $1
+	`}
+
+	return execAll(ctx, r.db, queries, id)
+}
+
+// PurgeItems relies on an item never being deleted after the items it
+// contains, so everything deleted before the moment goes at once. Whatever
+// else refers to the purged rows is removed by the cascades of the schema.
+func (r *SQLXTrashRepository) PurgeItems(ctx context.Context, before time.Time) ([]string, error) {
+	query := `
+	SELECT storage_key FROM attachments
+	WHERE card_id IN (SELECT id FROM cards WHERE deleted_at < $1)
+	`
+
+	var keys []string
+	err := conn(ctx, r.db).SelectContext(ctx, &keys, query, before)
+
+	if err != nil {
+		return nil, err
+	}
+
+	queries := []string{`
+	DELETE FROM cards WHERE deleted_at < $1
+	`, `
+	DELETE FROM columns WHERE deleted_at < $1
+	`, `
+	DELETE FROM boards WHERE deleted_at < $1
+	`}
+
+	if err := execAll(ctx, r.db, queries, before); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// execAll runs the queries one after another with the same arguments. They
+// are only applied together inside a transaction.
+func execAll(ctx context.Context, db *sqlx.DB, queries []string, args ...any) error {
+	for _, query := range queries {
+		if _, err := conn(ctx, db).ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"todo/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// updateVersioned runs a named UPDATE that only matches the row at the
+// version in arg and bumps it. No matching row means that the row was
+// changed or deleted since that version was read.
+func updateVersioned(ctx context.Context, db *sqlx.DB, query string, arg any) error {
+	res, err := conn(ctx, db).NamedExecContext(ctx, query, arg)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return repository.ErrVersionConflict
+	}
+
+	return nil
+}
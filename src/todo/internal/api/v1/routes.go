@@ -12,17 +12,89 @@ func InitializeV1Routes(router *mux.Router, todoHandler *v1.TodoHandler) {
 	router.HandleFunc("/api/v1/boards", todoHandler.GetBoardsByUser).Methods("GET")
 	router.HandleFunc("/api/v1/boards", todoHandler.UpdateBoard).Methods("PUT")
 	router.HandleFunc("/api/v1/boards", todoHandler.DeleteBoard).Methods("DELETE")
+	router.HandleFunc("/api/v1/boards/{id}/public", todoHandler.SetBoardPublic).Methods("PUT")
+	router.HandleFunc("/api/v1/boards/{id}/archive", todoHandler.ArchiveBoard).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/unarchive", todoHandler.UnarchiveBoard).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/clone", todoHandler.CloneBoard).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/export", todoHandler.ExportBoard).Methods("GET")
+	router.HandleFunc("/api/v1/boards/import", todoHandler.ImportBoard).Methods("POST")
+	router.HandleFunc("/api/v1/boards/import/trello", todoHandler.ImportTrelloBoard).Methods("POST")
+	router.HandleFunc("/api/v1/templates", todoHandler.GetTemplatesByUser).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/activity", todoHandler.GetBoardActivity).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/events", todoHandler.StreamBoardEvents).Methods("GET")
+
+	router.HandleFunc("/api/v1/boards/{id}/share", todoHandler.CreateShareToken).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/share", todoHandler.GetShareTokensByBoard).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/share/{token}", todoHandler.RevokeShareToken).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/boards/{id}/members", todoHandler.InviteMember).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/members", todoHandler.GetBoardMembers).Methods("GET")
+	router.HandleFunc("/api/v1/boards/{id}/members/accept", todoHandler.AcceptInvitation).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/members/{user_id}", todoHandler.UpdateMemberRole).Methods("PUT")
+	router.HandleFunc("/api/v1/boards/{id}/members/{user_id}", todoHandler.RemoveMember).Methods("DELETE")
+	router.HandleFunc("/api/v1/invitations", todoHandler.GetInvitations).Methods("GET")
+
+	router.HandleFunc("/api/v1/boards/{id}/labels", todoHandler.CreateLabel).Methods("POST")
+	router.HandleFunc("/api/v1/boards/{id}/labels", todoHandler.GetLabelsByBoard).Methods("GET")
+	router.HandleFunc("/api/v1/labels/{id}", todoHandler.UpdateLabel).Methods("PUT")
+	router.HandleFunc("/api/v1/labels/{id}", todoHandler.DeleteLabel).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/shared/{token}", todoHandler.GetSharedBoard).Methods("GET")
+	router.HandleFunc("/api/v1/shared/{token}/cards/{id}", todoHandler.GetSharedCard).Methods("GET")
+	router.PathPrefix("/api/v1/shared/").HandlerFunc(todoHandler.RejectSharedMutation)
 
 	router.HandleFunc("/api/v1/columns", todoHandler.CreateColumn).Methods("POST")
 	router.HandleFunc("/api/v1/columns/{id}", todoHandler.GetColumnByID).Methods("GET")
 	router.HandleFunc("/api/v1/columns", todoHandler.GetColumnsByBoard).Methods("GET")
 	router.HandleFunc("/api/v1/columns", todoHandler.UpdateColumn).Methods("PUT")
 	router.HandleFunc("/api/v1/columns", todoHandler.DeleteColumn).Methods("DELETE")
+	router.HandleFunc("/api/v1/columns/{id}/position", todoHandler.RepositionColumn).Methods("PUT")
+	router.HandleFunc("/api/v1/columns/{id}/archive", todoHandler.ArchiveColumn).Methods("POST")
+	router.HandleFunc("/api/v1/columns/{id}/unarchive", todoHandler.UnarchiveColumn).Methods("POST")
+	router.HandleFunc("/api/v1/columns/{id}/wip-limit", todoHandler.SetColumnWIPLimit).Methods("PUT")
 
 	router.HandleFunc("/api/v1/cards", todoHandler.CreateCard).Methods("POST")
 	router.HandleFunc("/api/v1/cards/new", todoHandler.GetNewCards).Methods("GET")
+	router.HandleFunc("/api/v1/cards/overdue", todoHandler.GetOverdueCards).Methods("GET")
+	router.HandleFunc("/api/v1/cards/due", todoHandler.GetDueCards).Methods("GET")
+	router.HandleFunc("/api/v1/cards/assigned", todoHandler.GetAssignedCards).Methods("GET")
+	router.HandleFunc("/api/v1/cards/search", todoHandler.SearchCards).Methods("GET")
 	router.HandleFunc("/api/v1/cards/{id}", todoHandler.GetCardByID).Methods("GET")
 	router.HandleFunc("/api/v1/cards", todoHandler.GetCardsByColumn).Methods("GET")
 	router.HandleFunc("/api/v1/cards", todoHandler.UpdateCard).Methods("PUT")
 	router.HandleFunc("/api/v1/cards", todoHandler.DeleteCard).Methods("DELETE")
+	router.HandleFunc("/api/v1/cards/{id}/position", todoHandler.RepositionCard).Methods("PUT")
+	router.HandleFunc("/api/v1/cards/{id}/move", todoHandler.MoveCard).Methods("POST")
+	router.HandleFunc("/api/v1/cards/{id}/dates", todoHandler.SetCardDates).Methods("PUT")
+	router.HandleFunc("/api/v1/cards/{id}/labels/{label_id}", todoHandler.AttachLabel).Methods("PUT")
+	router.HandleFunc("/api/v1/cards/{id}/labels/{label_id}", todoHandler.DetachLabel).Methods("DELETE")
+	router.HandleFunc("/api/v1/cards/{id}/assignees/{user_id}", todoHandler.AssignCard).Methods("PUT")
+	router.HandleFunc("/api/v1/cards/{id}/assignees/{user_id}", todoHandler.UnassignCard).Methods("DELETE")
+	router.HandleFunc("/api/v1/cards/{id}/checklists", todoHandler.CreateChecklist).Methods("POST")
+	router.HandleFunc("/api/v1/cards/{id}/checklists", todoHandler.GetChecklistsByCard).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/comments", todoHandler.CreateComment).Methods("POST")
+	router.HandleFunc("/api/v1/cards/{id}/comments", todoHandler.GetCommentsByCard).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/attachments", todoHandler.UploadAttachment).Methods("POST")
+	router.HandleFunc("/api/v1/cards/{id}/activity", todoHandler.GetCardActivity).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/revisions", todoHandler.GetCardRevisions).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/revisions/diff", todoHandler.DiffCardRevisions).Methods("GET")
+	router.HandleFunc("/api/v1/cards/{id}/revisions/{number}/revert", todoHandler.RevertCard).Methods("POST")
+
+	router.HandleFunc("/api/v1/checklists/{id}", todoHandler.UpdateChecklist).Methods("PUT")
+	router.HandleFunc("/api/v1/checklists/{id}", todoHandler.DeleteChecklist).Methods("DELETE")
+	router.HandleFunc("/api/v1/checklists/{id}/items", todoHandler.CreateChecklistItem).Methods("POST")
+	router.HandleFunc("/api/v1/checklist-items/{id}", todoHandler.UpdateChecklistItem).Methods("PUT")
+	router.HandleFunc("/api/v1/checklist-items/{id}", todoHandler.DeleteChecklistItem).Methods("DELETE")
+	router.HandleFunc("/api/v1/checklist-items/{id}/done", todoHandler.SetChecklistItemDone).Methods("PUT")
+
+	router.HandleFunc("/api/v1/comments/{id}", todoHandler.UpdateComment).Methods("PUT")
+	router.HandleFunc("/api/v1/comments/{id}", todoHandler.DeleteComment).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/attachments/{id}", todoHandler.GetAttachment).Methods("GET")
+	router.HandleFunc("/api/v1/attachments/{id}", todoHandler.DeleteAttachment).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/trash", todoHandler.GetTrash).Methods("GET")
+	router.HandleFunc("/api/v1/trash/{id}/restore", todoHandler.RestoreItem).Methods("POST")
+
+	router.HandleFunc("/api/v1/batch", todoHandler.RunBatch).Methods("POST")
 }
@@ -0,0 +1,2141 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	api "todo/internal/api/v1"
+	"todo/internal/common/identity"
+	"todo/internal/common/logger"
+	"todo/internal/config"
+	"todo/internal/dto"
+	"todo/internal/entity"
+	handler "todo/internal/handler/v1"
+	"todo/internal/middleware"
+	"todo/internal/repository"
+	usecase "todo/internal/usecase/v1"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+// nopTransactor runs the function without a transaction
+type nopTransactor struct{}
+
+func (nopTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fixture is a board with one column and one card owned by ownerID and shared
+// with members of every role; the comment on the card is written and the
+// attachment uploaded by the editor.
+// Every id that is not part of the fixture is reported as missing by the repos.
+type fixture struct {
+	ownerID uuid.UUID
+	members map[entity.MemberRole]uuid.UUID
+	// otherID is an accepted editor that is only ever the target of requests
+	otherID   uuid.UUID
+	pendingID uuid.UUID
+	board     *entity.Board
+	column    *entity.Column
+	card      *entity.Card
+	// siblings of the column and the card to reposition them against
+	columnAnchor *entity.Column
+	cardAnchor   *entity.Card
+	label        *entity.Label
+	checklist    *entity.Checklist
+	item         *entity.ChecklistItem
+	comment      *entity.Comment
+	attachment   *entity.Attachment
+	token        string
+	router       *mux.Router
+}
+
+// ids are substituted into route paths and bodies
+type ids struct {
+	user         uuid.UUID
+	member       uuid.UUID
+	board        uuid.UUID
+	column       uuid.UUID
+	card         uuid.UUID
+	columnAnchor uuid.UUID
+	cardAnchor   uuid.UUID
+	label        uuid.UUID
+	checklist    uuid.UUID
+	item         uuid.UUID
+	attachment   uuid.UUID
+}
+
+func newFixture() *fixture {
+	ownerID := uuid.New()
+	board := &entity.Board{ID: uuid.New(), UserID: ownerID, Title: "Board", IsPublic: true, Version: 1}
+	column := &entity.Column{ID: uuid.New(), UserID: ownerID, BoardID: board.ID, Title: "Column", Version: 1}
+	card := &entity.Card{ID: uuid.New(), UserID: ownerID, ColumnID: column.ID, Title: "Card", Version: 1}
+	columnAnchor := &entity.Column{ID: uuid.New(), UserID: ownerID, BoardID: board.ID, Title: "Anchor", Position: 1024}
+	cardAnchor := &entity.Card{ID: uuid.New(), UserID: ownerID, ColumnID: column.ID, Title: "Anchor", Position: 1024}
+	label := &entity.Label{ID: uuid.New(), BoardID: board.ID, Name: "Bug", Color: "#d73a4a"}
+	checklist := &entity.Checklist{ID: uuid.New(), CardID: card.ID, Title: "Steps"}
+	item := &entity.ChecklistItem{ID: uuid.New(), ChecklistID: checklist.ID, Text: "Step"}
+	token := "token"
+
+	boardRepo := new(mocks.BoardRepository)
+	columnRepo := new(mocks.ColumnRepository)
+	cardRepo := new(mocks.CardRepository)
+	shareTokenRepo := new(mocks.ShareTokenRepository)
+	memberRepo := new(mocks.BoardMemberRepository)
+	labelRepo := new(mocks.LabelRepository)
+	checklistRepo := new(mocks.ChecklistRepository)
+	commentRepo := new(mocks.CommentRepository)
+	assigneeRepo := new(mocks.AssigneeRepository)
+	attachmentRepo := new(mocks.AttachmentRepository)
+	blobStore := new(mocks.BlobStore)
+	activityRepo := new(mocks.ActivityRepository)
+	trashRepo := new(mocks.TrashRepository)
+	revisionRepo := new(mocks.RevisionRepository)
+	outboxRepo := new(mocks.OutboxRepository)
+	broker := new(mocks.Broker)
+
+	boardRepo.On("GetBoardByID", mock.Anything, board.ID).Return(board, nil)
+	boardRepo.On("GetBoardByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	boardRepo.On("CreateBoard", mock.Anything, mock.Anything).Return(nil)
+	boardRepo.On("GetBoardsByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Board{*board}, nil)
+	boardRepo.On("GetBoardsPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Board{*board}, nil)
+	boardRepo.On("UpdateBoard", mock.Anything, mock.Anything).Return(nil)
+	boardRepo.On("UpdateBoardVisibility", mock.Anything, mock.Anything).Return(nil)
+	boardRepo.On("UpdateBoardArchived", mock.Anything, mock.Anything).Return(nil)
+	boardRepo.On("GetTemplatesByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Board{}, nil)
+	boardRepo.On("DeleteBoard", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	boardRepo.On("LockBoard", mock.Anything, mock.Anything).Return(nil)
+
+	columnRepo.On("GetColumnByID", mock.Anything, column.ID).Return(column, nil)
+	columnRepo.On("GetColumnByID", mock.Anything, columnAnchor.ID).Return(columnAnchor, nil)
+	columnRepo.On("GetColumnByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	columnRepo.On("CreateColumn", mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("GetColumnsByBoard", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Column{*column}, nil)
+	columnRepo.On("GetColumnsPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Column{*column, *columnAnchor}, nil)
+	columnRepo.On("UpdateColumn", mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("UpdateColumnArchived", mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("SetColumnWIPLimit", mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("DeleteColumn", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("LockColumn", mock.Anything, mock.Anything).Return(nil)
+	columnRepo.On("GetColumnPositions", mock.Anything, mock.Anything).Return([]entity.Position{{ID: column.ID}, {ID: columnAnchor.ID, Position: columnAnchor.Position}}, nil)
+	columnRepo.On("UpdateColumnPosition", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	cardRepo.On("GetCardByID", mock.Anything, card.ID).Return(card, nil)
+	cardRepo.On("GetCardByID", mock.Anything, cardAnchor.ID).Return(cardAnchor, nil)
+	cardRepo.On("GetCardByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	cardRepo.On("CreateCard", mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("GetCardsByColumn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Card{*card}, nil)
+	cardRepo.On("GetCardsPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Card{*card, *cardAnchor}, nil)
+	cardRepo.On("GetNewCards", mock.Anything, mock.Anything, mock.Anything).Return([]entity.Card{*card}, nil)
+	cardRepo.On("UpdateCard", mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("MoveCard", mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("DeleteCard", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("LockCard", mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("GetCardPositions", mock.Anything, mock.Anything).Return([]entity.Position{{ID: card.ID}, {ID: cardAnchor.ID, Position: cardAnchor.Position}}, nil)
+	cardRepo.On("UpdateCardPosition", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("CountCardsByColumns", mock.Anything, mock.Anything).Return(map[uuid.UUID]int{card.ColumnID: 1}, nil)
+	cardRepo.On("SetCardDates", mock.Anything, mock.Anything).Return(nil)
+	cardRepo.On("GetDueCardsByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Card{*card}, nil)
+	cardRepo.On("GetCardsByAssignee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Card{*card}, nil)
+	cardRepo.On("SearchCards", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.CardMatch{{Card: *card, BoardID: board.ID, Rank: 0.6, TitleHeadline: "<b>Title</b>"}}, nil)
+
+	labelRepo.On("GetLabelByID", mock.Anything, label.ID).Return(label, nil)
+	labelRepo.On("GetLabelByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	labelRepo.On("CreateLabel", mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("GetLabelsByBoard", mock.Anything, mock.Anything).Return([]entity.Label{*label}, nil)
+	labelRepo.On("UpdateLabel", mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("DeleteLabel", mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("AttachLabel", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("DetachLabel", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("DetachForeignLabels", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	labelRepo.On("GetLabelsByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]entity.Label{card.ID: {*label}}, nil)
+
+	checklistRepo.On("GetChecklistByID", mock.Anything, checklist.ID).Return(checklist, nil)
+	checklistRepo.On("GetChecklistByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	checklistRepo.On("CreateChecklist", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("GetChecklistsByCard", mock.Anything, mock.Anything).Return([]entity.Checklist{*checklist}, nil)
+	checklistRepo.On("UpdateChecklist", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("DeleteChecklist", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("LockChecklist", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("GetChecklistPositions", mock.Anything, mock.Anything).Return([]entity.Position{}, nil)
+	checklistRepo.On("GetItemByID", mock.Anything, item.ID).Return(item, nil)
+	checklistRepo.On("GetItemByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	checklistRepo.On("CreateItem", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("GetItemsByCard", mock.Anything, mock.Anything).Return([]entity.ChecklistItem{*item}, nil)
+	checklistRepo.On("UpdateItem", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("DeleteItem", mock.Anything, mock.Anything).Return(nil)
+	checklistRepo.On("GetItemPositions", mock.Anything, mock.Anything).Return([]entity.Position{}, nil)
+	checklistRepo.On("GetProgressByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{card.ID: {Done: 1, Total: 2}}, nil)
+
+	shareTokenRepo.On("GetShareToken", mock.Anything, token).Return(&entity.ShareToken{Token: token, BoardID: board.ID}, nil)
+	shareTokenRepo.On("GetShareToken", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	shareTokenRepo.On("CreateShareToken", mock.Anything, mock.Anything).Return(nil)
+	shareTokenRepo.On("GetShareTokensByBoard", mock.Anything, mock.Anything).Return([]entity.ShareToken{}, nil)
+	shareTokenRepo.On("DeleteShareToken", mock.Anything, mock.Anything).Return(nil)
+
+	members := map[entity.MemberRole]uuid.UUID{
+		entity.RoleOwner:  uuid.New(),
+		entity.RoleEditor: uuid.New(),
+		entity.RoleViewer: uuid.New(),
+	}
+	otherID := uuid.New()
+	pendingID := uuid.New()
+
+	for role, userID := range members {
+		memberRepo.On("GetMember", mock.Anything, board.ID, userID).Return(&entity.BoardMember{BoardID: board.ID, UserID: userID, Role: role, Accepted: true}, nil)
+	}
+	memberRepo.On("GetMember", mock.Anything, board.ID, otherID).Return(&entity.BoardMember{BoardID: board.ID, UserID: otherID, Role: entity.RoleEditor, Accepted: true}, nil)
+	memberRepo.On("GetMember", mock.Anything, board.ID, pendingID).Return(&entity.BoardMember{BoardID: board.ID, UserID: pendingID, Role: entity.RoleEditor}, nil)
+	memberRepo.On("GetMember", mock.Anything, mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	memberRepo.On("AddMember", mock.Anything, mock.Anything).Return(nil)
+	memberRepo.On("GetMembersByBoard", mock.Anything, mock.Anything).Return([]entity.BoardMember{}, nil)
+	memberRepo.On("GetInvitationsByUser", mock.Anything, mock.Anything).Return([]entity.BoardMember{}, nil)
+	memberRepo.On("UpdateMember", mock.Anything, mock.Anything).Return(nil)
+	memberRepo.On("DeleteMember", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	comment := &entity.Comment{ID: uuid.New(), CardID: card.ID, AuthorID: members[entity.RoleEditor], Body: "Comment"}
+
+	commentRepo.On("GetCommentByID", mock.Anything, comment.ID).Return(comment, nil)
+	commentRepo.On("GetCommentByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	commentRepo.On("CreateComment", mock.Anything, mock.Anything).Return(nil)
+	commentRepo.On("GetCommentsByCard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Comment{*comment}, nil)
+	commentRepo.On("UpdateComment", mock.Anything, mock.Anything).Return(nil)
+	commentRepo.On("DeleteComment", mock.Anything, mock.Anything).Return(nil)
+
+	assigneeRepo.On("AddAssignee", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	assigneeRepo.On("RemoveAssignee", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	assigneeRepo.On("GetAssigneesByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{card.ID: {members[entity.RoleEditor]}}, nil)
+
+	attachmentContent := "Log line"
+	attachment := &entity.Attachment{
+		ID:          uuid.New(),
+		CardID:      card.ID,
+		UploaderID:  members[entity.RoleEditor],
+		Filename:    "log.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        int64(len(attachmentContent)),
+		StorageKey:  "cards/" + card.ID.String() + "/attachment",
+	}
+
+	attachmentRepo.On("GetAttachmentByID", mock.Anything, attachment.ID).Return(attachment, nil)
+	attachmentRepo.On("GetAttachmentByID", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	attachmentRepo.On("CreateAttachment", mock.Anything, mock.Anything).Return(nil)
+	attachmentRepo.On("GetAttachmentsByCard", mock.Anything, mock.Anything).Return([]entity.Attachment{*attachment}, nil)
+	attachmentRepo.On("DeleteAttachment", mock.Anything, mock.Anything).Return(nil)
+
+	blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		io.Copy(io.Discard, args.Get(2).(io.Reader))
+	}).Return(nil)
+	blobStore.On("Get", mock.Anything, attachment.StorageKey).Return(func(ctx context.Context, key string) io.ReadCloser {
+		return io.NopCloser(strings.NewReader(attachmentContent))
+	}, nil)
+	blobStore.On("Delete", mock.Anything, mock.Anything).Return(nil)
+
+	activityRepo.On("CreateActivity", mock.Anything, mock.Anything).Return(nil)
+	activityRepo.On("GetActivityByBoard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Activity{}, nil)
+	activityRepo.On("GetActivityByTarget", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.Activity{}, nil)
+
+	revisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil)
+	revisionRepo.On("GetRevisionsByCard", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.CardRevision{}, nil)
+	revisionRepo.On("GetRevision", mock.Anything, card.ID, mock.Anything).Return(func(ctx context.Context, cardID uuid.UUID, number int) *entity.CardRevision {
+		return &entity.CardRevision{ID: uuid.New(), CardID: cardID, Number: number, Title: "Card", AuthorID: ownerID}
+	}, nil)
+
+	outboxRepo.On("CreateOutboxEvents", mock.Anything, mock.Anything).Return(nil)
+
+	trashRepo.On("GetTrashByUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]entity.TrashItem{}, nil)
+	trashRepo.On("GetTrashItem", mock.Anything, board.ID).Return(&entity.TrashItem{ID: board.ID, Type: entity.ActivityTargetBoard, Title: board.Title, BoardID: board.ID, UserID: ownerID}, nil)
+	trashRepo.On("GetTrashItem", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound)
+	trashRepo.On("RestoreItem", mock.Anything, mock.Anything).Return(nil)
+
+	// A closed subscription ends the event stream right after its headers
+	closed := make(chan entity.Event)
+	close(closed)
+	broker.On("Subscribe", mock.Anything, mock.Anything, mock.Anything).Return((<-chan entity.Event)(closed), nil)
+
+	uc := usecase.NewTodoUseCase(boardRepo, columnRepo, cardRepo, shareTokenRepo, memberRepo, labelRepo, checklistRepo, commentRepo, assigneeRepo, attachmentRepo, blobStore, activityRepo, trashRepo, revisionRepo, outboxRepo, broker, nopTransactor{}, nopLogger{})
+	h := handler.NewTodoHandler(uc, config.PaginationConfig{Limit: 10}, time.Second)
+
+	router := mux.NewRouter()
+	router.Use(middleware.NewIdentityMiddleware().Middleware)
+	api.InitializeV1Routes(router, h)
+
+	return &fixture{
+		ownerID:   ownerID,
+		members:   members,
+		otherID:   otherID,
+		pendingID: pendingID,
+		board:     board,
+		column:    column,
+		card:      card,
+
+		columnAnchor: columnAnchor,
+		cardAnchor:   cardAnchor,
+		label:        label,
+		checklist:    checklist,
+		item:         item,
+		comment:      comment,
+		attachment:   attachment,
+		token:        token,
+		router:       router,
+	}
+}
+
+func (f *fixture) ids() ids {
+	return ids{
+		user:         f.ownerID,
+		member:       f.otherID,
+		board:        f.board.ID,
+		column:       f.column.ID,
+		card:         f.card.ID,
+		columnAnchor: f.columnAnchor.ID,
+		cardAnchor:   f.cardAnchor.ID,
+		label:        f.label.ID,
+		checklist:    f.checklist.ID,
+		item:         f.item.ID,
+		attachment:   f.attachment.ID,
+	}
+}
+
+func missingIDs(userID uuid.UUID) ids {
+	return ids{
+		user:         userID,
+		member:       uuid.New(),
+		board:        uuid.New(),
+		column:       uuid.New(),
+		card:         uuid.New(),
+		columnAnchor: uuid.New(),
+		cardAnchor:   uuid.New(),
+		label:        uuid.New(),
+		checklist:    uuid.New(),
+		item:         uuid.New(),
+		attachment:   uuid.New(),
+	}
+}
+
+func (f *fixture) do(method, path string, body any, caller *identity.Caller) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+
+	req := httptest.NewRequest(method, path, &buf)
+	if caller != nil {
+		req.Header.Set(middleware.UserIDHeader, caller.UserID.String())
+		req.Header.Set(middleware.RoleHeader, caller.Role)
+	}
+
+	rec := httptest.NewRecorder()
+	f.router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+type route struct {
+	name   string
+	method string
+	path   func(ids ids) string
+	body   func(ids ids) any
+	ok     int
+	// adminOnly routes are forbidden even for the owner
+	adminOnly bool
+	// hasTarget routes address an existing resource and can be not found
+	hasTarget bool
+	// role is the least board role that may use the route; empty for routes
+	// that are not scoped to a board
+	role entity.MemberRole
+}
+
+func routes() []route {
+	return []route{
+		{
+			name:   "CreateBoard",
+			method: http.MethodPost,
+			path:   func(ids ids) string { return "/api/v1/boards" },
+			body:   func(ids ids) any { return map[string]any{"user_id": ids.user, "title": "Title"} },
+			ok:     http.StatusCreated,
+		},
+		{
+			name:      "GetBoardByID",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:   "GetBoardsByUser",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/boards?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:      "UpdateBoard",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/boards" },
+			body:      func(ids ids) any { return map[string]any{"id": ids.board, "title": "Title"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "DeleteBoard",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/boards?id=" + ids.board.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "SetBoardPublic",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/public" },
+			body:      func(ids ids) any { return map[string]any{"is_public": true} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "ArchiveBoard",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/archive" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			// Runs right after ArchiveBoard so that the board is writable
+			// again for the routes below
+			name:      "UnarchiveBoard",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/unarchive" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "CloneBoard",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/clone" },
+			body:      func(ids ids) any { return map[string]any{"title": "Copy", "template": true} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "ExportBoard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/export" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:   "GetTemplatesByUser",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/templates?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:      "GetBoardActivity",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/activity?limit=5" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "StreamBoardEvents",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/events" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "CreateShareToken",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/share" },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "GetShareTokensByBoard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/share" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:      "RevokeShareToken",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/share/token" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:   "CreateColumn",
+			method: http.MethodPost,
+			path:   func(ids ids) string { return "/api/v1/columns" },
+			body: func(ids ids) any {
+				return map[string]any{"user_id": ids.user, "board_id": ids.board, "title": "Title"}
+			},
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetColumnByID",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/columns/" + ids.column.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "GetColumnsByBoard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/columns?board_id=" + ids.board.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "UpdateColumn",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/columns" },
+			body:      func(ids ids) any { return map[string]any{"id": ids.column, "title": "Title"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "DeleteColumn",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/columns?id=" + ids.column.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "RepositionColumn",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/columns/" + ids.column.String() + "/position" },
+			body:      func(ids ids) any { return map[string]any{"after_id": ids.columnAnchor} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "ArchiveColumn",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/columns/" + ids.column.String() + "/archive" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			// Runs right after ArchiveColumn, see UnarchiveBoard
+			name:      "UnarchiveColumn",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/columns/" + ids.column.String() + "/unarchive" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "SetColumnWIPLimit",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/columns/" + ids.column.String() + "/wip-limit" },
+			body:      func(ids ids) any { return map[string]any{"limit": 5, "strict": true} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "CreateCard",
+			method: http.MethodPost,
+			path:   func(ids ids) string { return "/api/v1/cards" },
+			body: func(ids ids) any {
+				return map[string]any{"user_id": ids.user, "column_id": ids.column, "title": "Title"}
+			},
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetNewCards",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/new?from=01-01-2024&to=01-01-2025" },
+			ok:        http.StatusOK,
+			adminOnly: true,
+		},
+		{
+			name:      "GetCardByID",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "GetCardsByColumn",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards?column_id=" + ids.column.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:   "GetCardsByColumnAndLabel",
+			method: http.MethodGet,
+			path: func(ids ids) string {
+				return "/api/v1/cards?column_id=" + ids.column.String() + "&label_id=" + ids.label.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "UpdateCard",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/cards" },
+			body:      func(ids ids) any { return map[string]any{"id": ids.card, "title": "Title"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "UpdateCardInCurrentColumn",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/cards" },
+			body:      func(ids ids) any { return map[string]any{"id": ids.card, "column_id": ids.column, "title": "Title"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "DeleteCard",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/cards?id=" + ids.card.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "RepositionCard",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/position" },
+			body:      func(ids ids) any { return map[string]any{"before_id": ids.cardAnchor} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "MoveCard",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/move" },
+			body:      func(ids ids) any { return map[string]any{"column_id": ids.columnAnchor} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "SetCardDates",
+			method: http.MethodPut,
+			path:   func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/dates" },
+			body: func(ids ids) any {
+				return map[string]any{"start_date": "2025-01-01T00:00:00Z", "due_date": "2025-01-10T00:00:00Z"}
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "GetOverdueCards",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/cards/overdue?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:   "GetDueCards",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/cards/due?days=7&user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:   "GetAssignedCards",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/cards/assigned?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:   "SearchCards",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/cards/search?q=title&user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:   "AssignCard",
+			method: http.MethodPut,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/assignees/" + ids.member.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "UnassignCard",
+			method: http.MethodDelete,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/assignees/" + ids.member.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "AttachLabel",
+			method: http.MethodPut,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/labels/" + ids.label.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "DetachLabel",
+			method: http.MethodDelete,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/labels/" + ids.label.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "CreateLabel",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/labels" },
+			body:      func(ids ids) any { return map[string]any{"name": "Feature", "color": "#1f6feb"} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetLabelsByBoard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/labels" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "UpdateLabel",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/labels/" + ids.label.String() },
+			body:      func(ids ids) any { return map[string]any{"name": "Bug", "color": "#1f6feb"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "DeleteLabel",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/labels/" + ids.label.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "CreateChecklist",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/checklists" },
+			body:      func(ids ids) any { return map[string]any{"title": "Release"} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetChecklistsByCard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/checklists" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "UpdateChecklist",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/checklists/" + ids.checklist.String() },
+			body:      func(ids ids) any { return map[string]any{"title": "Release"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "DeleteChecklist",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/checklists/" + ids.checklist.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "CreateChecklistItem",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/checklists/" + ids.checklist.String() + "/items" },
+			body:      func(ids ids) any { return map[string]any{"text": "Tag the release"} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "UpdateChecklistItem",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/checklist-items/" + ids.item.String() },
+			body:      func(ids ids) any { return map[string]any{"text": "Tag the release"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "SetChecklistItemDone",
+			method:    http.MethodPut,
+			path:      func(ids ids) string { return "/api/v1/checklist-items/" + ids.item.String() + "/done" },
+			body:      func(ids ids) any { return map[string]any{"done": true} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "DeleteChecklistItem",
+			method:    http.MethodDelete,
+			path:      func(ids ids) string { return "/api/v1/checklist-items/" + ids.item.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "CreateComment",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/comments" },
+			body:      func(ids ids) any { return map[string]any{"body": "Looks good to me"} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetCommentsByCard",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/comments?limit=5" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "GetCardActivity",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/activity?limit=5" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "GetCardRevisions",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/revisions?limit=5" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:   "DiffCardRevisions",
+			method: http.MethodGet,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/revisions/diff?from=1&to=2"
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "RevertCard",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/cards/" + ids.card.String() + "/revisions/1/revert" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:   "UploadAttachment",
+			method: http.MethodPost,
+			path: func(ids ids) string {
+				return "/api/v1/cards/" + ids.card.String() + "/attachments?filename=log.txt"
+			},
+			body:      func(ids ids) any { return "Log line" },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleEditor,
+		},
+		{
+			name:      "GetAttachment",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/attachments/" + ids.attachment.String() },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "GetBoardMembers",
+			method:    http.MethodGet,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/members" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleViewer,
+		},
+		{
+			name:      "InviteMember",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/boards/" + ids.board.String() + "/members" },
+			body:      func(ids ids) any { return map[string]any{"user_id": uuid.New(), "role": "viewer"} },
+			ok:        http.StatusCreated,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:   "UpdateMemberRole",
+			method: http.MethodPut,
+			path: func(ids ids) string {
+				return "/api/v1/boards/" + ids.board.String() + "/members/" + ids.member.String()
+			},
+			body:      func(ids ids) any { return map[string]any{"role": "viewer"} },
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:   "RemoveMember",
+			method: http.MethodDelete,
+			path: func(ids ids) string {
+				return "/api/v1/boards/" + ids.board.String() + "/members/" + ids.member.String()
+			},
+			ok:        http.StatusOK,
+			hasTarget: true,
+			role:      entity.RoleOwner,
+		},
+		{
+			name:   "GetInvitations",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/invitations?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			name:   "GetTrash",
+			method: http.MethodGet,
+			path:   func(ids ids) string { return "/api/v1/trash?user_id=" + ids.user.String() },
+			ok:     http.StatusOK,
+		},
+		{
+			// The board doubles as the deleted item
+			name:      "RestoreItem",
+			method:    http.MethodPost,
+			path:      func(ids ids) string { return "/api/v1/trash/" + ids.board.String() + "/restore" },
+			ok:        http.StatusOK,
+			hasTarget: true,
+		},
+	}
+}
+
+func body(r route, ids ids) any {
+	if r.body == nil {
+		return nil
+	}
+	return r.body(ids)
+}
+
+func TestRoutesOwnership(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+	stranger := &identity.Caller{UserID: uuid.New()}
+	admin := &identity.Caller{UserID: uuid.New(), Role: identity.RoleAdmin}
+
+	for _, r := range routes() {
+		t.Run(r.name, func(t *testing.T) {
+			t.Run("owner", func(t *testing.T) {
+				want := r.ok
+				if r.adminOnly {
+					want = http.StatusForbidden
+				}
+
+				rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), owner)
+				assert.Equal(t, want, rec.Code, rec.Body.String())
+			})
+
+			t.Run("admin bypasses ownership", func(t *testing.T) {
+				rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), admin)
+				assert.Equal(t, r.ok, rec.Code, rec.Body.String())
+			})
+
+			t.Run("stranger is forbidden", func(t *testing.T) {
+				rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), stranger)
+				assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+			})
+
+			t.Run("no caller identity", func(t *testing.T) {
+				rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), nil)
+				assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+			})
+
+			if r.role != "" {
+				for _, role := range []entity.MemberRole{entity.RoleOwner, entity.RoleEditor, entity.RoleViewer} {
+					t.Run("member with role "+string(role), func(t *testing.T) {
+						want := http.StatusForbidden
+						if role.Includes(r.role) {
+							want = r.ok
+						}
+
+						member := &identity.Caller{UserID: f.members[role]}
+						rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), member)
+						assert.Equal(t, want, rec.Code, rec.Body.String())
+					})
+				}
+
+				t.Run("pending invitee is forbidden", func(t *testing.T) {
+					pending := &identity.Caller{UserID: f.pendingID}
+					rec := f.do(r.method, r.path(f.ids()), body(r, f.ids()), pending)
+					assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+				})
+			}
+
+			if !r.hasTarget {
+				return
+			}
+
+			t.Run("missing resource is not found", func(t *testing.T) {
+				missing := missingIDs(f.ownerID)
+				rec := f.do(r.method, r.path(missing), body(r, missing), owner)
+				assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+			})
+
+			t.Run("missing resource is not found for stranger", func(t *testing.T) {
+				missing := missingIDs(stranger.UserID)
+				rec := f.do(r.method, r.path(missing), body(r, missing), stranger)
+				assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+			})
+		})
+	}
+}
+
+func TestRoutesSharedAccess(t *testing.T) {
+	f := newFixture()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{
+			name:   "GetSharedBoard",
+			method: http.MethodGet,
+			path:   "/api/v1/shared/" + f.token,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "GetSharedBoard with unknown token",
+			method: http.MethodGet,
+			path:   "/api/v1/shared/unknown",
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "GetSharedCard",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/v1/shared/%s/cards/%s", f.token, f.card.ID),
+			want:   http.StatusOK,
+		},
+		{
+			name:   "GetSharedCard with missing card",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/v1/shared/%s/cards/%s", f.token, uuid.New()),
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "mutation through share link",
+			method: http.MethodDelete,
+			path:   "/api/v1/shared/" + f.token,
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, nil, nil)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesMembership(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+	viewer := &identity.Caller{UserID: f.members[entity.RoleViewer]}
+	pending := &identity.Caller{UserID: f.pendingID}
+	stranger := &identity.Caller{UserID: uuid.New()}
+
+	boardPath := "/api/v1/boards/" + f.board.ID.String()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		caller *identity.Caller
+		want   int
+	}{
+		{
+			name:   "invitee accepts invitation",
+			method: http.MethodPost,
+			path:   boardPath + "/members/accept",
+			caller: pending,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "accept without invitation",
+			method: http.MethodPost,
+			path:   boardPath + "/members/accept",
+			caller: stranger,
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "accept without caller identity",
+			method: http.MethodPost,
+			path:   boardPath + "/members/accept",
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "member leaves board",
+			method: http.MethodDelete,
+			path:   boardPath + "/members/" + viewer.UserID.String(),
+			caller: viewer,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "invitee declines invitation",
+			method: http.MethodDelete,
+			path:   boardPath + "/members/" + pending.UserID.String(),
+			caller: pending,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "remove board creator",
+			method: http.MethodDelete,
+			path:   boardPath + "/members/" + f.ownerID.String(),
+			caller: owner,
+			want:   http.StatusConflict,
+		},
+		{
+			name:   "remove non-member",
+			method: http.MethodDelete,
+			path:   boardPath + "/members/" + uuid.New().String(),
+			caller: owner,
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "demote board creator",
+			method: http.MethodPut,
+			path:   boardPath + "/members/" + f.ownerID.String(),
+			body:   map[string]any{"role": "viewer"},
+			caller: owner,
+			want:   http.StatusConflict,
+		},
+		{
+			name:   "invite with invalid role",
+			method: http.MethodPost,
+			path:   boardPath + "/members",
+			body:   map[string]any{"user_id": uuid.New(), "role": "superuser"},
+			caller: owner,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "invite existing member",
+			method: http.MethodPost,
+			path:   boardPath + "/members",
+			body:   map[string]any{"user_id": viewer.UserID, "role": "editor"},
+			caller: owner,
+			want:   http.StatusConflict,
+		},
+		{
+			name:   "invite board creator",
+			method: http.MethodPost,
+			path:   boardPath + "/members",
+			body:   map[string]any{"user_id": f.ownerID, "role": "editor"},
+			caller: owner,
+			want:   http.StatusConflict,
+		},
+		{
+			name:   "invitations of another user",
+			method: http.MethodGet,
+			path:   "/api/v1/invitations?user_id=" + pending.UserID.String(),
+			caller: stranger,
+			want:   http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, tt.caller)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesRepositionValidation(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	tests := []struct {
+		name string
+		path string
+		body any
+	}{
+		{
+			name: "card without anchor",
+			path: "/api/v1/cards/" + f.card.ID.String() + "/position",
+			body: map[string]any{},
+		},
+		{
+			name: "card with both anchors",
+			path: "/api/v1/cards/" + f.card.ID.String() + "/position",
+			body: map[string]any{"before_id": f.cardAnchor.ID, "after_id": f.cardAnchor.ID},
+		},
+		{
+			name: "column next to itself",
+			path: "/api/v1/columns/" + f.column.ID.String() + "/position",
+			body: map[string]any{"before_id": f.column.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodPut, tt.path, tt.body, owner)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesMoveCard(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		status int
+	}{
+		{
+			name:   "column change through update",
+			method: http.MethodPut,
+			path:   "/api/v1/cards",
+			body:   map[string]any{"id": f.card.ID, "column_id": f.columnAnchor.ID, "title": "Title"},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "no target column",
+			method: http.MethodPost,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/move",
+			body:   map[string]any{},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "missing target column",
+			method: http.MethodPost,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/move",
+			body:   map[string]any{"column_id": uuid.New()},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "missing anchor",
+			method: http.MethodPost,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/move",
+			body:   map[string]any{"column_id": f.column.ID, "before_id": uuid.New()},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "anchor outside the target column",
+			method: http.MethodPost,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/move",
+			body:   map[string]any{"column_id": f.columnAnchor.ID, "before_id": f.cardAnchor.ID},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesLabels(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	t.Run("cards come with labels", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards/"+f.card.ID.String(), nil, owner)
+
+		var card struct {
+			Labels []struct {
+				ID   uuid.UUID `json:"id"`
+				Name string    `json:"name"`
+			} `json:"labels"`
+		}
+		json.NewDecoder(rec.Body).Decode(&card)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, card.Labels, 1) {
+			assert.Equal(t, f.label.ID, card.Labels[0].ID)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		status int
+	}{
+		{
+			name:   "duplicate name",
+			method: http.MethodPost,
+			path:   "/api/v1/boards/" + f.board.ID.String() + "/labels",
+			body:   map[string]any{"name": "bug", "color": "#1f6feb"},
+			status: http.StatusConflict,
+		},
+		{
+			name:   "invalid color",
+			method: http.MethodPost,
+			path:   "/api/v1/boards/" + f.board.ID.String() + "/labels",
+			body:   map[string]any{"name": "Chore", "color": "blue"},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid label filter",
+			method: http.MethodGet,
+			path:   "/api/v1/cards?column_id=" + f.column.ID.String() + "&label_id=bug",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "missing label",
+			method: http.MethodPut,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/labels/" + uuid.New().String(),
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesChecklists(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	t.Run("cards come with checklist progress", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards?column_id="+f.column.ID.String(), nil, owner)
+
+		var cards []struct {
+			Checklist *struct {
+				Done  int `json:"done"`
+				Total int `json:"total"`
+			} `json:"checklist"`
+		}
+		json.NewDecoder(rec.Body).Decode(&cards)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, cards, 1) && assert.NotNil(t, cards[0].Checklist) {
+			assert.Equal(t, 1, cards[0].Checklist.Done)
+			assert.Equal(t, 2, cards[0].Checklist.Total)
+		}
+	})
+
+	t.Run("checklists come with items", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards/"+f.card.ID.String()+"/checklists", nil, owner)
+
+		var checklists []struct {
+			ID    uuid.UUID `json:"id"`
+			Items []struct {
+				ID uuid.UUID `json:"id"`
+			} `json:"items"`
+		}
+		json.NewDecoder(rec.Body).Decode(&checklists)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, checklists, 1) && assert.Len(t, checklists[0].Items, 1) {
+			assert.Equal(t, f.item.ID, checklists[0].Items[0].ID)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		status int
+	}{
+		{
+			name:   "empty title",
+			method: http.MethodPost,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/checklists",
+			body:   map[string]any{"title": " "},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "empty item",
+			method: http.MethodPost,
+			path:   "/api/v1/checklists/" + f.checklist.ID.String() + "/items",
+			body:   map[string]any{"text": ""},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid item id",
+			method: http.MethodPut,
+			path:   "/api/v1/checklist-items/step/done",
+			body:   map[string]any{"done": true},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+// Comments can only be changed by their author or an admin, so the board roles
+// of the route table do not apply to them
+func TestRoutesComments(t *testing.T) {
+	f := newFixture()
+
+	author := &identity.Caller{UserID: f.members[entity.RoleEditor]}
+	owner := &identity.Caller{UserID: f.ownerID}
+	viewer := &identity.Caller{UserID: f.members[entity.RoleViewer]}
+	stranger := &identity.Caller{UserID: uuid.New()}
+	admin := &identity.Caller{UserID: uuid.New(), Role: identity.RoleAdmin}
+
+	commentPath := "/api/v1/comments/" + f.comment.ID.String()
+	edit := map[string]any{"body": "Edited"}
+
+	t.Run("comments come with author", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards/"+f.card.ID.String()+"/comments", nil, viewer)
+
+		var comments []struct {
+			ID       uuid.UUID `json:"id"`
+			AuthorID uuid.UUID `json:"author_id"`
+		}
+		json.NewDecoder(rec.Body).Decode(&comments)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, comments, 1) {
+			assert.Equal(t, author.UserID, comments[0].AuthorID)
+		}
+	})
+
+	t.Run("edited comment has edit timestamp", func(t *testing.T) {
+		rec := f.do(http.MethodPut, commentPath, edit, author)
+
+		var comment struct {
+			EditedAt *time.Time `json:"edited_at"`
+		}
+		json.NewDecoder(rec.Body).Decode(&comment)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotNil(t, comment.EditedAt)
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		caller *identity.Caller
+		want   int
+	}{
+		{
+			name:   "author edits comment",
+			method: http.MethodPut,
+			path:   commentPath,
+			body:   edit,
+			caller: author,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "admin edits comment",
+			method: http.MethodPut,
+			path:   commentPath,
+			body:   edit,
+			caller: admin,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "board owner edits comment of another user",
+			method: http.MethodPut,
+			path:   commentPath,
+			body:   edit,
+			caller: owner,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "stranger edits comment",
+			method: http.MethodPut,
+			path:   commentPath,
+			body:   edit,
+			caller: stranger,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "edit with empty body",
+			method: http.MethodPut,
+			path:   commentPath,
+			body:   map[string]any{"body": ""},
+			caller: author,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "edit missing comment",
+			method: http.MethodPut,
+			path:   "/api/v1/comments/" + uuid.New().String(),
+			body:   edit,
+			caller: author,
+			want:   http.StatusNotFound,
+		},
+		{
+			name:   "invalid comment id",
+			method: http.MethodPut,
+			path:   "/api/v1/comments/comment",
+			body:   edit,
+			caller: author,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "author deletes comment",
+			method: http.MethodDelete,
+			path:   commentPath,
+			caller: author,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "admin deletes comment",
+			method: http.MethodDelete,
+			path:   commentPath,
+			caller: admin,
+			want:   http.StatusOK,
+		},
+		{
+			name:   "viewer deletes comment of another user",
+			method: http.MethodDelete,
+			path:   commentPath,
+			caller: viewer,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "delete without caller identity",
+			method: http.MethodDelete,
+			path:   commentPath,
+			want:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, tt.caller)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesCardDates(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		status int
+	}{
+		{
+			name:   "clear dates",
+			method: http.MethodPut,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/dates",
+			body:   map[string]any{"start_date": nil, "due_date": nil},
+			status: http.StatusOK,
+		},
+		{
+			name:   "start after due",
+			method: http.MethodPut,
+			path:   "/api/v1/cards/" + f.card.ID.String() + "/dates",
+			body:   map[string]any{"start_date": "2025-01-10T00:00:00Z", "due_date": "2025-01-01T00:00:00Z"},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "create card starting after due",
+			method: http.MethodPost,
+			path:   "/api/v1/cards",
+			body: map[string]any{
+				"user_id": f.ownerID, "column_id": f.column.ID, "title": "Title",
+				"start_date": "2025-01-10T00:00:00Z", "due_date": "2025-01-01T00:00:00Z",
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "due within zero days",
+			method: http.MethodGet,
+			path:   "/api/v1/cards/due?days=0&user_id=" + f.ownerID.String(),
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "due without days",
+			method: http.MethodGet,
+			path:   "/api/v1/cards/due?user_id=" + f.ownerID.String(),
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, tt.body, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesSearchCards(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+	path := "/api/v1/cards/search?user_id=" + f.ownerID.String()
+
+	tests := []struct {
+		name   string
+		query  string
+		status int
+	}{
+		{name: "all filters", query: "&q=title&board_id=" + f.board.ID.String() + "&from=01-01-2025&to=01-01-2025", status: http.StatusOK},
+		{name: "no query", query: "", status: http.StatusBadRequest},
+		{name: "blank query", query: "&q=%20%20", status: http.StatusBadRequest},
+		{name: "query too long", query: "&q=" + strings.Repeat("a", 257), status: http.StatusBadRequest},
+		{name: "invalid board", query: "&q=title&board_id=board", status: http.StatusBadRequest},
+		{name: "invalid from", query: "&q=title&from=2025-01-01", status: http.StatusBadRequest},
+		{name: "ends before it starts", query: "&q=title&from=02-01-2025&to=31-12-2024", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodGet, path+tt.query, nil, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("someone else's cards", func(t *testing.T) {
+		rec := f.do(http.MethodGet, path+"&q=title", nil, &identity.Caller{UserID: f.otherID})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestRoutesImportBoard(t *testing.T) {
+	f := newFixture()
+
+	column := map[string]any{"title": "Column", "position": 1024, "cards": []any{map[string]any{"title": "Card", "label_ids": []string{"6c1f0b7e-5d0a-4a53-9b8e-1a2b3c4d5e6f"}}}}
+	label := map[string]any{"id": "6c1f0b7e-5d0a-4a53-9b8e-1a2b3c4d5e6f", "name": "Bug", "color": "#d73a4a"}
+	strict := map[string]any{"title": "Column", "wip_limit": 1, "wip_strict": true, "cards": []any{map[string]any{"title": "Card"}, map[string]any{"title": "Another"}}}
+
+	tests := []struct {
+		name   string
+		body   any
+		status int
+	}{
+		{name: "valid export", body: map[string]any{"version": 1, "board": map[string]any{"title": "Board"}, "labels": []any{label}, "columns": []any{column}}, status: http.StatusCreated},
+		{name: "unknown version", body: map[string]any{"version": 3, "board": map[string]any{"title": "Board"}}, status: http.StatusBadRequest},
+		{name: "board without a title", body: map[string]any{"version": 1, "board": map[string]any{}}, status: http.StatusBadRequest},
+		{name: "card with an unknown label", body: map[string]any{"version": 1, "board": map[string]any{"title": "Board"}, "columns": []any{column}}, status: http.StatusBadRequest},
+		{name: "strict column over its wip limit", body: map[string]any{"version": 2, "board": map[string]any{"title": "Board"}, "labels": []any{label}, "columns": []any{strict}}, status: http.StatusBadRequest},
+		{name: "not json", body: "export", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodPost, "/api/v1/boards/import", tt.body, &identity.Caller{UserID: f.otherID})
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("no caller identity", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/boards/import", tests[0].body, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestRoutesImportTrelloBoard(t *testing.T) {
+	f := newFixture()
+
+	trello := map[string]any{
+		"name":    "Roadmap",
+		"labels":  []any{map[string]any{"id": "l1", "name": "", "color": "green"}},
+		"lists":   []any{map[string]any{"id": "list", "name": "To do", "pos": 16384}},
+		"cards":   []any{map[string]any{"id": "card", "idList": "list", "name": "Card", "desc": "Text", "pos": 65535, "due": "2024-05-01T12:00:00.000Z", "idLabels": []string{"l1"}, "badges": map[string]any{"attachments": 2}}},
+		"members": []any{map[string]any{"id": "m1"}},
+		"actions": []any{map[string]any{"type": "commentCard"}, map[string]any{"type": "updateCard"}},
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		body   any
+		status int
+	}{
+		{name: "import", query: "", body: trello, status: http.StatusCreated},
+		{name: "dry run", query: "?dry_run=true", body: trello, status: http.StatusOK},
+		{name: "invalid dry run flag", query: "?dry_run=maybe", body: trello, status: http.StatusBadRequest},
+		{name: "card on an unknown list", query: "?dry_run=true", body: map[string]any{"name": "Roadmap", "cards": []any{map[string]any{"id": "card", "idList": "gone", "name": "Card"}}}, status: http.StatusBadRequest},
+		{name: "not json", query: "", body: "board", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodPost, "/api/v1/boards/import/trello"+tt.query, tt.body, &identity.Caller{UserID: f.otherID})
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("summary", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/boards/import/trello?dry_run=true", trello, &identity.Caller{UserID: f.otherID})
+
+		var summary dto.ImportSummary
+		assert.Nil(t, json.NewDecoder(rec.Body).Decode(&summary))
+		assert.Nil(t, summary.Board)
+		assert.Equal(t, dto.ImportSummary{Title: "Roadmap", DryRun: true, Labels: 1, Columns: 1, Cards: 1, Skipped: dto.ImportSkipped{Attachments: 2, Members: 1, Comments: 1}}, summary)
+	})
+
+	t.Run("no caller identity", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/boards/import/trello", trello, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestRoutesCardAssignees(t *testing.T) {
+	f := newFixture()
+
+	owner := &identity.Caller{UserID: f.ownerID}
+	assignees := "/api/v1/cards/" + f.card.ID.String() + "/assignees/"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		status int
+	}{
+		{
+			name:   "assign a pending member",
+			method: http.MethodPut,
+			path:   assignees + f.pendingID.String(),
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "assign a stranger",
+			method: http.MethodPut,
+			path:   assignees + uuid.New().String(),
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "assign the owner",
+			method: http.MethodPut,
+			path:   assignees + f.ownerID.String(),
+			status: http.StatusOK,
+		},
+		{
+			name:   "unassign a stranger",
+			method: http.MethodDelete,
+			path:   assignees + uuid.New().String(),
+			status: http.StatusOK,
+		},
+		{
+			name:   "invalid user id",
+			method: http.MethodPut,
+			path:   assignees + "user",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(tt.method, tt.path, nil, owner)
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("cards come with assignees", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards/"+f.card.ID.String(), nil, owner)
+
+		var card struct {
+			AssigneeIDs []uuid.UUID `json:"assignee_ids"`
+		}
+		json.NewDecoder(rec.Body).Decode(&card)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []uuid.UUID{f.members[entity.RoleEditor]}, card.AssigneeIDs)
+	})
+}
+
+// Attachments can only be deleted by their uploader or an admin, and their
+// content is checked rather than trusted
+func TestRoutesAttachments(t *testing.T) {
+	f := newFixture()
+
+	uploader := &identity.Caller{UserID: f.members[entity.RoleEditor]}
+	owner := &identity.Caller{UserID: f.ownerID}
+	viewer := &identity.Caller{UserID: f.members[entity.RoleViewer]}
+	admin := &identity.Caller{UserID: uuid.New(), Role: identity.RoleAdmin}
+
+	upload := func(filename string, content []byte, length int64) *httptest.ResponseRecorder {
+		path := "/api/v1/cards/" + f.card.ID.String() + "/attachments?filename=" + url.QueryEscape(filename)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(content))
+		req.ContentLength = length
+		req.Header.Set(middleware.UserIDHeader, uploader.UserID.String())
+
+		rec := httptest.NewRecorder()
+		f.router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("content type is sniffed and directories are dropped", func(t *testing.T) {
+		png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+		rec := upload("../../screenshot.txt", png, int64(len(png)))
+
+		var attachment struct {
+			Filename    string `json:"filename"`
+			ContentType string `json:"content_type"`
+			Size        int64  `json:"size"`
+		}
+		json.NewDecoder(rec.Body).Decode(&attachment)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "screenshot.txt", attachment.Filename)
+		assert.Equal(t, "image/png", attachment.ContentType)
+		assert.Equal(t, int64(len(png)), attachment.Size)
+	})
+
+	uploads := []struct {
+		name     string
+		filename string
+		content  []byte
+		length   int64
+		want     int
+	}{
+		{"too large", "big.log", []byte("x"), 64 << 20, http.StatusRequestEntityTooLarge},
+		{"unknown length", "log.txt", []byte("x"), -1, http.StatusLengthRequired},
+		{"empty", "log.txt", nil, 0, http.StatusBadRequest},
+		{"no filename", "", []byte("x"), 1, http.StatusBadRequest},
+	}
+
+	for _, tt := range uploads {
+		t.Run("upload "+tt.name, func(t *testing.T) {
+			rec := upload(tt.filename, tt.content, tt.length)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("download is served as attachment", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/attachments/"+f.attachment.ID.String(), nil, viewer)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "Log line", rec.Body.String())
+		assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=log.txt", rec.Header().Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("card comes with attachments", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards/"+f.card.ID.String(), nil, viewer)
+
+		var card struct {
+			Attachments []struct {
+				ID       uuid.UUID `json:"id"`
+				Filename string    `json:"filename"`
+			} `json:"attachments"`
+		}
+		json.NewDecoder(rec.Body).Decode(&card)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, card.Attachments, 1) {
+			assert.Equal(t, f.attachment.ID, card.Attachments[0].ID)
+			assert.Equal(t, "log.txt", card.Attachments[0].Filename)
+		}
+	})
+
+	deletes := []struct {
+		name   string
+		caller *identity.Caller
+		want   int
+	}{
+		{"uploader deletes attachment", uploader, http.StatusOK},
+		{"admin deletes attachment", admin, http.StatusOK},
+		{"board owner deletes attachment of another user", owner, http.StatusForbidden},
+		{"viewer deletes attachment", viewer, http.StatusForbidden},
+	}
+
+	for _, tt := range deletes {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodDelete, "/api/v1/attachments/"+f.attachment.ID.String(), nil, tt.caller)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestRoutesVersions(t *testing.T) {
+	f := newFixture()
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	update := func(path string, body any, ifMatch string) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+
+		req := httptest.NewRequest(http.MethodPut, path, &buf)
+		req.Header.Set(middleware.UserIDHeader, owner.UserID.String())
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		f.router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	gets := []struct {
+		name string
+		path string
+	}{
+		{name: "board", path: "/api/v1/boards/" + f.board.ID.String()},
+		{name: "column", path: "/api/v1/columns/" + f.column.ID.String()},
+		{name: "card", path: "/api/v1/cards/" + f.card.ID.String()},
+	}
+
+	for _, tt := range gets {
+		t.Run("get "+tt.name, func(t *testing.T) {
+			rec := f.do(http.MethodGet, tt.path, nil, owner)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
+
+			var body struct{ Version int }
+			json.NewDecoder(rec.Body).Decode(&body)
+			assert.Equal(t, 1, body.Version)
+		})
+	}
+
+	updates := []struct {
+		name    string
+		path    string
+		body    map[string]any
+		ifMatch string
+		status  int
+	}{
+		{name: "board with If-Match", path: "/api/v1/boards", body: map[string]any{"id": f.board.ID, "title": "Title"}, ifMatch: `"1"`, status: http.StatusOK},
+		{name: "board with a stale If-Match", path: "/api/v1/boards", body: map[string]any{"id": f.board.ID, "title": "Title"}, ifMatch: `"7"`, status: http.StatusPreconditionFailed},
+		{name: "board with any version", path: "/api/v1/boards", body: map[string]any{"id": f.board.ID, "title": "Title"}, ifMatch: "*", status: http.StatusOK},
+		{name: "board with a weak tag", path: "/api/v1/boards", body: map[string]any{"id": f.board.ID, "title": "Title"}, ifMatch: `W/"1"`, status: http.StatusBadRequest},
+		{name: "column with a stale version", path: "/api/v1/columns", body: map[string]any{"id": f.column.ID, "title": "Title", "version": 7}, status: http.StatusConflict},
+		{name: "column with a stale If-Match", path: "/api/v1/columns", body: map[string]any{"id": f.column.ID, "title": "Title"}, ifMatch: `"7"`, status: http.StatusPreconditionFailed},
+		{name: "card with the version", path: "/api/v1/cards", body: map[string]any{"id": f.card.ID, "title": "Title", "version": 1}, status: http.StatusOK},
+		{name: "card with a stale version", path: "/api/v1/cards", body: map[string]any{"id": f.card.ID, "title": "Title", "version": 7}, status: http.StatusConflict},
+		{name: "card with If-Match over the version", path: "/api/v1/cards", body: map[string]any{"id": f.card.ID, "title": "Title", "version": 7}, ifMatch: `"1"`, status: http.StatusOK},
+		{name: "card with a broken If-Match", path: "/api/v1/cards", body: map[string]any{"id": f.card.ID, "title": "Title"}, ifMatch: "1", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range updates {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := update(tt.path, tt.body, tt.ifMatch)
+
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+
+			switch tt.status {
+			case http.StatusOK:
+				// The mocked repository leaves the version where it was
+				assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
+			case http.StatusConflict, http.StatusPreconditionFailed:
+				// The current state comes back to merge the change into
+				assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
+
+				var body map[string]any
+				json.NewDecoder(rec.Body).Decode(&body)
+				assert.Equal(t, tt.body["id"].(uuid.UUID).String(), body["id"])
+				assert.Equal(t, float64(1), body["version"])
+			}
+		})
+	}
+}
+
+func TestRoutesPages(t *testing.T) {
+	f := newFixture()
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	t.Run("boards on a single page", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/boards?cursor=&limit=2&user_id="+f.ownerID.String(), nil, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var page dto.BoardPage
+		json.NewDecoder(rec.Body).Decode(&page)
+		assert.Len(t, page.Boards, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("columns with a next page", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/columns?cursor=&limit=1&board_id="+f.board.ID.String(), nil, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var page dto.ColumnPage
+		json.NewDecoder(rec.Body).Decode(&page)
+		assert.Len(t, page.Columns, 1)
+
+		next, err := dto.DecodeCursor(page.NextCursor)
+		assert.Nil(t, err)
+		assert.Equal(t, f.column.ID, next.ID)
+	})
+
+	t.Run("cards after a cursor", func(t *testing.T) {
+		cursor := dto.EncodeCursor(&entity.Cursor{Position: 1, ID: uuid.New()})
+		rec := f.do(http.MethodGet, "/api/v1/cards?limit=1&cursor="+cursor+"&column_id="+f.column.ID.String(), nil, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var page dto.CardPage
+		json.NewDecoder(rec.Body).Decode(&page)
+		assert.Len(t, page.Cards, 1)
+		assert.NotEmpty(t, page.NextCursor)
+	})
+
+	t.Run("broken cursor", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards?cursor=nope&column_id="+f.column.ID.String(), nil, owner)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("offsets without a cursor", func(t *testing.T) {
+		rec := f.do(http.MethodGet, "/api/v1/cards?column_id="+f.column.ID.String(), nil, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var cards []dto.Card
+		assert.Nil(t, json.NewDecoder(rec.Body).Decode(&cards))
+	})
+}
+
+func TestRoutesBatch(t *testing.T) {
+	f := newFixture()
+	owner := &identity.Caller{UserID: f.ownerID}
+
+	t.Run("best effort", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/batch", map[string]any{
+			"mode": "best_effort",
+			"operations": []map[string]any{
+				{"op": "create", "type": "column", "user_id": f.ownerID, "board_id": f.board.ID, "title": "New"},
+				{"op": "update", "type": "card", "id": f.card.ID, "title": "Title", "version": 7},
+				{"op": "delete", "type": "card", "id": f.card.ID},
+			},
+		}, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp dto.BatchResponse
+		json.NewDecoder(rec.Body).Decode(&resp)
+		assert.Equal(t, "best_effort", resp.Mode)
+		assert.Len(t, resp.Results, 3)
+		assert.Equal(t, "applied", resp.Results[0].Status)
+		assert.NotEqual(t, uuid.Nil, resp.Results[0].ID)
+		assert.Equal(t, "failed", resp.Results[1].Status)
+		assert.Equal(t, http.StatusConflict, resp.Results[1].Code)
+		assert.NotEmpty(t, resp.Results[1].Error)
+		assert.Equal(t, "applied", resp.Results[2].Status)
+	})
+
+	t.Run("atomic by default", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/batch", map[string]any{
+			"operations": []map[string]any{
+				{"op": "delete", "type": "card", "id": f.card.ID},
+				{"op": "delete", "type": "column", "id": uuid.New()},
+			},
+		}, owner)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp dto.BatchResponse
+		json.NewDecoder(rec.Body).Decode(&resp)
+		assert.Equal(t, "atomic", resp.Mode)
+		assert.Equal(t, "rolled_back", resp.Results[0].Status)
+		assert.Equal(t, "failed", resp.Results[1].Status)
+		assert.Equal(t, http.StatusNotFound, resp.Results[1].Code)
+	})
+
+	t.Run("invalid operation", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/batch", map[string]any{
+			"operations": []map[string]any{{"op": "move", "type": "board", "id": f.board.ID}},
+		}, owner)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("no caller", func(t *testing.T) {
+		rec := f.do(http.MethodPost, "/api/v1/batch", map[string]any{
+			"operations": []map[string]any{{"op": "delete", "type": "card", "id": f.card.ID}},
+		}, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
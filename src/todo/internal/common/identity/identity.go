@@ -0,0 +1,32 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const RoleAdmin = "admin"
+
+type contextKey string
+
+const callerKey contextKey = "caller"
+
+// Caller is the user on whose behalf a request is made, as forwarded by the aggregator
+type Caller struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+func (c Caller) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey, caller)
+}
+
+func FromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey).(Caller)
+	return caller, ok
+}
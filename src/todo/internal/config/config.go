@@ -23,14 +23,66 @@ type PaginationConfig struct {
 }
 
 type TodoConfig struct {
-	Path          string         `toml:"path"`
-	ContainerName string         `toml:"container_name"`
-	BaseURL       string         `toml:"base_url"`
-	Database      string         `toml:"database"`
-	LocalPort     int            `toml:"local_port"`
-	ExposedPort   int            `toml:"exposed_port"`
-	Log           LogConfig      `toml:"log"`
-	Postgres      PostgresConfig `toml:"postgres"`
+	Path          string            `toml:"path"`
+	ContainerName string            `toml:"container_name"`
+	BaseURL       string            `toml:"base_url"`
+	Database      string            `toml:"database"`
+	LocalPort     int               `toml:"local_port"`
+	ExposedPort   int               `toml:"exposed_port"`
+	Log           LogConfig         `toml:"log"`
+	Postgres      PostgresConfig    `toml:"postgres"`
+	Reminder      ReminderConfig    `toml:"reminder"`
+	Trash         TrashConfig       `toml:"trash"`
+	Attachments   AttachmentsConfig `toml:"attachments"`
+	Events        EventsConfig      `toml:"events"`
+	Outbox        OutboxConfig      `toml:"outbox"`
+}
+
+type ReminderConfig struct {
+	Enabled           bool   `toml:"enabled"`
+	IntervalSec       int    `toml:"interval_sec"`
+	LeadSec           int    `toml:"lead_sec"`
+	Sink              string `toml:"sink"`
+	WebhookURL        string `toml:"webhook_url"`
+	WebhookTimeoutSec int    `toml:"webhook_timeout_sec"`
+}
+
+// TrashConfig sets how long deleted items can be restored; zero retention
+// never purges them
+type TrashConfig struct {
+	RetentionDays    int `toml:"retention_days"`
+	PurgeIntervalSec int `toml:"purge_interval_sec"`
+}
+
+// EventsConfig sets how many events are kept for subscribers resuming a
+// board's change feed and how often an idle stream sends a heartbeat
+type EventsConfig struct {
+	Backlog      int `toml:"backlog"`
+	HeartbeatSec int `toml:"heartbeat_sec"`
+}
+
+// OutboxConfig sets how often the relay delivers the domain events of the
+// outbox, how many it takes at a time and how it backs off from an event
+// that fails
+type OutboxConfig struct {
+	IntervalMs   int `toml:"interval_ms"`
+	BatchSize    int `toml:"batch_size"`
+	RetryBaseSec int `toml:"retry_base_sec"`
+	RetryMaxSec  int `toml:"retry_max_sec"`
+}
+
+type AttachmentsConfig struct {
+	Store     string   `toml:"store"`
+	LocalPath string   `toml:"local_path"`
+	S3        S3Config `toml:"s3"`
+}
+
+type S3Config struct {
+	Endpoint  string `toml:"endpoint"`
+	Region    string `toml:"region"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"access_key"`
+	SecretKey string `toml:"secret_key"`
 }
 
 type PostgresConfig struct {
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+type Activity struct {
+	ID         uuid.UUID     `json:"id"`
+	BoardIDs   []uuid.UUID   `json:"board_ids"`
+	TargetType string        `json:"target_type"`
+	TargetID   uuid.UUID     `json:"target_id"`
+	Action     string        `json:"action"`
+	ActorID    uuid.UUID     `json:"actor_id"`
+	Changes    []FieldChange `json:"changes"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func ToActivityDTO(activity *entity.Activity) Activity {
+	changes := make([]FieldChange, len(activity.Changes))
+	for i, change := range activity.Changes {
+		changes[i] = FieldChange(change)
+	}
+
+	return Activity{
+		ID:         activity.ID,
+		BoardIDs:   activity.BoardIDs,
+		TargetType: string(activity.TargetType),
+		TargetID:   activity.TargetID,
+		Action:     string(activity.Action),
+		ActorID:    activity.ActorID,
+		Changes:    changes,
+		CreatedAt:  activity.CreatedAt,
+	}
+}
+
+func ToActivityDTOs(activities []entity.Activity) []Activity {
+	activityDTOs := make([]Activity, len(activities))
+	for i, activity := range activities {
+		activityDTOs[i] = ToActivityDTO(&activity)
+	}
+	return activityDTOs
+}
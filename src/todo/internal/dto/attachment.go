@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	CardID      uuid.UUID `json:"card_id"`
+	UploaderID  uuid.UUID `json:"uploader_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func ToAttachmentDTO(attachment *entity.Attachment) Attachment {
+	return Attachment{
+		ID:          attachment.ID,
+		CardID:      attachment.CardID,
+		UploaderID:  attachment.UploaderID,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func ToAttachmentDTOs(attachments []entity.Attachment) []Attachment {
+	attachmentDTOs := make([]Attachment, len(attachments))
+	for i, attachment := range attachments {
+		attachmentDTOs[i] = ToAttachmentDTO(&attachment)
+	}
+	return attachmentDTOs
+}
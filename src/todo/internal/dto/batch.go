@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type BatchRequest struct {
+	// Mode is atomic, the default, or best_effort
+	Mode       string           `json:"mode,omitempty"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation is one step of a batch. Op is create, update, move or
+// delete and Type is board, column or card; the other fields are those of
+// the single request doing the same.
+type BatchOperation struct {
+	Op          string     `json:"op"`
+	Type        string     `json:"type"`
+	ID          uuid.UUID  `json:"id,omitempty"`
+	UserID      uuid.UUID  `json:"user_id,omitempty"`
+	BoardID     uuid.UUID  `json:"board_id,omitempty"`
+	ColumnID    uuid.UUID  `json:"column_id,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	BeforeID    uuid.UUID  `json:"before_id,omitempty"`
+	AfterID     uuid.UUID  `json:"after_id,omitempty"`
+	// Version is the version an update is based on; zero is any version
+	Version int `json:"version,omitempty"`
+}
+
+func ToBatchOpEntity(op BatchOperation) entity.BatchOp {
+	batchOp := entity.BatchOp{
+		Action:    entity.ActivityAction(op.Op),
+		Target:    entity.ActivityTarget(op.Type),
+		ID:        op.ID,
+		Placement: entity.Placement{BeforeID: op.BeforeID, AfterID: op.AfterID},
+		Move: entity.CardMove{
+			ColumnID:  op.ColumnID,
+			Placement: entity.Placement{BeforeID: op.BeforeID, AfterID: op.AfterID},
+		},
+	}
+
+	// Only creates and updates carry an item
+	if batchOp.Action != entity.ActivityCreate && batchOp.Action != entity.ActivityUpdate {
+		return batchOp
+	}
+
+	switch batchOp.Target {
+	case entity.ActivityTargetBoard:
+		batchOp.Board = &entity.Board{
+			ID:      op.ID,
+			UserID:  op.UserID,
+			Title:   op.Title,
+			Version: op.Version,
+		}
+	case entity.ActivityTargetColumn:
+		batchOp.Column = &entity.Column{
+			ID:       op.ID,
+			UserID:   op.UserID,
+			BoardID:  op.BoardID,
+			Title:    op.Title,
+			Position: op.Position,
+			Version:  op.Version,
+		}
+	case entity.ActivityTargetCard:
+		batchOp.Card = &entity.Card{
+			ID:          op.ID,
+			UserID:      op.UserID,
+			ColumnID:    op.ColumnID,
+			Title:       op.Title,
+			Description: op.Description,
+			Position:    op.Position,
+			StartDate:   op.StartDate,
+			DueDate:     op.DueDate,
+			Version:     op.Version,
+		}
+	}
+
+	return batchOp
+}
+
+func ToBatchOpEntities(ops []BatchOperation) []entity.BatchOp {
+	batchOps := make([]entity.BatchOp, len(ops))
+	for i, op := range ops {
+		batchOps[i] = ToBatchOpEntity(op)
+	}
+	return batchOps
+}
+
+// BatchResult is the outcome of the operation at the same index of the
+// batch. Status is applied, failed, rolled_back or skipped; Code and Error
+// are only set on the operation that failed.
+type BatchResult struct {
+	Status  string    `json:"status"`
+	ID      uuid.UUID `json:"id,omitempty"`
+	Version int       `json:"version,omitempty"`
+	Code    int       `json:"code,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Mode    string        `json:"mode"`
+	Results []BatchResult `json:"results"`
+}
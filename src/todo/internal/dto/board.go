@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"time"
 	"todo/internal/entity"
 
 	"github.com/google/uuid"
@@ -12,18 +13,49 @@ type CreateBoardRequest struct {
 }
 
 type Board struct {
-	ID    uuid.UUID `json:"id"`
-	Title string    `json:"title"`
+	ID         uuid.UUID `json:"id"`
+	Title      string    `json:"title"`
+	IsPublic   bool      `json:"is_public"`
+	IsTemplate bool      `json:"is_template"`
+	// ArchivedAt is only sent for archived boards
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Version is the version the board is at; in an update it is the
+	// version the change is based on, and zero or missing is any version
+	Version int `json:"version"`
 }
 
 type UpdateBoardRequest struct {
 	Board
 }
 
+// CloneBoardRequest copies a board; an empty title keeps the title of the
+// source board, and template saves the copy as a template
+type CloneBoardRequest struct {
+	Title        string `json:"title,omitempty"`
+	ExcludeCards bool   `json:"exclude_cards,omitempty"`
+	Template     bool   `json:"template,omitempty"`
+}
+
+func ToCloneOptionsEntity(r CloneBoardRequest) entity.CloneOptions {
+	return entity.CloneOptions{
+		Title:        r.Title,
+		ExcludeCards: r.ExcludeCards,
+		Template:     r.Template,
+	}
+}
+
+type SetBoardPublicRequest struct {
+	IsPublic bool `json:"is_public"`
+}
+
 func ToBoardDTO(board *entity.Board) Board {
 	return Board{
-		ID:    board.ID,
-		Title: board.Title,
+		ID:         board.ID,
+		Title:      board.Title,
+		IsPublic:   board.IsPublic,
+		IsTemplate: board.IsTemplate,
+		ArchivedAt: board.ArchivedAt,
+		Version:    board.Version,
 	}
 }
 
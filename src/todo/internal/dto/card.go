@@ -8,21 +8,33 @@ import (
 )
 
 type CreateCardRequest struct {
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
+	UserID      uuid.UUID  `json:"user_id"`
+	ColumnID    uuid.UUID  `json:"column_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Position    float64    `json:"position"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
 }
 
 type Card struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	ColumnID    uuid.UUID `json:"column_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Position    float64   `json:"position"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ColumnID    uuid.UUID          `json:"column_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description,omitempty"`
+	Position    float64            `json:"position"`
+	StartDate   *time.Time         `json:"start_date,omitempty"`
+	DueDate     *time.Time         `json:"due_date,omitempty"`
+	Version     int                `json:"version"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Labels      []Label            `json:"labels,omitempty"`
+	Checklist   *ChecklistProgress `json:"checklist,omitempty"`
+	AssigneeIDs []uuid.UUID        `json:"assignee_ids,omitempty"`
+	Attachments []Attachment       `json:"attachments,omitempty"`
+	// OverWIPLimit warns that the card was just created in or moved to a
+	// column that was already at its soft WIP limit
+	OverWIPLimit bool `json:"over_wip_limit,omitempty"`
 }
 
 type UpdateCardRequest struct {
@@ -31,17 +43,34 @@ type UpdateCardRequest struct {
 	Title       string    `json:"title,omitempty"`
 	Description string    `json:"description,omitempty"`
 	Position    float64   `json:"position,omitempty"`
+	// Version is the version the change is based on; zero is any version
+	Version int `json:"version,omitempty"`
+}
+
+// SetCardDatesRequest replaces both dates of a card; a missing or null date
+// is cleared
+type SetCardDatesRequest struct {
+	StartDate *time.Time `json:"start_date"`
+	DueDate   *time.Time `json:"due_date"`
 }
 
 func ToCardDTO(card *entity.Card) Card {
 	return Card{
-		ID:          card.ID,
-		UserID:      card.UserID,
-		ColumnID:    card.ColumnID,
-		Title:       card.Title,
-		Description: card.Description,
-		Position:    card.Position,
-		CreatedAt:   card.CreatedAt,
+		ID:           card.ID,
+		UserID:       card.UserID,
+		ColumnID:     card.ColumnID,
+		Title:        card.Title,
+		Description:  card.Description,
+		Position:     card.Position,
+		StartDate:    card.StartDate,
+		DueDate:      card.DueDate,
+		Version:      card.Version,
+		CreatedAt:    card.CreatedAt,
+		Labels:       ToLabelDTOs(card.Labels),
+		Checklist:    ToChecklistProgressDTO(card.Progress),
+		AssigneeIDs:  card.Assignees,
+		Attachments:  ToAttachmentDTOs(card.Attachments),
+		OverWIPLimit: card.OverWIPLimit,
 	}
 }
 
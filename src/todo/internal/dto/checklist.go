@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type Checklist struct {
+	ID       uuid.UUID       `json:"id"`
+	CardID   uuid.UUID       `json:"card_id"`
+	Title    string          `json:"title"`
+	Position float64         `json:"position"`
+	Items    []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID `json:"id"`
+	ChecklistID uuid.UUID `json:"checklist_id"`
+	Text        string    `json:"text"`
+	Done        bool      `json:"done"`
+	Position    float64   `json:"position"`
+}
+
+// ChecklistProgress is shown next to a card in list views
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+type CreateChecklistRequest struct {
+	Title string `json:"title"`
+}
+
+type UpdateChecklistRequest struct {
+	Title string `json:"title"`
+}
+
+type CreateChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+type UpdateChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+type SetChecklistItemDoneRequest struct {
+	Done bool `json:"done"`
+}
+
+func ToChecklistDTO(checklist *entity.Checklist) Checklist {
+	return Checklist{
+		ID:       checklist.ID,
+		CardID:   checklist.CardID,
+		Title:    checklist.Title,
+		Position: checklist.Position,
+		Items:    ToChecklistItemDTOs(checklist.Items),
+	}
+}
+
+func ToChecklistDTOs(checklists []entity.Checklist) []Checklist {
+	checklistDTOs := make([]Checklist, len(checklists))
+	for i, checklist := range checklists {
+		checklistDTOs[i] = ToChecklistDTO(&checklist)
+	}
+	return checklistDTOs
+}
+
+func ToChecklistItemDTO(item *entity.ChecklistItem) ChecklistItem {
+	return ChecklistItem{
+		ID:          item.ID,
+		ChecklistID: item.ChecklistID,
+		Text:        item.Text,
+		Done:        item.Done,
+		Position:    item.Position,
+	}
+}
+
+func ToChecklistItemDTOs(items []entity.ChecklistItem) []ChecklistItem {
+	itemDTOs := make([]ChecklistItem, len(items))
+	for i, item := range items {
+		itemDTOs[i] = ToChecklistItemDTO(&item)
+	}
+	return itemDTOs
+}
+
+// ToChecklistProgressDTO leaves the progress out for cards without checklist
+// items
+func ToChecklistProgressDTO(progress entity.ChecklistProgress) *ChecklistProgress {
+	if progress.Total == 0 {
+		return nil
+	}
+
+	return &ChecklistProgress{
+		Done:  progress.Done,
+		Total: progress.Total,
+	}
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"time"
 	"todo/internal/entity"
 
 	"github.com/google/uuid"
@@ -11,6 +12,9 @@ type CreateColumnRequest struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	// WIPLimit is the most cards the column takes; zero is no limit
+	WIPLimit  int  `json:"wip_limit,omitempty"`
+	WIPStrict bool `json:"wip_strict,omitempty"`
 }
 
 type Column struct {
@@ -18,20 +22,41 @@ type Column struct {
 	BoardID  uuid.UUID `json:"board_id"`
 	Title    string    `json:"title"`
 	Position float64   `json:"position"`
+	// ArchivedAt is only sent for archived columns
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// WIPLimit is only sent for columns with a limit
+	WIPLimit  int  `json:"wip_limit,omitempty"`
+	WIPStrict bool `json:"wip_strict,omitempty"`
+	CardCount int  `json:"card_count"`
+	Version   int  `json:"version"`
 }
 
 type UpdateColumnRequest struct {
 	ID       uuid.UUID `json:"id"`
 	Title    string    `json:"title,omitempty"`
 	Position float64   `json:"position,omitempty"`
+	// Version is the version the change is based on; zero is any version
+	Version int `json:"version,omitempty"`
+}
+
+// SetColumnWIPLimitRequest replaces the WIP limit of a column; a zero or
+// missing limit lifts it
+type SetColumnWIPLimitRequest struct {
+	Limit  int  `json:"limit"`
+	Strict bool `json:"strict"`
 }
 
 func ToColumnDTO(column *entity.Column) Column {
 	return Column{
-		ID:       column.ID,
-		BoardID:  column.BoardID,
-		Title:    column.Title,
-		Position: column.Position,
+		ID:         column.ID,
+		BoardID:    column.BoardID,
+		Title:      column.Title,
+		Position:   column.Position,
+		ArchivedAt: column.ArchivedAt,
+		WIPLimit:   column.WIPLimit.Max,
+		WIPStrict:  column.WIPLimit.Strict,
+		CardCount:  column.CardCount,
+		Version:    column.Version,
 	}
 }
 
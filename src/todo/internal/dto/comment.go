@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type Comment struct {
+	ID        uuid.UUID  `json:"id"`
+	CardID    uuid.UUID  `json:"card_id"`
+	AuthorID  uuid.UUID  `json:"author_id"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+func ToCommentDTO(comment *entity.Comment) Comment {
+	return Comment{
+		ID:        comment.ID,
+		CardID:    comment.CardID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		EditedAt:  comment.EditedAt,
+	}
+}
+
+func ToCommentDTOs(comments []entity.Comment) []Comment {
+	commentDTOs := make([]Comment, len(comments))
+	for i, comment := range comments {
+		commentDTOs[i] = ToCommentDTO(&comment)
+	}
+	return commentDTOs
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// EventReset is the type of the event telling a subscriber to reload the
+// board, other events are typed "<target>.<action>"
+const EventReset = "reset"
+
+type Event struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	BoardID uuid.UUID `json:"board_id"`
+	Change  *Activity `json:"change,omitempty"`
+}
+
+func ToEventDTO(event *entity.Event) Event {
+	if event.Reset {
+		return Event{ID: event.ID, Type: EventReset, BoardID: event.BoardID}
+	}
+
+	change := ToActivityDTO(&event.Change)
+
+	return Event{
+		ID:      event.ID,
+		Type:    change.TargetType + "." + change.Action,
+		BoardID: event.BoardID,
+		Change:  &change,
+	}
+}
@@ -0,0 +1,177 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// BoardExport is the portable JSON document of a board. Version tells how
+// to read the rest of it; label ids only tie the labels to the cards.
+type BoardExport struct {
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Board      ExportedBoard    `json:"board"`
+	Labels     []ExportedLabel  `json:"labels"`
+	Columns    []ExportedColumn `json:"columns"`
+}
+
+type ExportedBoard struct {
+	Title      string     `json:"title"`
+	IsTemplate bool       `json:"is_template,omitempty"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type ExportedLabel struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExportedColumn struct {
+	Title      string         `json:"title"`
+	Position   float64        `json:"position"`
+	ArchivedAt *time.Time     `json:"archived_at,omitempty"`
+	WIPLimit   int            `json:"wip_limit,omitempty"`
+	WIPStrict  bool           `json:"wip_strict,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	Cards      []ExportedCard `json:"cards"`
+}
+
+type ExportedCard struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description,omitempty"`
+	Position    float64     `json:"position"`
+	StartDate   *time.Time  `json:"start_date,omitempty"`
+	DueDate     *time.Time  `json:"due_date,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	LabelIDs    []uuid.UUID `json:"label_ids,omitempty"`
+}
+
+func ToBoardExportDTO(export *entity.BoardExport) BoardExport {
+	labels := make([]ExportedLabel, len(export.Labels))
+	for i, label := range export.Labels {
+		labels[i] = ExportedLabel{
+			ID:        label.ID,
+			Name:      label.Name,
+			Color:     label.Color,
+			CreatedAt: label.CreatedAt,
+			UpdatedAt: label.UpdatedAt,
+		}
+	}
+
+	columns := make([]ExportedColumn, len(export.Columns))
+	for i, tree := range export.Columns {
+		cards := make([]ExportedCard, len(tree.Cards))
+		for j, card := range tree.Cards {
+			var labelIDs []uuid.UUID
+			for _, label := range card.Labels {
+				labelIDs = append(labelIDs, label.ID)
+			}
+
+			cards[j] = ExportedCard{
+				Title:       card.Title,
+				Description: card.Description,
+				Position:    card.Position,
+				StartDate:   card.StartDate,
+				DueDate:     card.DueDate,
+				CreatedAt:   card.CreatedAt,
+				UpdatedAt:   card.UpdatedAt,
+				LabelIDs:    labelIDs,
+			}
+		}
+
+		columns[i] = ExportedColumn{
+			Title:      tree.Column.Title,
+			Position:   tree.Column.Position,
+			ArchivedAt: tree.Column.ArchivedAt,
+			WIPLimit:   tree.Column.WIPLimit.Max,
+			WIPStrict:  tree.Column.WIPLimit.Strict,
+			CreatedAt:  tree.Column.CreatedAt,
+			UpdatedAt:  tree.Column.UpdatedAt,
+			Cards:      cards,
+		}
+	}
+
+	return BoardExport{
+		Version:    export.Version,
+		ExportedAt: export.ExportedAt,
+		Board: ExportedBoard{
+			Title:      export.Board.Title,
+			IsTemplate: export.Board.IsTemplate,
+			ArchivedAt: export.Board.ArchivedAt,
+			CreatedAt:  export.Board.CreatedAt,
+			UpdatedAt:  export.Board.UpdatedAt,
+		},
+		Labels:  labels,
+		Columns: columns,
+	}
+}
+
+func ToBoardExportEntity(r BoardExport) entity.BoardExport {
+	labels := make([]entity.Label, len(r.Labels))
+	for i, label := range r.Labels {
+		labels[i] = entity.Label{
+			ID:        label.ID,
+			Name:      label.Name,
+			Color:     label.Color,
+			CreatedAt: label.CreatedAt,
+			UpdatedAt: label.UpdatedAt,
+		}
+	}
+
+	columns := make([]entity.ColumnTree, len(r.Columns))
+	for i, column := range r.Columns {
+		cards := make([]entity.Card, len(column.Cards))
+		for j, card := range column.Cards {
+			cardLabels := make([]entity.Label, len(card.LabelIDs))
+			for k, id := range card.LabelIDs {
+				cardLabels[k] = entity.Label{ID: id}
+			}
+
+			cards[j] = entity.Card{
+				Title:       card.Title,
+				Description: card.Description,
+				Position:    card.Position,
+				StartDate:   card.StartDate,
+				DueDate:     card.DueDate,
+				CreatedAt:   card.CreatedAt,
+				UpdatedAt:   card.UpdatedAt,
+				Labels:      cardLabels,
+			}
+		}
+
+		columns[i] = entity.ColumnTree{
+			Column: entity.Column{
+				Title:      column.Title,
+				Position:   column.Position,
+				ArchivedAt: column.ArchivedAt,
+				WIPLimit:   entity.WIPLimit{Max: column.WIPLimit, Strict: column.WIPStrict},
+				CreatedAt:  column.CreatedAt,
+				UpdatedAt:  column.UpdatedAt,
+			},
+			Cards: cards,
+		}
+	}
+
+	return entity.BoardExport{
+		Version:    r.Version,
+		ExportedAt: r.ExportedAt,
+		Board: entity.Board{
+			Title:      r.Board.Title,
+			IsTemplate: r.Board.IsTemplate,
+			ArchivedAt: r.Board.ArchivedAt,
+			CreatedAt:  r.Board.CreatedAt,
+			UpdatedAt:  r.Board.UpdatedAt,
+		},
+		Labels:  labels,
+		Columns: columns,
+	}
+}
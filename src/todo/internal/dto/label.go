@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type Label struct {
+	ID      uuid.UUID `json:"id"`
+	BoardID uuid.UUID `json:"board_id"`
+	Name    string    `json:"name"`
+	Color   string    `json:"color"`
+}
+
+type CreateLabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type UpdateLabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+func ToLabelDTO(label *entity.Label) Label {
+	return Label{
+		ID:      label.ID,
+		BoardID: label.BoardID,
+		Name:    label.Name,
+		Color:   label.Color,
+	}
+}
+
+func ToLabelDTOs(labels []entity.Label) []Label {
+	labelDTOs := make([]Label, len(labels))
+	for i, label := range labels {
+		labelDTOs[i] = ToLabelDTO(&label)
+	}
+	return labelDTOs
+}
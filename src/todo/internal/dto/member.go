@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type BoardMember struct {
+	BoardID   uuid.UUID `json:"board_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	Accepted  bool      `json:"accepted"`
+	InvitedBy uuid.UUID `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type InviteMemberRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role"`
+}
+
+func ToBoardMemberDTO(member *entity.BoardMember) BoardMember {
+	return BoardMember{
+		BoardID:   member.BoardID,
+		UserID:    member.UserID,
+		Role:      string(member.Role),
+		Accepted:  member.Accepted,
+		InvitedBy: member.InvitedBy,
+		CreatedAt: member.CreatedAt,
+	}
+}
+
+func ToBoardMemberDTOs(members []entity.BoardMember) []BoardMember {
+	memberDTOs := make([]BoardMember, len(members))
+	for i, member := range members {
+		memberDTOs[i] = ToBoardMemberDTO(&member)
+	}
+	return memberDTOs
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursor is what an opaque cursor carries; clients are only meant to pass it
+// back as it is
+type cursor struct {
+	Position  float64   `json:"p,omitempty"`
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// EncodeCursor turns a cursor into the opaque string handed out as
+// next_cursor; there is no next page for a nil cursor
+func EncodeCursor(c *entity.Cursor) string {
+	if c == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(cursor{Position: c.Position, CreatedAt: c.CreatedAt, ID: c.ID})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a cursor handed out by EncodeCursor; an empty string
+// asks for the first page
+func DecodeCursor(s string) (*entity.Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &entity.Cursor{Position: c.Position, CreatedAt: c.CreatedAt, ID: c.ID}, nil
+}
+
+type BoardPage struct {
+	Boards     []Board `json:"boards"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+type ColumnPage struct {
+	Columns    []Column `json:"columns"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+type CardPage struct {
+	Cards      []Card `json:"cards"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
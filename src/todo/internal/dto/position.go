@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// RepositionRequest places a card or a column right before or right after
+// one of its siblings; exactly one of the ids should be set
+type RepositionRequest struct {
+	BeforeID uuid.UUID `json:"before_id,omitempty"`
+	AfterID  uuid.UUID `json:"after_id,omitempty"`
+}
+
+func ToPlacementEntity(req RepositionRequest) entity.Placement {
+	return entity.Placement{
+		BeforeID: req.BeforeID,
+		AfterID:  req.AfterID,
+	}
+}
+
+// MoveCardRequest sends a card to a column, optionally right before or right
+// after one of the cards already there; without an anchor the card goes last
+type MoveCardRequest struct {
+	ColumnID uuid.UUID `json:"column_id"`
+	BeforeID uuid.UUID `json:"before_id,omitempty"`
+	AfterID  uuid.UUID `json:"after_id,omitempty"`
+}
+
+func ToCardMoveEntity(req MoveCardRequest) entity.CardMove {
+	return entity.CardMove{
+		ColumnID: req.ColumnID,
+		Placement: entity.Placement{
+			BeforeID: req.BeforeID,
+			AfterID:  req.AfterID,
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type CardRevision struct {
+	ID           uuid.UUID `json:"id"`
+	CardID       uuid.UUID `json:"card_id"`
+	Number       int       `json:"number"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	RevertedFrom *int      `json:"reverted_from,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DiffLine is a line of a diff; op is one of "equal", "insert" and "delete"
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiff struct {
+	From        CardRevision `json:"from"`
+	To          CardRevision `json:"to"`
+	Title       []DiffLine   `json:"title"`
+	Description []DiffLine   `json:"description"`
+}
+
+func ToCardRevisionDTO(revision *entity.CardRevision) CardRevision {
+	return CardRevision(*revision)
+}
+
+func ToCardRevisionDTOs(revisions []entity.CardRevision) []CardRevision {
+	revisionDTOs := make([]CardRevision, len(revisions))
+	for i, revision := range revisions {
+		revisionDTOs[i] = ToCardRevisionDTO(&revision)
+	}
+	return revisionDTOs
+}
+
+func ToDiffLineDTOs(lines []entity.DiffLine) []DiffLine {
+	lineDTOs := make([]DiffLine, len(lines))
+	for i, line := range lines {
+		lineDTOs[i] = DiffLine{Op: string(line.Op), Text: line.Text}
+	}
+	return lineDTOs
+}
+
+func ToRevisionDiffDTO(diff *entity.RevisionDiff) RevisionDiff {
+	return RevisionDiff{
+		From:        ToCardRevisionDTO(&diff.From),
+		To:          ToCardRevisionDTO(&diff.To),
+		Title:       ToDiffLineDTOs(diff.Title),
+		Description: ToDiffLineDTOs(diff.Description),
+	}
+}
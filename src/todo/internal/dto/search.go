@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type CardMatch struct {
+	Card                Card      `json:"card"`
+	BoardID             uuid.UUID `json:"board_id"`
+	Rank                float64   `json:"rank"`
+	TitleHeadline       string    `json:"title_headline"`
+	DescriptionHeadline string    `json:"description_headline,omitempty"`
+}
+
+func ToCardMatchDTO(match *entity.CardMatch) CardMatch {
+	return CardMatch{
+		Card:                ToCardDTO(&match.Card),
+		BoardID:             match.BoardID,
+		Rank:                match.Rank,
+		TitleHeadline:       match.TitleHeadline,
+		DescriptionHeadline: match.DescriptionHeadline,
+	}
+}
+
+func ToCardMatchDTOs(matches []entity.CardMatch) []CardMatch {
+	matchDTOs := make([]CardMatch, len(matches))
+	for i, match := range matches {
+		matchDTOs[i] = ToCardMatchDTO(&match)
+	}
+	return matchDTOs
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type ShareToken struct {
+	Token     string    `json:"token"`
+	BoardID   uuid.UUID `json:"board_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BoardTree struct {
+	Board
+	Columns []ColumnTree `json:"columns"`
+}
+
+type ColumnTree struct {
+	Column
+	Cards []Card `json:"cards"`
+}
+
+func ToShareTokenDTO(token *entity.ShareToken) ShareToken {
+	return ShareToken{
+		Token:     token.Token,
+		BoardID:   token.BoardID,
+		CreatedAt: token.CreatedAt,
+	}
+}
+
+func ToShareTokenDTOs(tokens []entity.ShareToken) []ShareToken {
+	tokenDTOs := make([]ShareToken, len(tokens))
+	for i, token := range tokens {
+		tokenDTOs[i] = ToShareTokenDTO(&token)
+	}
+	return tokenDTOs
+}
+
+func ToBoardTreeDTO(tree *entity.BoardTree) BoardTree {
+	columns := make([]ColumnTree, len(tree.Columns))
+	for i, column := range tree.Columns {
+		columns[i] = ColumnTree{
+			Column: ToColumnDTO(&column.Column),
+			Cards:  ToCardDTOs(column.Cards),
+		}
+	}
+
+	return BoardTree{
+		Board:   ToBoardDTO(&tree.Board),
+		Columns: columns,
+	}
+}
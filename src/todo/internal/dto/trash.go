@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+type TrashItem struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	DeletedBy uuid.UUID  `json:"deleted_by"`
+	DeletedAt time.Time  `json:"deleted_at"`
+	// OverWIPLimit warns that the restored card took its column past its
+	// soft WIP limit
+	OverWIPLimit bool `json:"over_wip_limit,omitempty"`
+}
+
+func ToTrashItemDTO(item *entity.TrashItem) TrashItem {
+	var parentID *uuid.UUID
+	if item.ParentID != uuid.Nil {
+		parentID = &item.ParentID
+	}
+
+	return TrashItem{
+		ID:           item.ID,
+		Type:         string(item.Type),
+		Title:        item.Title,
+		ParentID:     parentID,
+		BoardID:      item.BoardID,
+		UserID:       item.UserID,
+		DeletedBy:    item.DeletedBy,
+		DeletedAt:    item.DeletedAt,
+		OverWIPLimit: item.OverWIPLimit,
+	}
+}
+
+func ToTrashItemDTOs(items []entity.TrashItem) []TrashItem {
+	itemDTOs := make([]TrashItem, len(items))
+	for i, item := range items {
+		itemDTOs[i] = ToTrashItemDTO(&item)
+	}
+	return itemDTOs
+}
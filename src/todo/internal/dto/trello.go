@@ -0,0 +1,162 @@
+package dto
+
+import (
+	"time"
+	"todo/internal/entity"
+)
+
+// TrelloBoard is a Trello board JSON export as produced by "Print and
+// export" in Trello; fields the importer does not read are left out
+type TrelloBoard struct {
+	Name    string         `json:"name"`
+	Closed  bool           `json:"closed"`
+	Labels  []TrelloLabel  `json:"labels"`
+	Lists   []TrelloList   `json:"lists"`
+	Cards   []TrelloCard   `json:"cards"`
+	Members []TrelloMember `json:"members"`
+	Actions []TrelloAction `json:"actions"`
+}
+
+type TrelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TrelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type TrelloCard struct {
+	ID           string             `json:"id"`
+	ListID       string             `json:"idList"`
+	Name         string             `json:"name"`
+	Desc         string             `json:"desc"`
+	Closed       bool               `json:"closed"`
+	Pos          float64            `json:"pos"`
+	Start        *time.Time         `json:"start"`
+	Due          *time.Time         `json:"due"`
+	LabelIDs     []string           `json:"idLabels"`
+	MemberIDs    []string           `json:"idMembers"`
+	ChecklistIDs []string           `json:"idChecklists"`
+	Attachments  []TrelloAttachment `json:"attachments"`
+	Badges       TrelloBadges       `json:"badges"`
+}
+
+type TrelloAttachment struct {
+	ID string `json:"id"`
+}
+
+// TrelloBadges are the counters Trello shows on a card; they still count the
+// attachments when an export leaves the attachments themselves out
+type TrelloBadges struct {
+	Attachments int `json:"attachments"`
+}
+
+type TrelloMember struct {
+	ID string `json:"id"`
+}
+
+type TrelloAction struct {
+	Type string `json:"type"`
+}
+
+// trelloCommentAction is the type of the actions that hold card comments
+const trelloCommentAction = "commentCard"
+
+func ToTrelloBoardEntity(r TrelloBoard) entity.TrelloBoard {
+	labels := make([]entity.TrelloLabel, len(r.Labels))
+	for i, label := range r.Labels {
+		labels[i] = entity.TrelloLabel{
+			ID:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+
+	lists := make([]entity.TrelloList, len(r.Lists))
+	for i, list := range r.Lists {
+		lists[i] = entity.TrelloList{
+			ID:     list.ID,
+			Name:   list.Name,
+			Closed: list.Closed,
+			Pos:    list.Pos,
+		}
+	}
+
+	cards := make([]entity.TrelloCard, len(r.Cards))
+	for i, card := range r.Cards {
+		cards[i] = entity.TrelloCard{
+			ID:          card.ID,
+			ListID:      card.ListID,
+			Name:        card.Name,
+			Desc:        card.Desc,
+			Closed:      card.Closed,
+			Pos:         card.Pos,
+			Start:       card.Start,
+			Due:         card.Due,
+			LabelIDs:    card.LabelIDs,
+			MemberIDs:   card.MemberIDs,
+			Checklists:  len(card.ChecklistIDs),
+			Attachments: max(len(card.Attachments), card.Badges.Attachments),
+		}
+	}
+
+	comments := 0
+	for _, action := range r.Actions {
+		if action.Type == trelloCommentAction {
+			comments++
+		}
+	}
+
+	return entity.TrelloBoard{
+		Name:     r.Name,
+		Closed:   r.Closed,
+		Labels:   labels,
+		Lists:    lists,
+		Cards:    cards,
+		Members:  len(r.Members),
+		Comments: comments,
+	}
+}
+
+type ImportSummary struct {
+	Title string `json:"title"`
+	// Board is left out on a dry run, when nothing is created
+	Board   *Board        `json:"board,omitempty"`
+	DryRun  bool          `json:"dry_run"`
+	Labels  int           `json:"labels"`
+	Columns int           `json:"columns"`
+	Cards   int           `json:"cards"`
+	Skipped ImportSkipped `json:"skipped"`
+}
+
+type ImportSkipped struct {
+	ArchivedCards int `json:"archived_cards"`
+	Attachments   int `json:"attachments"`
+	Members       int `json:"members"`
+	CardMembers   int `json:"card_members"`
+	Checklists    int `json:"checklists"`
+	Comments      int `json:"comments"`
+}
+
+func ToImportSummaryDTO(summary *entity.ImportSummary) ImportSummary {
+	var board *Board
+	if !summary.DryRun {
+		boardDTO := ToBoardDTO(&summary.Board)
+		board = &boardDTO
+	}
+
+	return ImportSummary{
+		Title:   summary.Board.Title,
+		Board:   board,
+		DryRun:  summary.DryRun,
+		Labels:  summary.Labels,
+		Columns: summary.Columns,
+		Cards:   summary.Cards,
+		Skipped: ImportSkipped(summary.Skipped),
+	}
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActivityAction string
+
+const (
+	ActivityCreate  ActivityAction = "create"
+	ActivityUpdate  ActivityAction = "update"
+	ActivityMove    ActivityAction = "move"
+	ActivityDelete  ActivityAction = "delete"
+	ActivityRestore ActivityAction = "restore"
+	// ActivityArchive and ActivityUnarchive apply to boards and columns
+	ActivityArchive   ActivityAction = "archive"
+	ActivityUnarchive ActivityAction = "unarchive"
+)
+
+type ActivityTarget string
+
+const (
+	ActivityTargetBoard  ActivityTarget = "board"
+	ActivityTargetColumn ActivityTarget = "column"
+	ActivityTargetCard   ActivityTarget = "card"
+)
+
+// FieldChange is the value of a field before and after a change. A nil value
+// means the field was not set, which is always the case before a create and
+// after a delete.
+type FieldChange struct {
+	Field  string
+	Before *string
+	After  *string
+}
+
+// Activity is an entry of the append-only activity log
+type Activity struct {
+	ID uuid.UUID
+	// BoardIDs are the boards whose log shows the entry. A card moved to
+	// another board shows up in the log of both, the board it ends up on
+	// comes first.
+	BoardIDs   []uuid.UUID
+	TargetType ActivityTarget
+	TargetID   uuid.UUID
+	Action     ActivityAction
+	ActorID    uuid.UUID
+	Changes    []FieldChange
+	CreatedAt  time.Time
+}
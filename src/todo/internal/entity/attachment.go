@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment is a file uploaded to a card. Only its metadata is kept in the
+// database, the content lives in a blob store under StorageKey.
+type Attachment struct {
+	ID          uuid.UUID
+	CardID      uuid.UUID
+	UploaderID  uuid.UUID
+	Filename    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,50 @@
+package entity
+
+import "github.com/google/uuid"
+
+type BatchMode string
+
+const (
+	// BatchAtomic applies all operations of a batch or none of them
+	BatchAtomic BatchMode = "atomic"
+	// BatchBestEffort applies every operation it can and undoes only those
+	// that fail
+	BatchBestEffort BatchMode = "best_effort"
+)
+
+// BatchOp is one operation of a batch. Action is create, update, move or
+// delete and Target a board, column or card. Creates and updates carry the
+// item of the target; moves reposition a column by Placement or send a card
+// to another place by Move; deletes only need ID.
+type BatchOp struct {
+	Action    ActivityAction
+	Target    ActivityTarget
+	ID        uuid.UUID
+	Board     *Board
+	Column    *Column
+	Card      *Card
+	Placement Placement
+	Move      CardMove
+}
+
+type BatchStatus string
+
+const (
+	BatchApplied BatchStatus = "applied"
+	BatchFailed  BatchStatus = "failed"
+	// BatchRolledBack operations went through but were undone since a later
+	// operation of an atomic batch failed
+	BatchRolledBack BatchStatus = "rolled_back"
+	// BatchSkipped operations were not run since an earlier operation of an
+	// atomic batch failed
+	BatchSkipped BatchStatus = "skipped"
+)
+
+// BatchResult is the outcome of an operation of a batch, in the order of the
+// operations. ID and Version are those of the item after the operation.
+type BatchResult struct {
+	Status  BatchStatus
+	ID      uuid.UUID
+	Version int
+	Err     error
+}
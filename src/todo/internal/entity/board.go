@@ -7,9 +7,37 @@ import (
 )
 
 type Board struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	Title     string
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Title    string
+	IsPublic bool
+	// IsTemplate marks the boards that new boards are cloned from
+	IsTemplate bool
+	// ArchivedAt is set while the board is archived
+	ArchivedAt *time.Time
+	// Version goes up with every change to the board
+	Version   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// CloneOptions tell what a board clone is made of. The clone always belongs
+// to the caller and gets fresh ids and timestamps.
+type CloneOptions struct {
+	// Title defaults to the title of the source board
+	Title        string
+	ExcludeCards bool
+	// Template makes the clone a template instead of a board
+	Template bool
+}
+
+// BoardTree is a read-only snapshot of a board with all of its columns and cards
+type BoardTree struct {
+	Board   Board
+	Columns []ColumnTree
+}
+
+type ColumnTree struct {
+	Column Column
+	Cards  []Card
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MemberRole string
+
+const (
+	RoleViewer MemberRole = "viewer"
+	RoleEditor MemberRole = "editor"
+	RoleOwner  MemberRole = "owner"
+)
+
+var memberRoleRank = map[MemberRole]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+func (r MemberRole) IsValid() bool {
+	_, ok := memberRoleRank[r]
+	return ok
+}
+
+// Includes reports whether the role grants at least the permissions of other
+func (r MemberRole) Includes(other MemberRole) bool {
+	return r.IsValid() && memberRoleRank[r] >= memberRoleRank[other]
+}
+
+// BoardMember links a user to a board they did not create. Invitations stay
+// pending until the invited user accepts them.
+type BoardMember struct {
+	BoardID   uuid.UUID
+	UserID    uuid.UUID
+	Role      MemberRole
+	Accepted  bool
+	InvitedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
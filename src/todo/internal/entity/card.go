@@ -13,6 +13,25 @@ type Card struct {
 	Title       string
 	Description string
 	Position    float64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	StartDate   *time.Time
+	DueDate     *time.Time
+	// Version goes up with every change to the card
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Labels    []Label
+	Progress  ChecklistProgress
+	Assignees []uuid.UUID
+	// Attachments are only filled in when a single card is requested
+	Attachments []Attachment
+	// OverWIPLimit is set when the card was just created in or moved to a
+	// column already at its soft WIP limit
+	OverWIPLimit bool
+}
+
+// CardDates are the optional start and due dates of a card; a nil date is
+// not set
+type CardDates struct {
+	StartDate *time.Time
+	DueDate   *time.Time
 }
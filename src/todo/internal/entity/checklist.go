@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Checklist is a named list of checkable items on a card. The checklists of a
+// card and the items of a checklist are ordered by ascending position.
+type Checklist struct {
+	ID        uuid.UUID
+	CardID    uuid.UUID
+	Title     string
+	Position  float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Items     []ChecklistItem
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID
+	ChecklistID uuid.UUID
+	Text        string
+	Done        bool
+	Position    float64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ChecklistProgress counts the done items over all checklists of a card
+type ChecklistProgress struct {
+	Done  int
+	Total int
+}
@@ -7,11 +7,27 @@ import (
 )
 
 type Column struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	BoardID   uuid.UUID
-	Title     string
-	Position  float64
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	BoardID  uuid.UUID
+	Title    string
+	Position float64
+	// ArchivedAt is set while the column is archived
+	ArchivedAt *time.Time
+	WIPLimit   WIPLimit
+	// CardCount is the number of cards in the column; it is filled in when
+	// the column is read and not stored
+	CardCount int
+	// Version goes up with every change to the column
+	Version   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// WIPLimit caps the number of cards of a column; a zero Max is no limit. A
+// strict limit turns new cards away from a full column, a soft one lets them
+// in with a warning.
+type WIPLimit struct {
+	Max    int
+	Strict bool
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment is a message in the discussion thread of a card. EditedAt stays nil
+// until the author changes the body for the first time.
+type Comment struct {
+	ID        uuid.UUID
+	CardID    uuid.UUID
+	AuthorID  uuid.UUID
+	Body      string
+	CreatedAt time.Time
+	EditedAt  *time.Time
+}
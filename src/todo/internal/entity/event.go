@@ -0,0 +1,19 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Event is a change to a board, a column or a card as published on the
+// change feed of a board. Events are delivered at least once; a redelivered
+// event carries the same change, whose ID tells the two apart.
+type Event struct {
+	// ID is given by the broker on publishing and orders the events of a
+	// board; a subscriber resumes after the last one it got
+	ID string
+	// Type is the name of the domain event, see OutboxEventType
+	Type    string
+	BoardID uuid.UUID
+	// Reset tells the subscriber that events were lost and the board has to
+	// be reloaded; a reset event carries no change
+	Reset  bool
+	Change Activity
+}
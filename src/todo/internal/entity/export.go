@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// BoardExportVersion is the version of the board export documents this
+// service writes. Documents of a newer version are refused on import.
+// Version 2 added the WIP limits of the columns; a version 1 document
+// imports with no limits.
+const BoardExportVersion = 2
+
+// BoardExport is a complete board that can be imported back as a new board,
+// here or elsewhere. Its ids only tie the labels to the cards inside the
+// export; the import gives everything fresh ids.
+type BoardExport struct {
+	Version    int
+	ExportedAt time.Time
+	Board      Board
+	Labels     []Label
+	// Columns include the archived ones
+	Columns []ColumnTree
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Label belongs to the palette of a board and can be put on any card of it
+type Label struct {
+	ID        uuid.UUID
+	BoardID   uuid.UUID
+	Name      string
+	Color     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CardFilter narrows down the cards of a column; zero fields match any card
+type CardFilter struct {
+	LabelID uuid.UUID
+}
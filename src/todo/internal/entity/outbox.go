@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a domain event waiting to be delivered. It is written in the
+// transaction of the change it describes and removed once delivered.
+type OutboxEvent struct {
+	ID uuid.UUID
+	// Seq is the order the events were written in; the events of an
+	// aggregate are delivered in that order
+	Seq int64
+	// Type names what happened to the aggregate, such as CardMoved
+	Type          string
+	AggregateType ActivityTarget
+	AggregateID   uuid.UUID
+	// BoardID is the board whose feed the event goes to
+	BoardID uuid.UUID
+	Change  Activity
+	// Attempts counts the failed deliveries; the next one is not made before
+	// NextAttemptAt
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+}
+
+// OutboxEventType names the event of an action on a target, like CardCreated
+// for the creation of a card
+func OutboxEventType(target ActivityTarget, action ActivityAction) string {
+	title := func(s string) string {
+		if s == "" {
+			return s
+		}
+		return strings.ToUpper(s[:1]) + s[1:]
+	}
+
+	past := string(action) + "ed"
+	if strings.HasSuffix(string(action), "e") {
+		past = string(action) + "d"
+	}
+
+	return title(string(target)) + title(past)
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor is the last item of a page of a listing. Columns and cards are
+// listed by position, creation time and id, boards by creation time and id;
+// the next page starts right after the cursor, so items added or removed in
+// the meantime do not shift it.
+type Cursor struct {
+	Position  float64
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// PageRequest asks for at most Limit items after After; a nil After asks for
+// the first page
+type PageRequest struct {
+	Limit int
+	After *Cursor
+}
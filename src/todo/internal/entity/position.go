@@ -0,0 +1,34 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Position is the place of a card in its column or of a column on its board.
+// Siblings are ordered by ascending position.
+type Position struct {
+	ID       uuid.UUID
+	Position float64
+}
+
+// Placement puts an item right before or right after one of its siblings.
+// Exactly one of the ids is set.
+type Placement struct {
+	BeforeID uuid.UUID
+	AfterID  uuid.UUID
+}
+
+// Anchor returns the sibling the item is placed next to and whether the item
+// goes before it
+func (p Placement) Anchor() (uuid.UUID, bool) {
+	if p.BeforeID != uuid.Nil {
+		return p.BeforeID, true
+	}
+
+	return p.AfterID, false
+}
+
+// CardMove sends a card to a column, possibly on another board. Without a
+// placement the card goes to the end of the column.
+type CardMove struct {
+	ColumnID  uuid.UUID
+	Placement Placement
+}
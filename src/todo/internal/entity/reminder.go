@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reminder tells that a card is about to become due
+type Reminder struct {
+	CardID   uuid.UUID
+	ColumnID uuid.UUID
+	UserID   uuid.UUID
+	Title    string
+	DueDate  time.Time
+}
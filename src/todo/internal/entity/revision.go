@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardRevision is a snapshot of the title and description of a card, taken
+// every time either of them changes. Revisions of a card are numbered from 1.
+type CardRevision struct {
+	ID          uuid.UUID
+	CardID      uuid.UUID
+	Number      int
+	Title       string
+	Description string
+	AuthorID    uuid.UUID
+	// RevertedFrom is the number of the revision that this one restored, nil
+	// for ordinary edits
+	RevertedFrom *int
+	CreatedAt    time.Time
+}
+
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine is a line of a line-based diff: a line both sides share, a line
+// only the newer side has or a line only the older side has
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// RevisionDiff is the line-based diff between two revisions of a card
+type RevisionDiff struct {
+	From        CardRevision
+	To          CardRevision
+	Title       []DiffLine
+	Description []DiffLine
+}
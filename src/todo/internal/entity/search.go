@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardSearch looks for cards whose title or description matches the query.
+// The query takes the usual web search syntax: quoted phrases, "or" and a
+// leading minus to exclude a word. Zero filters match any card.
+type CardSearch struct {
+	Query   string
+	BoardID uuid.UUID
+	// From and To bound the creation time of the cards to [From, To)
+	From time.Time
+	To   time.Time
+}
+
+// CardMatch is a card found by a search. The headlines are the title and the
+// most relevant fragments of the description with the matched words wrapped
+// in <b></b>.
+type CardMatch struct {
+	Card                Card
+	BoardID             uuid.UUID
+	Rank                float64
+	TitleHeadline       string
+	DescriptionHeadline string
+}
@@ -0,0 +1,13 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShareToken struct {
+	Token     string
+	BoardID   uuid.UUID
+	CreatedAt time.Time
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashItem is a board, column or card that was deleted and can still be
+// restored. Whatever was deleted along with it is restored with it and is not
+// an item of its own.
+type TrashItem struct {
+	ID    uuid.UUID
+	Type  ActivityTarget
+	Title string
+	// ParentID is the board of a column or the column of a card; a board has
+	// no parent
+	ParentID uuid.UUID
+	BoardID  uuid.UUID
+	// UserID is the creator of the item, which for a board is its owner
+	UserID    uuid.UUID
+	DeletedBy uuid.UUID
+	DeletedAt time.Time
+	// OverWIPLimit is set when a restored card took its column past a soft
+	// WIP limit
+	OverWIPLimit bool
+}
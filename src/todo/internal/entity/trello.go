@@ -0,0 +1,71 @@
+package entity
+
+import "time"
+
+// TrelloBoard is the part of a Trello board JSON export the importer reads.
+// Ids are the ones of Trello and only tie the lists, cards and labels
+// together.
+type TrelloBoard struct {
+	Name   string
+	Closed bool
+	Labels []TrelloLabel
+	Lists  []TrelloList
+	Cards  []TrelloCard
+	// Members of the board, which have no counterpart here
+	Members int
+	// Comments are card comments found among the actions of the export
+	Comments int
+}
+
+type TrelloLabel struct {
+	ID   string
+	Name string
+	// Color is a Trello color name like "green" or "sky_dark"; empty for
+	// labels without a color
+	Color string
+}
+
+type TrelloList struct {
+	ID     string
+	Name   string
+	Closed bool
+	Pos    float64
+}
+
+type TrelloCard struct {
+	ID          string
+	ListID      string
+	Name        string
+	Desc        string
+	Closed      bool
+	Pos         float64
+	Start       *time.Time
+	Due         *time.Time
+	LabelIDs    []string
+	MemberIDs   []string
+	Checklists  int
+	Attachments int
+}
+
+// ImportSummary tells what an import created, or would create on a dry run,
+// and what it had to leave out
+type ImportSummary struct {
+	// Board is the created board; on a dry run it is never written
+	Board   Board
+	DryRun  bool
+	Labels  int
+	Columns int
+	Cards   int
+	Skipped ImportSkipped
+}
+
+// ImportSkipped counts the parts of an import that have no counterpart in
+// this service
+type ImportSkipped struct {
+	ArchivedCards int
+	Attachments   int
+	Members       int
+	CardMembers   int
+	Checklists    int
+	Comments      int
+}
@@ -0,0 +1,24 @@
+package feed
+
+import (
+	"context"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// Publisher takes the events of a committed change
+type Publisher interface {
+	Publish(ctx context.Context, events []entity.Event) error
+}
+
+// Broker hands published events over to the subscribers of their board. The
+// in-process broker can be swapped for one backed by an external bus.
+type Broker interface {
+	Publisher
+	// Subscribe streams the events of the board published from now on. With
+	// a lastEventID the events after it are sent first, or a reset event when
+	// they can no longer all be sent. The channel is closed once ctx is done
+	// or the subscriber falls too far behind.
+	Subscribe(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error)
+}
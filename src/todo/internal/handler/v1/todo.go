@@ -1,35 +1,73 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 	"todo/internal/config"
 	"todo/internal/dto"
 	"todo/internal/entity"
+	"todo/internal/repository"
 	"todo/internal/usecase"
+	ucv1 "todo/internal/usecase/v1"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
+const (
+	// maxImportSize bounds the request body of a board import
+	maxImportSize = 10 << 20
+	// maxTrelloImportSize is larger since Trello exports carry the actions
+	// of the board as well
+	maxTrelloImportSize = 50 << 20
+)
+
+var (
+	ErrInvalidUserID          = "invalid user id"
+	ErrInvalidBoardID         = "invalid board id"
+	ErrInvalidColumnID        = "invalid column id"
+	ErrInvalidCardID          = "invalid card id"
+	ErrInvalidLabelID         = "invalid label id"
+	ErrInvalidChecklistID     = "invalid checklist id"
+	ErrInvalidChecklistItemID = "invalid checklist item id"
+	ErrInvalidCommentID       = "invalid comment id"
+	ErrInvalidAttachmentID    = "invalid attachment id"
+	ErrInvalidTrashItemID     = "invalid trash item id"
+	ErrInvalidFromDate        = "invalid <<from>> date"
+	ErrInvalidToDate          = "invalid <<to>> date"
+	ErrInvalidDays            = "invalid number of days"
+	ErrInvalidArchived        = "invalid <<archived>> flag"
+	ErrInvalidDryRun          = "invalid <<dry_run>> flag"
+	ErrInvalidIfMatch         = "invalid If-Match header"
+	ErrInvalidCursor          = "invalid cursor"
+	ErrInvalidRevisionNumber  = "invalid revision number"
+)
+
 var (
-	ErrInvalidUserID   = "invalid user id"
-	ErrInvalidBoardID  = "invalid board id"
-	ErrInvalidColumnID = "invalid column id"
-	ErrInvalidCardID   = "invalid card id"
-	ErrInvalidFromDate = "invalid <<from>> date"
-	ErrInvalidToDate   = "invalid <<to>> date"
+	ErrSharedBoardNotFound  = "shared board not found"
+	ErrSharedCardNotFound   = "shared card not found"
+	ErrReadOnlyShare        = "shared boards are read-only"
+	ErrStreamingUnsupported = "streaming is not supported"
 )
 
 type TodoHandler struct {
 	todoUseCase usecase.TodoUseCase
 	config      config.PaginationConfig
+	// heartbeat is how often an idle event stream sends a comment to keep
+	// the connection open
+	heartbeat time.Duration
 }
 
-func NewTodoHandler(todoUseCase usecase.TodoUseCase, config config.PaginationConfig) *TodoHandler {
-	return &TodoHandler{todoUseCase: todoUseCase, config: config}
+func NewTodoHandler(todoUseCase usecase.TodoUseCase, config config.PaginationConfig, heartbeat time.Duration) *TodoHandler {
+	return &TodoHandler{todoUseCase: todoUseCase, config: config, heartbeat: heartbeat}
 }
 
 func (h *TodoHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
@@ -48,7 +86,7 @@ func (h *TodoHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
 	err := h.todoUseCase.CreateBoard(r.Context(), board)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -67,12 +105,13 @@ func (h *TodoHandler) GetBoardByID(w http.ResponseWriter, r *http.Request) {
 	board, err := h.todoUseCase.GetBoardByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	boardDTO := dto.ToBoardDTO(board)
 
+	setETag(w, board.Version)
 	json.NewEncoder(w).Encode(boardDTO)
 }
 
@@ -103,9 +142,34 @@ func (h *TodoHandler) GetBoardsByUser(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	boards, err := h.todoUseCase.GetBoardsByUser(r.Context(), id, limit, offset)
+	archived, err := archivedFilter(query)
+	if err != nil {
+		http.Error(w, ErrInvalidArchived, http.StatusBadRequest)
+		return
+	}
+
+	// A cursor, even an empty one, switches to pages that carry the cursor
+	// of the next one instead of offsets
+	if _, ok := query["cursor"]; ok {
+		after, err := dto.DecodeCursor(query.Get("cursor"))
+		if err != nil {
+			http.Error(w, ErrInvalidCursor, http.StatusBadRequest)
+			return
+		}
+
+		boards, next, err := h.todoUseCase.GetBoardsPage(r.Context(), id, archived, entity.PageRequest{Limit: limit, After: after})
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(dto.BoardPage{Boards: dto.ToBoardDTOs(boards), NextCursor: dto.EncodeCursor(next)})
+		return
+	}
+
+	boards, err := h.todoUseCase.GetBoardsByUser(r.Context(), id, archived, limit, offset)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -122,18 +186,39 @@ func (h *TodoHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, ErrInvalidIfMatch, http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = input.Version
+	}
+
 	board := &entity.Board{
-		ID:    input.ID,
-		Title: input.Title,
+		ID:      input.ID,
+		Title:   input.Title,
+		Version: version,
 	}
 
-	err := h.todoUseCase.UpdateBoard(r.Context(), board)
+	err = h.todoUseCase.UpdateBoard(r.Context(), board)
+
+	if errors.Is(err, ucv1.ErrVersionConflict) {
+		current, getErr := h.todoUseCase.GetBoardByID(r.Context(), board.ID)
+		if getErr == nil {
+			writeConflict(w, fromHeader, current.Version, dto.ToBoardDTO(current))
+			return
+		}
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
+	setETag(w, board.Version)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -150,7 +235,7 @@ func (h *TodoHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
 	err = h.todoUseCase.DeleteBoard(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -170,12 +255,14 @@ func (h *TodoHandler) CreateColumn(w http.ResponseWriter, r *http.Request) {
 		BoardID:  input.BoardID,
 		Title:    input.Title,
 		Position: input.Position,
+		WIPLimit: entity.WIPLimit{Max: input.WIPLimit, Strict: input.WIPStrict},
 	}
 
 	err := h.todoUseCase.CreateColumn(r.Context(), column)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
@@ -193,12 +280,13 @@ func (h *TodoHandler) GetColumnByID(w http.ResponseWriter, r *http.Request) {
 	column, err := h.todoUseCase.GetColumnByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	columnDTO := dto.ToColumnDTO(column)
 
+	setETag(w, column.Version)
 	json.NewEncoder(w).Encode(columnDTO)
 }
 
@@ -229,9 +317,32 @@ func (h *TodoHandler) GetColumnsByBoard(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	columns, err := h.todoUseCase.GetColumnsByBoard(r.Context(), id, limit, offset)
+	archived, err := archivedFilter(query)
+	if err != nil {
+		http.Error(w, ErrInvalidArchived, http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := query["cursor"]; ok {
+		after, err := dto.DecodeCursor(query.Get("cursor"))
+		if err != nil {
+			http.Error(w, ErrInvalidCursor, http.StatusBadRequest)
+			return
+		}
+
+		columns, next, err := h.todoUseCase.GetColumnsPage(r.Context(), id, archived, entity.PageRequest{Limit: limit, After: after})
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(dto.ColumnPage{Columns: dto.ToColumnDTOs(columns), NextCursor: dto.EncodeCursor(next)})
+		return
+	}
+
+	columns, err := h.todoUseCase.GetColumnsByBoard(r.Context(), id, archived, limit, offset)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -248,19 +359,40 @@ func (h *TodoHandler) UpdateColumn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, ErrInvalidIfMatch, http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = input.Version
+	}
+
 	column := &entity.Column{
 		ID:       input.ID,
 		Title:    input.Title,
 		Position: input.Position,
+		Version:  version,
 	}
 
-	err := h.todoUseCase.UpdateColumn(r.Context(), column)
+	err = h.todoUseCase.UpdateColumn(r.Context(), column)
+
+	if errors.Is(err, ucv1.ErrVersionConflict) {
+		current, getErr := h.todoUseCase.GetColumnByID(r.Context(), column.ID)
+		if getErr == nil {
+			writeConflict(w, fromHeader, current.Version, dto.ToColumnDTO(current))
+			return
+		}
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
+	setETag(w, column.Version)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -277,7 +409,7 @@ func (h *TodoHandler) DeleteColumn(w http.ResponseWriter, r *http.Request) {
 	err = h.todoUseCase.DeleteColumn(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -298,15 +430,20 @@ func (h *TodoHandler) CreateCard(w http.ResponseWriter, r *http.Request) {
 		Title:       input.Title,
 		Description: input.Description,
 		Position:    input.Position,
+		StartDate:   input.StartDate,
+		DueDate:     input.DueDate,
 	}
 
 	err := h.todoUseCase.CreateCard(r.Context(), card)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
 	}
 
+	// The card is sent back for the warning about the WIP limit
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
 }
 
 func (h *TodoHandler) GetCardByID(w http.ResponseWriter, r *http.Request) {
@@ -321,12 +458,13 @@ func (h *TodoHandler) GetCardByID(w http.ResponseWriter, r *http.Request) {
 	card, err := h.todoUseCase.GetCardByID(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	cardDTO := dto.ToCardDTO(card)
 
+	setETag(w, card.Version)
 	json.NewEncoder(w).Encode(cardDTO)
 }
 
@@ -357,9 +495,35 @@ func (h *TodoHandler) GetCardsByColumn(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	cards, err := h.todoUseCase.GetCardsByColumn(r.Context(), id, limit, offset)
+	var filter entity.CardFilter
+	if labelID := query.Get("label_id"); labelID != "" {
+		filter.LabelID, err = uuid.Parse(labelID)
+		if err != nil {
+			http.Error(w, ErrInvalidLabelID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if _, ok := query["cursor"]; ok {
+		after, err := dto.DecodeCursor(query.Get("cursor"))
+		if err != nil {
+			http.Error(w, ErrInvalidCursor, http.StatusBadRequest)
+			return
+		}
+
+		cards, next, err := h.todoUseCase.GetCardsPage(r.Context(), id, filter, entity.PageRequest{Limit: limit, After: after})
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+
+		json.NewEncoder(w).Encode(dto.CardPage{Cards: dto.ToCardDTOs(cards), NextCursor: dto.EncodeCursor(next)})
+		return
+	}
+
+	cards, err := h.todoUseCase.GetCardsByColumn(r.Context(), id, filter, limit, offset)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -387,7 +551,7 @@ func (h *TodoHandler) GetNewCards(w http.ResponseWriter, r *http.Request) {
 
 	cards, err := h.todoUseCase.GetNewCards(r.Context(), from, to)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
@@ -404,21 +568,42 @@ func (h *TodoHandler) UpdateCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// If-Match takes precedence over the version in the body
+	version, fromHeader, err := ifMatch(r)
+	if err != nil {
+		http.Error(w, ErrInvalidIfMatch, http.StatusBadRequest)
+		return
+	}
+
+	if !fromHeader {
+		version = input.Version
+	}
+
 	card := &entity.Card{
 		ID:          input.ID,
 		ColumnID:    input.ColumnID,
 		Title:       input.Title,
 		Description: input.Description,
 		Position:    input.Position,
+		Version:     version,
 	}
 
-	err := h.todoUseCase.UpdateCard(r.Context(), card)
+	err = h.todoUseCase.UpdateCard(r.Context(), card)
+
+	if errors.Is(err, ucv1.ErrVersionConflict) {
+		current, getErr := h.todoUseCase.GetCardByID(r.Context(), card.ID)
+		if getErr == nil {
+			writeConflict(w, fromHeader, current.Version, dto.ToCardDTO(current))
+			return
+		}
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
+	setETag(w, card.Version)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -435,9 +620,1838 @@ func (h *TodoHandler) DeleteCard(w http.ResponseWriter, r *http.Request) {
 	err = h.todoUseCase.DeleteCard(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) RepositionColumn(w http.ResponseWriter, r *http.Request) {
+	columnID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(columnID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidColumnID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.RepositionRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	column, err := h.todoUseCase.RepositionColumn(r.Context(), id, dto.ToPlacementEntity(input))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToColumnDTO(column))
+}
+
+func (h *TodoHandler) ArchiveColumn(w http.ResponseWriter, r *http.Request) {
+	h.setColumnArchived(w, r, h.todoUseCase.ArchiveColumn)
+}
+
+func (h *TodoHandler) UnarchiveColumn(w http.ResponseWriter, r *http.Request) {
+	h.setColumnArchived(w, r, h.todoUseCase.UnarchiveColumn)
+}
+
+func (h *TodoHandler) setColumnArchived(w http.ResponseWriter, r *http.Request, set func(context.Context, uuid.UUID) (*entity.Column, error)) {
+	columnID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(columnID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidColumnID, http.StatusBadRequest)
+		return
+	}
+
+	column, err := set(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToColumnDTO(column))
+}
+
+func (h *TodoHandler) SetColumnWIPLimit(w http.ResponseWriter, r *http.Request) {
+	columnID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(columnID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidColumnID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.SetColumnWIPLimitRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	limit := entity.WIPLimit{
+		Max:    input.Limit,
+		Strict: input.Strict,
+	}
+
+	column, err := h.todoUseCase.SetColumnWIPLimit(r.Context(), id, limit)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToColumnDTO(column))
+}
+
+func (h *TodoHandler) RepositionCard(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.RepositionRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.todoUseCase.RepositionCard(r.Context(), id, dto.ToPlacementEntity(input))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
+}
+
+func (h *TodoHandler) MoveCard(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.MoveCardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.todoUseCase.MoveCard(r.Context(), id, dto.ToCardMoveEntity(input))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
+}
+
+func (h *TodoHandler) SetBoardPublic(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.SetBoardPublicRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.SetBoardPublic(r.Context(), id, input.IsPublic)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h *TodoHandler) ArchiveBoard(w http.ResponseWriter, r *http.Request) {
+	h.setBoardArchived(w, r, h.todoUseCase.ArchiveBoard)
+}
+
+func (h *TodoHandler) UnarchiveBoard(w http.ResponseWriter, r *http.Request) {
+	h.setBoardArchived(w, r, h.todoUseCase.UnarchiveBoard)
+}
+
+func (h *TodoHandler) setBoardArchived(w http.ResponseWriter, r *http.Request, set func(context.Context, uuid.UUID) (*entity.Board, error)) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	board, err := set(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardDTO(board))
+}
+
+func (h *TodoHandler) CloneBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CloneBoardRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && err != io.EOF {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := h.todoUseCase.CloneBoard(r.Context(), id, dto.ToCloneOptionsEntity(input))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToBoardDTO(board))
+}
+
+func (h *TodoHandler) ExportBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.todoUseCase.ExportBoard(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"board-%s.json\"", id))
+	json.NewEncoder(w).Encode(dto.ToBoardExportDTO(export))
+}
+
+// ImportBoard takes a board export as the request body and creates a new
+// board of the caller from it
+func (h *TodoHandler) ImportBoard(w http.ResponseWriter, r *http.Request) {
+	var input dto.BoardExport
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportSize)).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	export := dto.ToBoardExportEntity(input)
+
+	board, err := h.todoUseCase.ImportBoard(r.Context(), &export)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.ToBoardDTO(board))
+}
+
+// ImportTrelloBoard takes a Trello board export as the request body. With
+// dry_run=true nothing is created and only the summary is returned.
+func (h *TodoHandler) ImportTrelloBoard(w http.ResponseWriter, r *http.Request) {
+	dryRun := false
+
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(value)
+
+		if err != nil {
+			http.Error(w, ErrInvalidDryRun, http.StatusBadRequest)
+			return
+		}
+	}
+
+	var input dto.TrelloBoard
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTrelloImportSize)).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	trello := dto.ToTrelloBoardEntity(input)
+
+	summary, err := h.todoUseCase.ImportTrelloBoard(r.Context(), &trello, dryRun)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	if !dryRun {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(dto.ToImportSummaryDTO(summary))
+}
+
+func (h *TodoHandler) GetTemplatesByUser(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	templates, err := h.todoUseCase.GetTemplatesByUser(r.Context(), id, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardDTOs(templates))
+}
+
+func (h *TodoHandler) CreateShareToken(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	token, err := h.todoUseCase.CreateShareToken(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToShareTokenDTO(token))
+}
+
+func (h *TodoHandler) GetShareTokensByBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	tokens, err := h.todoUseCase.GetShareTokensByBoard(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToShareTokenDTOs(tokens))
+}
+
+func (h *TodoHandler) RevokeShareToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.RevokeShareToken(r.Context(), id, vars["token"])
+
+	if errors.Is(err, ucv1.ErrShareTokenNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) GetSharedBoard(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	tree, err := h.todoUseCase.GetSharedBoard(r.Context(), token)
+
+	if isSharedNotFound(err) {
+		http.Error(w, ErrSharedBoardNotFound, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardTreeDTO(tree))
+}
+
+func (h *TodoHandler) GetSharedCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.todoUseCase.GetSharedCard(r.Context(), vars["token"], id)
+
+	if isSharedNotFound(err) {
+		http.Error(w, ErrSharedCardNotFound, http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
+}
+
+func (h *TodoHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.InviteMemberRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.todoUseCase.InviteMember(r.Context(), id, input.UserID, entity.MemberRole(input.Role))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToBoardMemberDTO(member))
+}
+
+func (h *TodoHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.todoUseCase.AcceptInvitation(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardMemberDTO(member))
+}
+
+func (h *TodoHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(vars["user_id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.UpdateMemberRoleRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.todoUseCase.UpdateMemberRole(r.Context(), id, userID, entity.MemberRole(input.Role))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardMemberDTO(member))
+}
+
+func (h *TodoHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	userID, err := uuid.Parse(vars["user_id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.RemoveMember(r.Context(), id, userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) GetBoardMembers(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	members, err := h.todoUseCase.GetBoardMembers(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardMemberDTOs(members))
+}
+
+func (h *TodoHandler) GetInvitations(w http.ResponseWriter, r *http.Request) {
+	userID := r.URL.Query().Get("user_id")
+	id, err := uuid.Parse(userID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	invitations, err := h.todoUseCase.GetInvitations(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToBoardMemberDTOs(invitations))
+}
+
+func (h *TodoHandler) RejectSharedMutation(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, ErrReadOnlyShare, http.StatusMethodNotAllowed)
+}
+
+// errorStatus maps usecase errors to HTTP status codes. A resource that exists
+// but belongs to someone else is forbidden, a missing one is not found.
+func (h *TodoHandler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CreateLabelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	label := &entity.Label{
+		BoardID: id,
+		Name:    input.Name,
+		Color:   input.Color,
+	}
+
+	err = h.todoUseCase.CreateLabel(r.Context(), label)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToLabelDTO(label))
+}
+
+func (h *TodoHandler) GetLabelsByBoard(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	labels, err := h.todoUseCase.GetLabelsByBoard(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToLabelDTOs(labels))
+}
+
+func (h *TodoHandler) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	labelID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(labelID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidLabelID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.UpdateLabelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	label := &entity.Label{
+		ID:    id,
+		Name:  input.Name,
+		Color: input.Color,
+	}
+
+	err = h.todoUseCase.UpdateLabel(r.Context(), label)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToLabelDTO(label))
+}
+
+func (h *TodoHandler) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	labelID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(labelID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidLabelID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.DeleteLabel(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) AttachLabel(w http.ResponseWriter, r *http.Request) {
+	cardID, labelID, ok := cardLabelVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.todoUseCase.AttachLabel(r.Context(), cardID, labelID)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) DetachLabel(w http.ResponseWriter, r *http.Request) {
+	cardID, labelID, ok := cardLabelVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.todoUseCase.DetachLabel(r.Context(), cardID, labelID)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func cardLabelVars(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	cardID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	labelID, err := uuid.Parse(vars["label_id"])
+	if err != nil {
+		http.Error(w, ErrInvalidLabelID, http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return cardID, labelID, true
+}
+
+func (h *TodoHandler) AssignCard(w http.ResponseWriter, r *http.Request) {
+	cardID, userID, ok := cardAssigneeVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.todoUseCase.AssignCard(r.Context(), cardID, userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) UnassignCard(w http.ResponseWriter, r *http.Request) {
+	cardID, userID, ok := cardAssigneeVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.todoUseCase.UnassignCard(r.Context(), cardID, userID)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func cardAssigneeVars(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	cardID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(vars["user_id"])
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return cardID, userID, true
+}
+
+func (h *TodoHandler) CreateChecklist(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CreateChecklistRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	checklist := &entity.Checklist{
+		CardID: id,
+		Title:  input.Title,
+	}
+
+	err = h.todoUseCase.CreateChecklist(r.Context(), checklist)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToChecklistDTO(checklist))
+}
+
+func (h *TodoHandler) GetChecklistsByCard(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	checklists, err := h.todoUseCase.GetChecklistsByCard(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToChecklistDTOs(checklists))
+}
+
+func (h *TodoHandler) UpdateChecklist(w http.ResponseWriter, r *http.Request) {
+	checklistID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(checklistID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.UpdateChecklistRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	checklist := &entity.Checklist{
+		ID:    id,
+		Title: input.Title,
+	}
+
+	err = h.todoUseCase.UpdateChecklist(r.Context(), checklist)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToChecklistDTO(checklist))
+}
+
+func (h *TodoHandler) DeleteChecklist(w http.ResponseWriter, r *http.Request) {
+	checklistID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(checklistID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.DeleteChecklist(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) CreateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	checklistID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(checklistID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CreateChecklistItemRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item := &entity.ChecklistItem{
+		ChecklistID: id,
+		Text:        input.Text,
+	}
+
+	err = h.todoUseCase.CreateChecklistItem(r.Context(), item)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToChecklistItemDTO(item))
+}
+
+func (h *TodoHandler) UpdateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	itemID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(itemID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistItemID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.UpdateChecklistItemRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item := &entity.ChecklistItem{
+		ID:   id,
+		Text: input.Text,
+	}
+
+	err = h.todoUseCase.UpdateChecklistItem(r.Context(), item)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToChecklistItemDTO(item))
+}
+
+func (h *TodoHandler) SetChecklistItemDone(w http.ResponseWriter, r *http.Request) {
+	itemID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(itemID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistItemID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.SetChecklistItemDoneRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.todoUseCase.SetChecklistItemDone(r.Context(), id, input.Done)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToChecklistItemDTO(item))
+}
+
+func (h *TodoHandler) DeleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	itemID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(itemID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidChecklistItemID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.DeleteChecklistItem(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.CreateCommentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	comment := &entity.Comment{
+		CardID: id,
+		Body:   input.Body,
+	}
+
+	err = h.todoUseCase.CreateComment(r.Context(), comment)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToCommentDTO(comment))
+}
+
+func (h *TodoHandler) GetCommentsByCard(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	comments, err := h.todoUseCase.GetCommentsByCard(r.Context(), id, limit, offset)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCommentDTOs(comments))
+}
+
+func (h *TodoHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	commentID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(commentID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCommentID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.UpdateCommentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	comment := &entity.Comment{
+		ID:   id,
+		Body: input.Body,
+	}
+
+	err = h.todoUseCase.UpdateComment(r.Context(), comment)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCommentDTO(comment))
+}
+
+func (h *TodoHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	commentID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(commentID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCommentID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.DeleteComment(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) SetCardDates(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	var input dto.SetCardDatesRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dates := entity.CardDates{
+		StartDate: input.StartDate,
+		DueDate:   input.DueDate,
+	}
+
+	card, err := h.todoUseCase.SetCardDates(r.Context(), id, dates)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
+}
+
+func (h *TodoHandler) GetOverdueCards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	cards, err := h.todoUseCase.GetOverdueCards(r.Context(), id, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTOs(cards))
+}
+
+func (h *TodoHandler) GetDueCards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	days, err := strconv.Atoi(query.Get("days"))
+	if err != nil {
+		http.Error(w, ErrInvalidDays, http.StatusBadRequest)
+		return
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	cards, err := h.todoUseCase.GetDueCards(r.Context(), id, days, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTOs(cards))
+}
+
+func (h *TodoHandler) GetAssignedCards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	cards, err := h.todoUseCase.GetAssignedCards(r.Context(), id, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardDTOs(cards))
+}
+
+// SearchCards takes the query from ?q= and optionally narrows it down to a
+// board with ?board_id= and to the cards created from ?from= to ?to=
+// inclusive, both DD-MM-YYYY
+func (h *TodoHandler) SearchCards(w http.ResponseWriter, r *http.Request) {
+	layout := "02-01-2006" // DD-MM-YYYY
+
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	search := entity.CardSearch{Query: query.Get("q")}
+
+	if boardID := query.Get("board_id"); boardID != "" {
+		search.BoardID, err = uuid.Parse(boardID)
+		if err != nil {
+			http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if from := query.Get("from"); from != "" {
+		search.From, err = time.Parse(layout, from)
+		if err != nil {
+			http.Error(w, ErrInvalidFromDate, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if to := query.Get("to"); to != "" {
+		search.To, err = time.Parse(layout, to)
+		if err != nil {
+			http.Error(w, ErrInvalidToDate, http.StatusBadRequest)
+			return
+		}
+		search.To = search.To.AddDate(0, 0, 1)
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	matches, err := h.todoUseCase.SearchCards(r.Context(), id, search, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardMatchDTOs(matches))
+}
+
+// UploadAttachment takes the raw content of the file as the request body and
+// its name from ?filename=
+func (h *TodoHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	attachment := &entity.Attachment{
+		CardID:   id,
+		Filename: r.URL.Query().Get("filename"),
+		Size:     r.ContentLength,
+	}
+
+	err = h.todoUseCase.UploadAttachment(r.Context(), attachment, r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+
+	json.NewEncoder(w).Encode(dto.ToAttachmentDTO(attachment))
+}
+
+// GetAttachment streams the content of the attachment. It is always served
+// as a download with the sniffed type, so that an uploaded page cannot run
+// in the browser of whoever opens it.
+func (h *TodoHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	attachmentID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(attachmentID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidAttachmentID, http.StatusBadRequest)
+		return
+	}
+
+	attachment, content, err := h.todoUseCase.GetAttachment(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	io.Copy(w, content)
+}
+
+func (h *TodoHandler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	attachmentID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(attachmentID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidAttachmentID, http.StatusBadRequest)
+		return
+	}
+
+	err = h.todoUseCase.DeleteAttachment(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *TodoHandler) GetBoardActivity(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	activities, err := h.todoUseCase.GetBoardActivity(r.Context(), id, limit, offset)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToActivityDTOs(activities))
+}
+
+func (h *TodoHandler) GetCardActivity(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	activities, err := h.todoUseCase.GetCardActivity(r.Context(), id, limit, offset)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToActivityDTOs(activities))
+}
+
+// StreamBoardEvents streams the change feed of the board as server-sent
+// events until the client goes away. A client reconnecting with the
+// Last-Event-ID header gets the events it missed first.
+func (h *TodoHandler) StreamBoardEvents(w http.ResponseWriter, r *http.Request) {
+	boardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(boardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidBoardID, http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, ErrStreamingUnsupported, http.StatusInternalServerError)
+		return
+	}
+
+	events, err := h.todoUseCase.SubscribeBoard(r.Context(), id, r.Header.Get("Last-Event-ID"))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// Dropped by the broker; the client resumes where it left off
+				return
+			}
+			writeEvent(w, dto.ToEventDTO(&event))
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w io.Writer, event dto.Event) {
+	data, _ := json.Marshal(event)
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
+
+func (h *TodoHandler) GetCardRevisions(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	revisions, err := h.todoUseCase.GetCardRevisions(r.Context(), id, limit, offset)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToCardRevisionDTOs(revisions))
+}
+
+// DiffCardRevisions compares the revisions given by ?from= and ?to=
+func (h *TodoHandler) DiffCardRevisions(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(cardID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	from, err := strconv.Atoi(query.Get("from"))
+	if err != nil {
+		http.Error(w, ErrInvalidRevisionNumber, http.StatusBadRequest)
+		return
+	}
+
+	to, err := strconv.Atoi(query.Get("to"))
+	if err != nil {
+		http.Error(w, ErrInvalidRevisionNumber, http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.todoUseCase.DiffCardRevisions(r.Context(), id, from, to)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToRevisionDiffDTO(diff))
+}
+
+func (h *TodoHandler) RevertCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := uuid.Parse(vars["id"])
+
+	if err != nil {
+		http.Error(w, ErrInvalidCardID, http.StatusBadRequest)
+		return
+	}
+
+	number, err := strconv.Atoi(vars["number"])
+	if err != nil {
+		http.Error(w, ErrInvalidRevisionNumber, http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.todoUseCase.RevertCard(r.Context(), id, number)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	setETag(w, card.Version)
+	json.NewEncoder(w).Encode(dto.ToCardDTO(card))
+}
+
+// GetTrash returns a page of the trash of the user given by ?user_id=
+func (h *TodoHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, ErrInvalidUserID, http.StatusBadRequest)
+		return
+	}
+
+	limit := h.config.Limit
+	if _, ok := query["limit"]; ok {
+		limitStr := query.Get("limit")
+		limitInt, err := strconv.Atoi(limitStr)
+		if err == nil {
+			limit = limitInt
+		}
+	}
+
+	offset := h.config.Offset
+	if _, ok := query["offset"]; ok {
+		offsetStr := query.Get("offset")
+		offsetInt, err := strconv.Atoi(offsetStr)
+		if err == nil {
+			offset = offsetInt
+		}
+	}
+
+	items, err := h.todoUseCase.GetTrash(r.Context(), id, limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToTrashItemDTOs(items))
+}
+
+func (h *TodoHandler) RestoreItem(w http.ResponseWriter, r *http.Request) {
+	itemID := mux.Vars(r)["id"]
+	id, err := uuid.Parse(itemID)
+
+	if err != nil {
+		http.Error(w, ErrInvalidTrashItemID, http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.todoUseCase.RestoreItem(r.Context(), id)
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	json.NewEncoder(w).Encode(dto.ToTrashItemDTO(item))
+}
+
+// RunBatch applies the operations of the batch in order. The batch is atomic
+// unless its mode is best_effort. The reply lists the outcome of every
+// operation, with the status code it would have had on its own when it failed.
+func (h *TodoHandler) RunBatch(w http.ResponseWriter, r *http.Request) {
+	var input dto.BatchRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mode := entity.BatchMode(input.Mode)
+	if mode == "" {
+		mode = entity.BatchAtomic
+	}
+
+	results, err := h.todoUseCase.RunBatch(r.Context(), mode, dto.ToBatchOpEntities(input.Operations))
+
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	resp := dto.BatchResponse{Mode: string(mode), Results: make([]dto.BatchResult, len(results))}
+	for i, result := range results {
+		resp.Results[i] = dto.BatchResult{
+			Status:  string(result.Status),
+			ID:      result.ID,
+			Version: result.Version,
+		}
+
+		if result.Err != nil {
+			resp.Results[i].Code = errorStatus(result.Err)
+			resp.Results[i].Error = result.Err.Error()
+		}
+	}
+
+	json.NewEncoder(w).Encode(resp)
+}
+
+// archivedFilter reads the optional <<archived>> query flag; archived items
+// are only listed when it is set
+func archivedFilter(query url.Values) (bool, error) {
+	if _, ok := query["archived"]; !ok {
+		return false, nil
+	}
+
+	return strconv.ParseBool(query.Get("archived"))
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, ucv1.ErrNoCaller):
+		return http.StatusUnauthorized
+	case errors.Is(err, ucv1.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrNotFound),
+		errors.Is(err, ucv1.ErrMemberNotFound),
+		errors.Is(err, ucv1.ErrInvitationNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ucv1.ErrInvalidMemberRole),
+		errors.Is(err, ucv1.ErrMemberNoUserID),
+		errors.Is(err, ucv1.ErrInvalidPlacement),
+		errors.Is(err, ucv1.ErrNotSiblings),
+		errors.Is(err, ucv1.ErrMoveNoColumnID),
+		errors.Is(err, ucv1.ErrLabelEmptyName),
+		errors.Is(err, ucv1.ErrLabelNameTooLong),
+		errors.Is(err, ucv1.ErrLabelInvalidColor),
+		errors.Is(err, ucv1.ErrLabelNotOnBoard),
+		errors.Is(err, ucv1.ErrChecklistEmptyTitle),
+		errors.Is(err, ucv1.ErrChecklistTitleTooLong),
+		errors.Is(err, ucv1.ErrChecklistItemEmptyText),
+		errors.Is(err, ucv1.ErrChecklistItemTooLong),
+		errors.Is(err, ucv1.ErrCommentEmptyBody),
+		errors.Is(err, ucv1.ErrCommentTooLong),
+		errors.Is(err, ucv1.ErrCardDescriptionTooLong),
+		errors.Is(err, ucv1.ErrCardColumnChange),
+		errors.Is(err, ucv1.ErrCardStartAfterDue),
+		errors.Is(err, ucv1.ErrInvalidDueWithin),
+		errors.Is(err, ucv1.ErrAssigneeNoUserID),
+		errors.Is(err, ucv1.ErrAssigneeNotMember),
+		errors.Is(err, ucv1.ErrAttachmentNoFilename),
+		errors.Is(err, ucv1.ErrAttachmentFilenameTooLong),
+		errors.Is(err, ucv1.ErrAttachmentEmpty),
+		errors.Is(err, ucv1.ErrSearchEmptyQuery),
+		errors.Is(err, ucv1.ErrSearchQueryTooLong),
+		errors.Is(err, ucv1.ErrSearchInvalidPeriod),
+		errors.Is(err, ucv1.ErrExportVersion),
+		errors.Is(err, ucv1.ErrInvalidExport),
+		errors.Is(err, ucv1.ErrInvalidTrelloExport),
+		errors.Is(err, ucv1.ErrBatchEmpty),
+		errors.Is(err, ucv1.ErrBatchTooLarge),
+		errors.Is(err, ucv1.ErrBatchInvalidMode),
+		errors.Is(err, ucv1.ErrBatchInvalidOp),
+		errors.Is(err, ucv1.ErrWIPLimitNegative),
+		errors.Is(err, ucv1.ErrRevisionInvalidNumber):
+		return http.StatusBadRequest
+	case errors.Is(err, ucv1.ErrAttachmentSizeUnknown):
+		return http.StatusLengthRequired
+	case errors.Is(err, ucv1.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ucv1.ErrAlreadyMember),
+		errors.Is(err, ucv1.ErrBoardCreator),
+		errors.Is(err, ucv1.ErrCardMoved),
+		errors.Is(err, ucv1.ErrTrashParentDeleted),
+		errors.Is(err, ucv1.ErrArchived),
+		errors.Is(err, ucv1.ErrLabelExists),
+		errors.Is(err, ucv1.ErrWIPLimitReached),
+		errors.Is(err, ucv1.ErrVersionConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Guests must not be able to tell a revoked link from a private board or a
+// card of another board, so all of them look the same from the outside
+func isSharedNotFound(err error) bool {
+	return errors.Is(err, ucv1.ErrShareTokenNotFound) ||
+		errors.Is(err, ucv1.ErrEmptyShareToken) ||
+		errors.Is(err, ucv1.ErrBoardNotPublic) ||
+		errors.Is(err, ucv1.ErrCardNotOnSharedBoard) ||
+		errors.Is(err, repository.ErrNotFound)
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errInvalidETag = errors.New("invalid entity tag")
+
+// ifMatch reads the version an update is based on from the If-Match header.
+// ok is false without the header; "*" matches any version, which is zero.
+// Weak tags are refused since If-Match only compares strong ones.
+func ifMatch(r *http.Request) (version int, ok bool, err error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+
+	if value == "" {
+		return 0, false, nil
+	}
+
+	if value == "*" {
+		return 0, true, nil
+	}
+
+	tag, err := strconv.Unquote(value)
+	if err != nil || !strings.HasPrefix(value, `"`) {
+		return 0, true, errInvalidETag
+	}
+
+	version, err = strconv.Atoi(tag)
+	if err != nil || version <= 0 {
+		return 0, true, errInvalidETag
+	}
+
+	return version, true, nil
+}
+
+// setETag tags a response with the version of the board, column or card in
+// it
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// writeConflict answers an update based on an outdated version with the
+// current state of the item, so that the client can merge its change into
+// it. A version from If-Match fails its precondition, one from the body
+// conflicts.
+func writeConflict(w http.ResponseWriter, fromHeader bool, version int, current any) {
+	status := http.StatusConflict
+	if fromHeader {
+		status = http.StatusPreconditionFailed
+	}
+
+	setETag(w, version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(current)
+}
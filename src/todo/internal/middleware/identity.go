@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"todo/internal/common/identity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	UserIDHeader = "X-User-ID"
+	RoleHeader   = "X-User-Role"
+)
+
+// IdentityMiddleware puts the caller forwarded by the aggregator into the
+// request context. The todo service is only reachable through the aggregator,
+// which authenticates the user and sets these headers itself.
+type IdentityMiddleware struct{}
+
+func NewIdentityMiddleware() *IdentityMiddleware {
+	return &IdentityMiddleware{}
+}
+
+func (m *IdentityMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userIDStr := r.Header.Get(UserIDHeader)
+		if userIDStr == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			http.Error(w, "Invalid "+UserIDHeader+" header", http.StatusUnauthorized)
+			return
+		}
+
+		caller := identity.Caller{
+			UserID: userID,
+			Role:   r.Header.Get(RoleHeader),
+		}
+
+		next.ServeHTTP(w, r.WithContext(identity.WithCaller(r.Context(), caller)))
+	})
+}
@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/feed"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Relay periodically delivers the events of the outbox to the publisher. An
+// event leaves the outbox in the transaction that delivered it, so a relay
+// that dies before the transaction commits delivers it again: events are
+// delivered at least once. The events of an aggregate are delivered in the
+// order they were written; an event that fails holds back the later events
+// of its aggregate until it is delivered.
+type Relay struct {
+	outboxRepo repository.OutboxRepository
+	publisher  feed.Publisher
+	tx         repository.Transactor
+	interval   time.Duration
+	batchSize  int
+	// retryBase is the wait after the first failed delivery of an event; it
+	// doubles with every failure up to retryMax
+	retryBase time.Duration
+	retryMax  time.Duration
+	log       logger.Logger
+}
+
+func NewRelay(outboxRepo repository.OutboxRepository, publisher feed.Publisher, tx repository.Transactor, interval time.Duration, batchSize int, retryBase, retryMax time.Duration, log logger.Logger) *Relay {
+	return &Relay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		tx:         tx,
+		interval:   interval,
+		batchSize:  batchSize,
+		retryBase:  retryBase,
+		retryMax:   retryMax,
+		log:        log,
+	}
+}
+
+// Run ticks right away and then every interval until the context is done
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.Tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick delivers the oldest batch of events that are due at the given moment.
+// It does nothing while another relay holds the outbox.
+func (r *Relay) Tick(ctx context.Context, now time.Time) {
+	header := "OutboxRelay: "
+
+	var delivered, failed int
+
+	err := r.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		delivered, failed = 0, 0
+
+		locked, err := r.outboxRepo.LockOutbox(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		events, err := r.outboxRepo.GetDueOutboxEvents(ctx, now, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		// held are the aggregates whose event failed in this tick; their later
+		// events wait for it
+		held := make(map[uuid.UUID]bool)
+
+		for i := range events {
+			event := &events[i]
+
+			if held[event.AggregateID] {
+				continue
+			}
+
+			err := r.publisher.Publish(ctx, []entity.Event{{
+				Type:    event.Type,
+				BoardID: event.BoardID,
+				Change:  event.Change,
+			}})
+
+			if err != nil {
+				r.log.Warn(ctx, header+"Failed to deliver event", "id", event.ID, "attempts", event.Attempts+1, "err", err.Error())
+
+				held[event.AggregateID] = true
+				event.Attempts++
+				event.NextAttemptAt = now.Add(r.backoff(event.Attempts))
+				event.LastError = err.Error()
+
+				if err := r.outboxRepo.RescheduleOutboxEvent(ctx, event); err != nil {
+					return err
+				}
+
+				failed++
+				continue
+			}
+
+			if err := r.outboxRepo.DeleteOutboxEvent(ctx, event.ID); err != nil {
+				return err
+			}
+
+			delivered++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		r.log.Error(ctx, header+"Failed to relay outbox", "err", err.Error())
+		return
+	}
+
+	if delivered > 0 || failed > 0 {
+		r.log.Info(ctx, header+"Outbox relayed", "delivered", delivered, "failed", failed)
+	}
+}
+
+// backoff is the wait before the next delivery of an event that failed the
+// given number of times
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.retryBase
+	for i := 1; i < attempts && wait < r.retryMax; i++ {
+		wait *= 2
+	}
+
+	return min(wait, r.retryMax)
+}
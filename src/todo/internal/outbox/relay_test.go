@@ -0,0 +1,289 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/outbox"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+var errCrashed = errors.New("crashed before commit")
+
+type txKey struct{}
+
+// memOutbox is an outbox kept in memory. It is also its own transactor: what
+// a transaction does only shows once it commits, and a transaction can be
+// made to end like one whose process died before the commit.
+type memOutbox struct {
+	committed []entity.OutboxEvent
+	seq       int64
+	// crash makes the next transaction end without committing
+	crash bool
+	// locked is set while another relay holds the outbox
+	locked bool
+}
+
+func (o *memOutbox) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*[]entity.OutboxEvent); ok {
+		return fn(ctx)
+	}
+
+	working := slices.Clone(o.committed)
+	if err := fn(context.WithValue(ctx, txKey{}, &working)); err != nil {
+		return err
+	}
+
+	if o.crash {
+		o.crash = false
+		return errCrashed
+	}
+
+	o.committed = working
+
+	return nil
+}
+
+func (o *memOutbox) events(ctx context.Context) *[]entity.OutboxEvent {
+	if working, ok := ctx.Value(txKey{}).(*[]entity.OutboxEvent); ok {
+		return working
+	}
+
+	return &o.committed
+}
+
+func (o *memOutbox) CreateOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error {
+	stored := o.events(ctx)
+	for _, event := range events {
+		o.seq++
+		event.Seq = o.seq
+		*stored = append(*stored, event)
+	}
+
+	return nil
+}
+
+func (o *memOutbox) GetDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error) {
+	var due []entity.OutboxEvent
+	waiting := make(map[uuid.UUID]bool)
+
+	for _, event := range *o.events(ctx) {
+		if event.NextAttemptAt.After(now) {
+			waiting[event.AggregateID] = true
+		}
+
+		if !waiting[event.AggregateID] && len(due) < limit {
+			due = append(due, event)
+		}
+	}
+
+	return due, nil
+}
+
+func (o *memOutbox) DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error {
+	stored := o.events(ctx)
+	*stored = slices.DeleteFunc(*stored, func(e entity.OutboxEvent) bool { return e.ID == id })
+
+	return nil
+}
+
+func (o *memOutbox) RescheduleOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	for i, e := range *o.events(ctx) {
+		if e.ID == event.ID {
+			(*o.events(ctx))[i] = *event
+		}
+	}
+
+	return nil
+}
+
+func (o *memOutbox) LockOutbox(ctx context.Context) (bool, error) {
+	return !o.locked, nil
+}
+
+// write commits an event of the aggregate as a change would
+func (o *memOutbox) write(t *testing.T, aggregateID uuid.UUID, now time.Time) entity.OutboxEvent {
+	t.Helper()
+
+	event := entity.OutboxEvent{
+		ID:            uuid.New(),
+		Type:          "CardUpdated",
+		AggregateType: entity.ActivityTargetCard,
+		AggregateID:   aggregateID,
+		BoardID:       uuid.New(),
+		Change:        entity.Activity{ID: uuid.New(), TargetID: aggregateID},
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+
+	err := o.WithinTransaction(context.TODO(), func(ctx context.Context) error {
+		return o.CreateOutboxEvents(ctx, []entity.OutboxEvent{event})
+	})
+	assert.Nil(t, err)
+
+	return event
+}
+
+// recordingPublisher keeps what it was given, failing the changes it is told
+// to fail the given number of times first
+type recordingPublisher struct {
+	delivered []uuid.UUID
+	attempts  int
+	failures  map[uuid.UUID]int
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events []entity.Event) error {
+	for _, event := range events {
+		p.attempts++
+
+		if p.failures[event.Change.ID] > 0 {
+			p.failures[event.Change.ID]--
+			return errors.New("unavailable")
+		}
+
+		p.delivered = append(p.delivered, event.Change.ID)
+	}
+
+	return nil
+}
+
+func newRelay(o *memOutbox, publisher *recordingPublisher) *outbox.Relay {
+	return outbox.NewRelay(o, publisher, o, time.Second, 10, time.Second, 3*time.Second, nopLogger{})
+}
+
+func TestRelayDeliversWhatACrashedProcessLeft(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	// the changes were committed, but the process died before its relay ran
+	o := &memOutbox{}
+	first := o.write(t, uuid.New(), now)
+	second := o.write(t, uuid.New(), now)
+
+	publisher := &recordingPublisher{}
+	newRelay(o, publisher).Tick(ctx, now)
+
+	assert.Equal(t, []uuid.UUID{first.Change.ID, second.Change.ID}, publisher.delivered)
+	assert.Empty(t, o.committed)
+}
+
+func TestRelayRedeliversAfterCrashBeforeCommit(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	o := &memOutbox{}
+	event := o.write(t, uuid.New(), now)
+	publisher := &recordingPublisher{}
+
+	// the event went out, but the removal from the outbox never committed
+	o.crash = true
+	newRelay(o, publisher).Tick(ctx, now)
+
+	assert.Equal(t, []uuid.UUID{event.Change.ID}, publisher.delivered)
+	assert.Len(t, o.committed, 1)
+
+	newRelay(o, publisher).Tick(ctx, now)
+
+	assert.Equal(t, []uuid.UUID{event.Change.ID, event.Change.ID}, publisher.delivered)
+	assert.Empty(t, o.committed)
+}
+
+func TestRelayRetriesWithBackoff(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	o := &memOutbox{}
+	event := o.write(t, uuid.New(), now)
+	publisher := &recordingPublisher{failures: map[uuid.UUID]int{event.Change.ID: 3}}
+	relay := newRelay(o, publisher)
+
+	// the wait doubles from a second up to three seconds
+	ticks := []struct {
+		at          time.Duration
+		attempts    int
+		nextAttempt time.Duration
+	}{
+		{0, 1, time.Second},
+		{time.Second, 2, 3 * time.Second},
+		{3 * time.Second, 3, 6 * time.Second},
+	}
+
+	for _, tick := range ticks {
+		relay.Tick(ctx, now.Add(tick.at))
+
+		if assert.Len(t, o.committed, 1) {
+			assert.Equal(t, tick.attempts, o.committed[0].Attempts)
+			assert.Equal(t, now.Add(tick.nextAttempt), o.committed[0].NextAttemptAt)
+			assert.Equal(t, "unavailable", o.committed[0].LastError)
+		}
+	}
+
+	// nothing is tried before the next attempt is due
+	relay.Tick(ctx, now.Add(5*time.Second))
+	assert.Equal(t, 3, publisher.attempts)
+
+	relay.Tick(ctx, now.Add(6*time.Second))
+	assert.Equal(t, []uuid.UUID{event.Change.ID}, publisher.delivered)
+	assert.Empty(t, o.committed)
+}
+
+func TestRelayKeepsOrderPerAggregate(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	o := &memOutbox{}
+	card, other := uuid.New(), uuid.New()
+	first := o.write(t, card, now)
+	unrelated := o.write(t, other, now)
+	second := o.write(t, card, now)
+
+	publisher := &recordingPublisher{failures: map[uuid.UUID]int{first.Change.ID: 1}}
+	relay := newRelay(o, publisher)
+
+	// the later event of the card waits for the one that failed, the other
+	// card goes ahead
+	relay.Tick(ctx, now)
+
+	assert.Equal(t, []uuid.UUID{unrelated.Change.ID}, publisher.delivered)
+	assert.Len(t, o.committed, 2)
+
+	// and keeps waiting until the retry is due
+	relay.Tick(ctx, now.Add(time.Second/2))
+
+	assert.Equal(t, []uuid.UUID{unrelated.Change.ID}, publisher.delivered)
+
+	relay.Tick(ctx, now.Add(time.Second))
+
+	assert.Equal(t, []uuid.UUID{unrelated.Change.ID, first.Change.ID, second.Change.ID}, publisher.delivered)
+	assert.Empty(t, o.committed)
+}
+
+func TestRelayLeavesOutboxHeldByAnotherRelay(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	o := &memOutbox{}
+	o.write(t, uuid.New(), now)
+	o.locked = true
+
+	publisher := &recordingPublisher{}
+	newRelay(o, publisher).Tick(ctx, now)
+
+	assert.Zero(t, publisher.attempts)
+	assert.Len(t, o.committed, 1)
+}
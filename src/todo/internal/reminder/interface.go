@@ -0,0 +1,11 @@
+package reminder
+
+import (
+	"context"
+	"todo/internal/entity"
+)
+
+// Sink delivers reminders to wherever they should end up
+type Sink interface {
+	Send(ctx context.Context, reminder entity.Reminder) error
+}
@@ -0,0 +1,85 @@
+package reminder
+
+import (
+	"context"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/repository"
+)
+
+// Scheduler periodically looks for cards that become due within the lead
+// time and sends a reminder for each of them once. Cards that are overdue
+// already are not reminded of.
+type Scheduler struct {
+	cardRepo repository.CardRepository
+	sink     Sink
+	interval time.Duration
+	lead     time.Duration
+	log      logger.Logger
+}
+
+func NewScheduler(cardRepo repository.CardRepository, sink Sink, interval, lead time.Duration, log logger.Logger) *Scheduler {
+	return &Scheduler{
+		cardRepo: cardRepo,
+		sink:     sink,
+		interval: interval,
+		lead:     lead,
+		log:      log,
+	}
+}
+
+// Run ticks right away and then every interval until the context is done
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick sends the reminders that are due at the given moment. A reminder that
+// fails to be sent is not recorded, so it is retried on the next tick.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) {
+	header := "ReminderScheduler: "
+
+	cards, err := s.cardRepo.GetCardsToRemind(ctx, now, now.Add(s.lead))
+
+	if err != nil {
+		s.log.Error(ctx, header+"Failed to get cards to remind", "err", err.Error())
+		return
+	}
+
+	for _, card := range cards {
+		if card.DueDate == nil {
+			continue
+		}
+
+		reminder := entity.Reminder{
+			CardID:   card.ID,
+			ColumnID: card.ColumnID,
+			UserID:   card.UserID,
+			Title:    card.Title,
+			DueDate:  *card.DueDate,
+		}
+
+		if err := s.sink.Send(ctx, reminder); err != nil {
+			s.log.Warn(ctx, header+"Failed to send reminder", "cardID", card.ID, "err", err.Error())
+			continue
+		}
+
+		if err := s.cardRepo.MarkCardReminded(ctx, card.ID, *card.DueDate, now); err != nil {
+			s.log.Error(ctx, header+"Failed to mark card as reminded", "cardID", card.ID, "err", err.Error())
+			continue
+		}
+
+		s.log.Info(ctx, header+"Reminder sent", "cardID", card.ID, "dueDate", card.DueDate)
+	}
+}
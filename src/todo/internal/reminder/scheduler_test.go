@@ -0,0 +1,107 @@
+package reminder_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/entity"
+	"todo/internal/reminder"
+	"todo/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+func TestTick(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	lead := 24 * time.Hour
+
+	due := now.Add(time.Hour)
+	sent := entity.Card{ID: uuid.New(), ColumnID: uuid.New(), UserID: uuid.New(), Title: "Sent", DueDate: &due}
+	failed := entity.Card{ID: uuid.New(), Title: "Failed", DueDate: &due}
+
+	cardRepo := new(mocks.CardRepository)
+	sink := new(mocks.Sink)
+
+	cardRepo.On("GetCardsToRemind", ctx, now, now.Add(lead)).Return([]entity.Card{sent, failed}, nil)
+	cardRepo.On("MarkCardReminded", ctx, sent.ID, due, now).Return(nil)
+	sink.On("Send", ctx, entity.Reminder{
+		CardID:   sent.ID,
+		ColumnID: sent.ColumnID,
+		UserID:   sent.UserID,
+		Title:    sent.Title,
+		DueDate:  due,
+	}).Return(nil)
+	sink.On("Send", ctx, mock.MatchedBy(func(r entity.Reminder) bool {
+		return r.CardID == failed.ID
+	})).Return(errors.New("unavailable"))
+
+	scheduler := reminder.NewScheduler(cardRepo, sink, time.Minute, lead, nopLogger{})
+	scheduler.Tick(ctx, now)
+
+	sink.AssertNumberOfCalls(t, "Send", 2)
+	cardRepo.AssertCalled(t, "MarkCardReminded", ctx, sent.ID, due, now)
+	// a failed reminder is left for the next tick
+	cardRepo.AssertNotCalled(t, "MarkCardReminded", ctx, failed.ID, mock.Anything, mock.Anything)
+}
+
+func TestTickWithoutCards(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+
+	cardRepo := new(mocks.CardRepository)
+	sink := new(mocks.Sink)
+
+	cardRepo.On("GetCardsToRemind", ctx, mock.Anything, mock.Anything).Return(nil, errors.New(""))
+
+	scheduler := reminder.NewScheduler(cardRepo, sink, time.Minute, time.Hour, nopLogger{})
+	scheduler.Tick(ctx, now)
+
+	sink.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestRunStopsWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	cardRepo := new(mocks.CardRepository)
+	sink := new(mocks.Sink)
+
+	var ticks atomic.Int32
+	cardRepo.On("GetCardsToRemind", ctx, mock.Anything, mock.Anything).Return([]entity.Card{}, nil).Run(func(mock.Arguments) {
+		ticks.Add(1)
+	})
+
+	scheduler := reminder.NewScheduler(cardRepo, sink, time.Millisecond, time.Hour, nopLogger{})
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return ticks.Load() >= 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop")
+	}
+}
@@ -1,56 +1,384 @@
 package repository
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 	"todo/internal/entity"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Board struct {
-	ID        uuid.UUID `db:"id"`
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Title      string     `db:"title"`
+	IsPublic   bool       `db:"is_public"`
+	IsTemplate bool       `db:"is_template"`
+	ArchivedAt *time.Time `db:"archived_at"`
+	Version    int        `db:"version"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	Deletion
+}
+
+type Column struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	BoardID    uuid.UUID  `db:"board_id"`
+	Title      string     `db:"title"`
+	Position   float64    `db:"position"`
+	ArchivedAt *time.Time `db:"archived_at"`
+	WIPLimit   int        `db:"wip_limit"`
+	WIPStrict  bool       `db:"wip_strict"`
+	Version    int        `db:"version"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	Deletion
+}
+
+type Card struct {
+	ID          uuid.UUID  `db:"id"`
+	UserID      uuid.UUID  `db:"user_id"`
+	ColumnID    uuid.UUID  `db:"column_id"`
+	Title       string     `db:"title"`
+	Description string     `db:"description"`
+	Position    float64    `db:"position"`
+	StartDate   *time.Time `db:"start_date"`
+	DueDate     *time.Time `db:"due_date"`
+	RemindedAt  *time.Time `db:"reminded_at"`
+	Version     int        `db:"version"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	Deletion
+}
+
+// Deletion marks a board, column or card that is in the trash. DeletedWith
+// is the item whose deletion took this one with it.
+type Deletion struct {
+	DeletedAt   *time.Time    `db:"deleted_at"`
+	DeletedBy   uuid.NullUUID `db:"deleted_by"`
+	DeletedWith uuid.NullUUID `db:"deleted_with"`
+}
+
+type ShareToken struct {
+	Token     string    `db:"token"`
+	BoardID   uuid.UUID `db:"board_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type BoardMember struct {
+	BoardID   uuid.UUID `db:"board_id"`
 	UserID    uuid.UUID `db:"user_id"`
-	Title     string    `db:"title"`
+	Role      string    `db:"role"`
+	Accepted  bool      `db:"accepted"`
+	InvitedBy uuid.UUID `db:"invited_by"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
-type Column struct {
+type Label struct {
 	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
 	BoardID   uuid.UUID `db:"board_id"`
+	Name      string    `db:"name"`
+	Color     string    `db:"color"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// CardLabel is a label together with the card it is put on
+type CardLabel struct {
+	CardID uuid.UUID `db:"card_id"`
+	Label
+}
+
+type Checklist struct {
+	ID        uuid.UUID `db:"id"`
+	CardID    uuid.UUID `db:"card_id"`
 	Title     string    `db:"title"`
 	Position  float64   `db:"position"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
-type Card struct {
+type ChecklistItem struct {
 	ID          uuid.UUID `db:"id"`
-	UserID      uuid.UUID `db:"user_id"`
-	ColumnID    uuid.UUID `db:"column_id"`
-	Title       string    `db:"title"`
-	Description string    `db:"description"`
+	ChecklistID uuid.UUID `db:"checklist_id"`
+	Text        string    `db:"text"`
+	Done        bool      `db:"done"`
 	Position    float64   `db:"position"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
 }
 
+// ChecklistProgress is the number of done and all items of a card
+type CardCount struct {
+	ColumnID uuid.UUID `db:"column_id"`
+	Count    int       `db:"count"`
+}
+
+type ChecklistProgress struct {
+	CardID uuid.UUID `db:"card_id"`
+	Done   int       `db:"done"`
+	Total  int       `db:"total"`
+}
+
+type Comment struct {
+	ID        uuid.UUID  `db:"id"`
+	CardID    uuid.UUID  `db:"card_id"`
+	AuthorID  uuid.UUID  `db:"author_id"`
+	Body      string     `db:"body"`
+	CreatedAt time.Time  `db:"created_at"`
+	EditedAt  *time.Time `db:"edited_at"`
+}
+
+// CardAssignee is a user assigned to a card
+type CardAssignee struct {
+	CardID uuid.UUID `db:"card_id"`
+	UserID uuid.UUID `db:"user_id"`
+}
+
+type Attachment struct {
+	ID          uuid.UUID `db:"id"`
+	CardID      uuid.UUID `db:"card_id"`
+	UploaderID  uuid.UUID `db:"uploader_id"`
+	Filename    string    `db:"filename"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	StorageKey  string    `db:"storage_key"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type Activity struct {
+	ID         uuid.UUID    `db:"id"`
+	BoardIDs   UUIDs        `db:"board_ids"`
+	TargetType string       `db:"target_type"`
+	TargetID   uuid.UUID    `db:"target_id"`
+	Action     string       `db:"action"`
+	ActorID    uuid.UUID    `db:"actor_id"`
+	Changes    FieldChanges `db:"changes"`
+	CreatedAt  time.Time    `db:"created_at"`
+}
+
+type OutboxEvent struct {
+	ID            uuid.UUID     `db:"id"`
+	Seq           int64         `db:"seq"`
+	Type          string        `db:"type"`
+	AggregateType string        `db:"aggregate_type"`
+	AggregateID   uuid.UUID     `db:"aggregate_id"`
+	BoardID       uuid.UUID     `db:"board_id"`
+	Payload       OutboxPayload `db:"payload"`
+	Attempts      int           `db:"attempts"`
+	NextAttemptAt time.Time     `db:"next_attempt_at"`
+	LastError     string        `db:"last_error"`
+	CreatedAt     time.Time     `db:"created_at"`
+}
+
+type CardRevision struct {
+	ID           uuid.UUID `db:"id"`
+	CardID       uuid.UUID `db:"card_id"`
+	Number       int       `db:"number"`
+	Title        string    `db:"title"`
+	Description  string    `db:"description"`
+	AuthorID     uuid.UUID `db:"author_id"`
+	RevertedFrom *int      `db:"reverted_from"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// UUIDs is stored as a UUID[] column
+type UUIDs []uuid.UUID
+
+func (u UUIDs) Value() (driver.Value, error) {
+	ids := make([]string, len(u))
+	for i, id := range u {
+		ids[i] = id.String()
+	}
+
+	return pq.StringArray(ids).Value()
+}
+
+func (u *UUIDs) Scan(src any) error {
+	var ids pq.StringArray
+	if err := ids.Scan(src); err != nil {
+		return err
+	}
+
+	*u = make(UUIDs, len(ids))
+	for i, s := range ids {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return err
+		}
+		(*u)[i] = id
+	}
+
+	return nil
+}
+
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// FieldChanges is stored as a JSONB array
+type FieldChanges []FieldChange
+
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = FieldChanges{}
+	}
+
+	return json.Marshal(c)
+}
+
+func (c *FieldChanges) Scan(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, c)
+	case string:
+		return json.Unmarshal([]byte(src), c)
+	default:
+		return fmt.Errorf("cannot scan %T into field changes", src)
+	}
+}
+
+// OutboxPayload is the change an outbox event carries, stored as JSONB
+type OutboxPayload struct {
+	ID         uuid.UUID    `json:"id"`
+	BoardIDs   []uuid.UUID  `json:"board_ids"`
+	TargetType string       `json:"target_type"`
+	TargetID   uuid.UUID    `json:"target_id"`
+	Action     string       `json:"action"`
+	ActorID    uuid.UUID    `json:"actor_id"`
+	Changes    FieldChanges `json:"changes"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+func (p OutboxPayload) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+func (p *OutboxPayload) Scan(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, p)
+	case string:
+		return json.Unmarshal([]byte(src), p)
+	default:
+		return fmt.Errorf("cannot scan %T into outbox payload", src)
+	}
+}
+
+type TrashItem struct {
+	ID        uuid.UUID     `db:"id"`
+	Type      string        `db:"type"`
+	Title     string        `db:"title"`
+	ParentID  uuid.NullUUID `db:"parent_id"`
+	BoardID   uuid.UUID     `db:"board_id"`
+	UserID    uuid.UUID     `db:"user_id"`
+	DeletedBy uuid.UUID     `db:"deleted_by"`
+	DeletedAt time.Time     `db:"deleted_at"`
+}
+
+// CardMatch is a card with the board it is on and how well it matched a
+// search
+type CardMatch struct {
+	Card
+	BoardID             uuid.UUID `db:"board_id"`
+	Rank                float64   `db:"rank"`
+	TitleHeadline       string    `db:"title_headline"`
+	DescriptionHeadline string    `db:"description_headline"`
+}
+
+type Position struct {
+	ID       uuid.UUID `db:"id"`
+	Position float64   `db:"position"`
+}
+
 func RepoBoard(e entity.Board) Board {
 	return Board{
-		ID:        e.ID,
+		ID:         e.ID,
+		UserID:     e.UserID,
+		Title:      e.Title,
+		IsPublic:   e.IsPublic,
+		IsTemplate: e.IsTemplate,
+		ArchivedAt: e.ArchivedAt,
+		Version:    e.Version,
+		CreatedAt:  e.CreatedAt,
+		UpdatedAt:  e.UpdatedAt,
+	}
+}
+
+func RepoColumn(e entity.Column) Column {
+	return Column{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		BoardID:    e.BoardID,
+		Title:      e.Title,
+		Position:   e.Position,
+		ArchivedAt: e.ArchivedAt,
+		WIPLimit:   e.WIPLimit.Max,
+		WIPStrict:  e.WIPLimit.Strict,
+		Version:    e.Version,
+		CreatedAt:  e.CreatedAt,
+		UpdatedAt:  e.UpdatedAt,
+	}
+}
+
+func RepoCard(e entity.Card) Card {
+	return Card{
+		ID:          e.ID,
+		UserID:      e.UserID,
+		ColumnID:    e.ColumnID,
+		Title:       e.Title,
+		Description: e.Description,
+		Position:    e.Position,
+		StartDate:   e.StartDate,
+		DueDate:     e.DueDate,
+		Version:     e.Version,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func RepoShareToken(e entity.ShareToken) ShareToken {
+	return ShareToken{
+		Token:     e.Token,
+		BoardID:   e.BoardID,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func RepoBoardMember(e entity.BoardMember) BoardMember {
+	return BoardMember{
+		BoardID:   e.BoardID,
 		UserID:    e.UserID,
-		Title:     e.Title,
+		Role:      string(e.Role),
+		Accepted:  e.Accepted,
+		InvitedBy: e.InvitedBy,
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
 	}
 }
 
-func RepoColumn(e entity.Column) Column {
-	return Column{
+func RepoLabel(e entity.Label) Label {
+	return Label{
 		ID:        e.ID,
-		UserID:    e.UserID,
 		BoardID:   e.BoardID,
+		Name:      e.Name,
+		Color:     e.Color,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func RepoChecklist(e entity.Checklist) Checklist {
+	return Checklist{
+		ID:        e.ID,
+		CardID:    e.CardID,
 		Title:     e.Title,
 		Position:  e.Position,
 		CreatedAt: e.CreatedAt,
@@ -58,34 +386,151 @@ func RepoColumn(e entity.Column) Column {
 	}
 }
 
-func RepoCard(e entity.Card) Card {
-	return Card{
+func RepoChecklistItem(e entity.ChecklistItem) ChecklistItem {
+	return ChecklistItem{
 		ID:          e.ID,
-		UserID:      e.UserID,
-		ColumnID:    e.ColumnID,
-		Title:       e.Title,
-		Description: e.Description,
+		ChecklistID: e.ChecklistID,
+		Text:        e.Text,
+		Done:        e.Done,
 		Position:    e.Position,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
 	}
 }
 
+func RepoComment(e entity.Comment) Comment {
+	return Comment{
+		ID:        e.ID,
+		CardID:    e.CardID,
+		AuthorID:  e.AuthorID,
+		Body:      e.Body,
+		CreatedAt: e.CreatedAt,
+		EditedAt:  e.EditedAt,
+	}
+}
+
+func RepoAttachment(e entity.Attachment) Attachment {
+	return Attachment{
+		ID:          e.ID,
+		CardID:      e.CardID,
+		UploaderID:  e.UploaderID,
+		Filename:    e.Filename,
+		ContentType: e.ContentType,
+		Size:        e.Size,
+		StorageKey:  e.StorageKey,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func RepoActivity(e entity.Activity) Activity {
+	changes := make(FieldChanges, len(e.Changes))
+	for i, c := range e.Changes {
+		changes[i] = FieldChange(c)
+	}
+
+	return Activity{
+		ID:         e.ID,
+		BoardIDs:   UUIDs(e.BoardIDs),
+		TargetType: string(e.TargetType),
+		TargetID:   e.TargetID,
+		Action:     string(e.Action),
+		ActorID:    e.ActorID,
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func RepoCardRevision(e entity.CardRevision) CardRevision {
+	return CardRevision(e)
+}
+
 func BoardToEntity(r Board) entity.Board {
 	return entity.Board{
-		ID:        r.ID,
+		ID:         r.ID,
+		UserID:     r.UserID,
+		Title:      r.Title,
+		IsPublic:   r.IsPublic,
+		IsTemplate: r.IsTemplate,
+		ArchivedAt: r.ArchivedAt,
+		Version:    r.Version,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+func ColumnToEntity(r Column) entity.Column {
+	return entity.Column{
+		ID:         r.ID,
+		UserID:     r.UserID,
+		BoardID:    r.BoardID,
+		Title:      r.Title,
+		Position:   r.Position,
+		ArchivedAt: r.ArchivedAt,
+		WIPLimit:   entity.WIPLimit{Max: r.WIPLimit, Strict: r.WIPStrict},
+		Version:    r.Version,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+func CardToEntity(r Card) entity.Card {
+	return entity.Card{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		ColumnID:    r.ColumnID,
+		Title:       r.Title,
+		Description: r.Description,
+		Position:    r.Position,
+		StartDate:   r.StartDate,
+		DueDate:     r.DueDate,
+		Version:     r.Version,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func ShareTokenToEntity(r ShareToken) entity.ShareToken {
+	return entity.ShareToken{
+		Token:     r.Token,
+		BoardID:   r.BoardID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func BoardMemberToEntity(r BoardMember) entity.BoardMember {
+	return entity.BoardMember{
+		BoardID:   r.BoardID,
 		UserID:    r.UserID,
-		Title:     r.Title,
+		Role:      entity.MemberRole(r.Role),
+		Accepted:  r.Accepted,
+		InvitedBy: r.InvitedBy,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: r.UpdatedAt,
 	}
 }
 
-func ColumnToEntity(r Column) entity.Column {
-	return entity.Column{
+func PositionToEntity(r Position) entity.Position {
+	return entity.Position{
+		ID:       r.ID,
+		Position: r.Position,
+	}
+}
+
+func LabelToEntity(r Label) entity.Label {
+	return entity.Label{
 		ID:        r.ID,
-		UserID:    r.UserID,
 		BoardID:   r.BoardID,
+		Name:      r.Name,
+		Color:     r.Color,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func ChecklistToEntity(r Checklist) entity.Checklist {
+	return entity.Checklist{
+		ID:        r.ID,
+		CardID:    r.CardID,
 		Title:     r.Title,
 		Position:  r.Position,
 		CreatedAt: r.CreatedAt,
@@ -93,15 +538,137 @@ func ColumnToEntity(r Column) entity.Column {
 	}
 }
 
-func CardToEntity(r Card) entity.Card {
-	return entity.Card{
+func ChecklistItemToEntity(r ChecklistItem) entity.ChecklistItem {
+	return entity.ChecklistItem{
 		ID:          r.ID,
-		UserID:      r.UserID,
-		ColumnID:    r.ColumnID,
-		Title:       r.Title,
-		Description: r.Description,
+		ChecklistID: r.ChecklistID,
+		Text:        r.Text,
+		Done:        r.Done,
 		Position:    r.Position,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 	}
 }
+
+func CommentToEntity(r Comment) entity.Comment {
+	return entity.Comment{
+		ID:        r.ID,
+		CardID:    r.CardID,
+		AuthorID:  r.AuthorID,
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+		EditedAt:  r.EditedAt,
+	}
+}
+
+func AttachmentToEntity(r Attachment) entity.Attachment {
+	return entity.Attachment{
+		ID:          r.ID,
+		CardID:      r.CardID,
+		UploaderID:  r.UploaderID,
+		Filename:    r.Filename,
+		ContentType: r.ContentType,
+		Size:        r.Size,
+		StorageKey:  r.StorageKey,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func RepoOutboxEvent(e entity.OutboxEvent) OutboxEvent {
+	activity := RepoActivity(e.Change)
+
+	return OutboxEvent{
+		ID:            e.ID,
+		Seq:           e.Seq,
+		Type:          e.Type,
+		AggregateType: string(e.AggregateType),
+		AggregateID:   e.AggregateID,
+		BoardID:       e.BoardID,
+		Payload: OutboxPayload{
+			ID:         activity.ID,
+			BoardIDs:   activity.BoardIDs,
+			TargetType: activity.TargetType,
+			TargetID:   activity.TargetID,
+			Action:     activity.Action,
+			ActorID:    activity.ActorID,
+			Changes:    activity.Changes,
+			CreatedAt:  activity.CreatedAt,
+		},
+		Attempts:      e.Attempts,
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func ActivityToEntity(r Activity) entity.Activity {
+	changes := make([]entity.FieldChange, len(r.Changes))
+	for i, c := range r.Changes {
+		changes[i] = entity.FieldChange(c)
+	}
+
+	return entity.Activity{
+		ID:         r.ID,
+		BoardIDs:   []uuid.UUID(r.BoardIDs),
+		TargetType: entity.ActivityTarget(r.TargetType),
+		TargetID:   r.TargetID,
+		Action:     entity.ActivityAction(r.Action),
+		ActorID:    r.ActorID,
+		Changes:    changes,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func OutboxEventToEntity(r OutboxEvent) entity.OutboxEvent {
+	change := ActivityToEntity(Activity{
+		ID:         r.Payload.ID,
+		BoardIDs:   r.Payload.BoardIDs,
+		TargetType: r.Payload.TargetType,
+		TargetID:   r.Payload.TargetID,
+		Action:     r.Payload.Action,
+		ActorID:    r.Payload.ActorID,
+		Changes:    r.Payload.Changes,
+		CreatedAt:  r.Payload.CreatedAt,
+	})
+
+	return entity.OutboxEvent{
+		ID:            r.ID,
+		Seq:           r.Seq,
+		Type:          r.Type,
+		AggregateType: entity.ActivityTarget(r.AggregateType),
+		AggregateID:   r.AggregateID,
+		BoardID:       r.BoardID,
+		Change:        change,
+		Attempts:      r.Attempts,
+		NextAttemptAt: r.NextAttemptAt,
+		LastError:     r.LastError,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func CardRevisionToEntity(r CardRevision) entity.CardRevision {
+	return entity.CardRevision(r)
+}
+
+func TrashItemToEntity(r TrashItem) entity.TrashItem {
+	return entity.TrashItem{
+		ID:        r.ID,
+		Type:      entity.ActivityTarget(r.Type),
+		Title:     r.Title,
+		ParentID:  r.ParentID.UUID,
+		BoardID:   r.BoardID,
+		UserID:    r.UserID,
+		DeletedBy: r.DeletedBy,
+		DeletedAt: r.DeletedAt,
+	}
+}
+
+func CardMatchToEntity(r CardMatch) entity.CardMatch {
+	return entity.CardMatch{
+		Card:                CardToEntity(r.Card),
+		BoardID:             r.BoardID,
+		Rank:                r.Rank,
+		TitleHeadline:       r.TitleHeadline,
+		DescriptionHeadline: r.DescriptionHeadline,
+	}
+}
@@ -2,34 +2,245 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 	"todo/internal/entity"
 
 	"github.com/google/uuid"
 )
 
+var ErrNotFound = errors.New("not found")
+
+// ErrVersionConflict is returned by the conditional updates when the row is
+// no longer at the version of the entity passed in
+var ErrVersionConflict = errors.New("version conflict")
+
+// Transactor runs fn in a single transaction. Repositories take part in it
+// when they are called with the context passed to fn.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type BoardRepository interface {
 	CreateBoard(ctx context.Context, board *entity.Board) error
 	GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error)
-	GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+	// GetBoardsByUser lists either the archived boards of the user or the
+	// others; templates are left out
+	GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit, offset int) ([]entity.Board, error)
+	// GetBoardsPage lists the same boards as GetBoardsByUser a page at a time
+	GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, error)
+	// GetTemplatesByUser lists the templates the user can see that are not
+	// archived
+	GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+	// UpdateBoard only updates the board while it is still at board.Version
 	UpdateBoard(ctx context.Context, board *entity.Board) error
-	DeleteBoard(ctx context.Context, id uuid.UUID) error
+	UpdateBoardVisibility(ctx context.Context, board *entity.Board) error
+	UpdateBoardArchived(ctx context.Context, board *entity.Board) error
+	// DeleteBoard moves the board to the trash together with its columns
+	// and cards; userID is the user who deletes it
+	DeleteBoard(ctx context.Context, id, userID uuid.UUID) error
+	// LockBoard locks the board row until the end of the current transaction
+	LockBoard(ctx context.Context, id uuid.UUID) error
 }
 
 type ColumnRepository interface {
 	CreateColumn(ctx context.Context, column *entity.Column) error
 	GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error)
-	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error)
+	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit, offset int) ([]entity.Column, error)
+	GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, error)
+	// UpdateColumn only updates the column while it is still at
+	// column.Version
 	UpdateColumn(ctx context.Context, column *entity.Column) error
-	DeleteColumn(ctx context.Context, id uuid.UUID) error
+	UpdateColumnArchived(ctx context.Context, column *entity.Column) error
+	SetColumnWIPLimit(ctx context.Context, column *entity.Column) error
+	// DeleteColumn moves the column to the trash together with its cards
+	DeleteColumn(ctx context.Context, id, userID uuid.UUID) error
+	// LockColumn locks the column row until the end of the current transaction
+	LockColumn(ctx context.Context, id uuid.UUID) error
+	GetColumnPositions(ctx context.Context, boardID uuid.UUID) ([]entity.Position, error)
+	UpdateColumnPosition(ctx context.Context, id uuid.UUID, position float64) error
 }
 
 type CardRepository interface {
 	CreateCard(ctx context.Context, card *entity.Card) error
 	GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error)
-	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error)
+	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit, offset int) ([]entity.Card, error)
+	GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, error)
 	GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	// UpdateCard only updates the card while it is still at card.Version
 	UpdateCard(ctx context.Context, card *entity.Card) error
 	MoveCard(ctx context.Context, card *entity.Card) error
-	DeleteCard(ctx context.Context, id uuid.UUID) error
+	DeleteCard(ctx context.Context, id, userID uuid.UUID) error
+	// LockCard locks the card row until the end of the current transaction
+	LockCard(ctx context.Context, id uuid.UUID) error
+	GetCardPositions(ctx context.Context, columnID uuid.UUID) ([]entity.Position, error)
+	UpdateCardPosition(ctx context.Context, id uuid.UUID, position float64) error
+	// CountCardsByColumns returns the number of cards of every column that
+	// has cards keyed by column id
+	CountCardsByColumns(ctx context.Context, columnIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	// SetCardDates also clears the reminder of the card if its due date changes
+	SetCardDates(ctx context.Context, card *entity.Card) error
+	// GetDueCardsByUser returns the cards on the boards of the user that are
+	// due in [from, to); a zero from means no lower bound
+	GetDueCardsByUser(ctx context.Context, userID uuid.UUID, from, to time.Time, limit, offset int) ([]entity.Card, error)
+	// GetCardsToRemind returns the cards due in (from, to] that have not been
+	// reminded of yet
+	GetCardsToRemind(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	// MarkCardReminded records the reminder unless the due date of the card
+	// has changed in the meantime
+	MarkCardReminded(ctx context.Context, id uuid.UUID, dueDate, at time.Time) error
+	// GetCardsByAssignee returns the cards the user is assigned to on the
+	// boards they can still see
+	GetCardsByAssignee(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error)
+	// SearchCards returns the cards on the boards of the user that match the
+	// search, best matches first. Archived boards and columns and templates
+	// are left out.
+	SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit, offset int) ([]entity.CardMatch, error)
+}
+
+// TrashRepository works with the items deleted by BoardRepository,
+// ColumnRepository and CardRepository. The other repositories do not see
+// anything that is in the trash.
+type TrashRepository interface {
+	// GetTrashByUser returns the items the user deleted and the items
+	// deleted from the boards the user owns, the most recent first
+	GetTrashByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.TrashItem, error)
+	GetTrashItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error)
+	// RestoreItem brings back the item and everything deleted along with it
+	RestoreItem(ctx context.Context, id uuid.UUID) error
+	// PurgeItems removes the items deleted before the given moment for good.
+	// It returns the storage keys of the attachments that went with them.
+	PurgeItems(ctx context.Context, before time.Time) ([]string, error)
+}
+
+type ShareTokenRepository interface {
+	CreateShareToken(ctx context.Context, token *entity.ShareToken) error
+	GetShareToken(ctx context.Context, token string) (*entity.ShareToken, error)
+	GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error)
+	DeleteShareToken(ctx context.Context, token string) error
+}
+
+type BoardMemberRepository interface {
+	AddMember(ctx context.Context, member *entity.BoardMember) error
+	GetMember(ctx context.Context, boardID, userID uuid.UUID) (*entity.BoardMember, error)
+	GetMembersByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error)
+	GetInvitationsByUser(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error)
+	UpdateMember(ctx context.Context, member *entity.BoardMember) error
+	DeleteMember(ctx context.Context, boardID, userID uuid.UUID) error
+}
+
+type LabelRepository interface {
+	CreateLabel(ctx context.Context, label *entity.Label) error
+	GetLabelByID(ctx context.Context, id uuid.UUID) (*entity.Label, error)
+	GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error)
+	UpdateLabel(ctx context.Context, label *entity.Label) error
+	DeleteLabel(ctx context.Context, id uuid.UUID) error
+	// AttachLabel puts the label on the card; attaching it twice is a no-op
+	AttachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+	DetachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+	// DetachForeignLabels removes the labels of other boards from the card
+	DetachForeignLabels(ctx context.Context, cardID, boardID uuid.UUID) error
+	// GetLabelsByCards returns the labels of every card keyed by card id
+	GetLabelsByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]entity.Label, error)
+}
+
+type ChecklistRepository interface {
+	CreateChecklist(ctx context.Context, checklist *entity.Checklist) error
+	GetChecklistByID(ctx context.Context, id uuid.UUID) (*entity.Checklist, error)
+	// GetChecklistsByCard returns the checklists of the card without items
+	GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error)
+	UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error
+	DeleteChecklist(ctx context.Context, id uuid.UUID) error
+	// LockChecklist locks the checklist row until the end of the current transaction
+	LockChecklist(ctx context.Context, id uuid.UUID) error
+	GetChecklistPositions(ctx context.Context, cardID uuid.UUID) ([]entity.Position, error)
+
+	CreateItem(ctx context.Context, item *entity.ChecklistItem) error
+	GetItemByID(ctx context.Context, id uuid.UUID) (*entity.ChecklistItem, error)
+	// GetItemsByCard returns the items of all checklists of the card
+	GetItemsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.ChecklistItem, error)
+	UpdateItem(ctx context.Context, item *entity.ChecklistItem) error
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	GetItemPositions(ctx context.Context, checklistID uuid.UUID) ([]entity.Position, error)
+
+	// GetProgressByCards returns the checklist progress of every card that
+	// has checklist items keyed by card id
+	GetProgressByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID]entity.ChecklistProgress, error)
+}
+
+type CommentRepository interface {
+	CreateComment(ctx context.Context, comment *entity.Comment) error
+	GetCommentByID(ctx context.Context, id uuid.UUID) (*entity.Comment, error)
+	// GetCommentsByCard returns a page of the thread of the card, oldest first
+	GetCommentsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Comment, error)
+	UpdateComment(ctx context.Context, comment *entity.Comment) error
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+}
+
+type AssigneeRepository interface {
+	// AddAssignee assigns the user to the card; assigning them twice is a no-op
+	AddAssignee(ctx context.Context, cardID, userID uuid.UUID) error
+	RemoveAssignee(ctx context.Context, cardID, userID uuid.UUID) error
+	// GetAssigneesByCards returns the assigned user ids of every card keyed
+	// by card id
+	GetAssigneesByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment *entity.Attachment) error
+	GetAttachmentByID(ctx context.Context, id uuid.UUID) (*entity.Attachment, error)
+	// GetAttachmentsByCard returns the attachments of the card, oldest first
+	GetAttachmentsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Attachment, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+}
+
+// ActivityRepository is append-only: entries are never changed or removed
+type ActivityRepository interface {
+	CreateActivity(ctx context.Context, activity *entity.Activity) error
+	// GetActivityByBoard returns the entries of the board, newest first
+	GetActivityByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+	// GetActivityByTarget returns the entries of a board, column or card,
+	// newest first
+	GetActivityByTarget(ctx context.Context, targetID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+}
+
+// RevisionRepository keeps the revision history of cards
+type RevisionRepository interface {
+	// CreateRevision numbers the revision right after the latest revision of
+	// its card
+	CreateRevision(ctx context.Context, revision *entity.CardRevision) error
+	// GetRevision returns the revision of the card with the given number
+	GetRevision(ctx context.Context, cardID uuid.UUID, number int) (*entity.CardRevision, error)
+	// GetRevisionsByCard returns the revisions of the card, newest first
+	GetRevisionsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.CardRevision, error)
+}
+
+// OutboxRepository keeps the domain events written along with the changes
+// they describe until they are delivered
+type OutboxRepository interface {
+	CreateOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error
+	// GetDueOutboxEvents returns the oldest events due at the given moment in
+	// the order they were written. An event is left out along with the later
+	// events of its aggregate while its next attempt is not due.
+	GetDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error)
+	DeleteOutboxEvent(ctx context.Context, id uuid.UUID) error
+	// RescheduleOutboxEvent saves the attempts, the next attempt and the last
+	// error of a failed delivery
+	RescheduleOutboxEvent(ctx context.Context, event *entity.OutboxEvent) error
+	// LockOutbox takes the outbox for the rest of the transaction, so that a
+	// single relay delivers at a time. It returns false right away when the
+	// outbox is taken.
+	LockOutbox(ctx context.Context) (bool, error)
+}
+
+// BlobStore keeps the content of attachments
+type BlobStore interface {
+	// Put stores exactly size bytes read from content under the key
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get returns ErrNotFound when there is nothing under the key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content under the key; deleting a missing key is a
+	// no-op
+	Delete(ctx context.Context, key string) error
 }
@@ -0,0 +1,75 @@
+package trash
+
+import (
+	"context"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/repository"
+)
+
+// Purger periodically removes the items that have been in the trash for
+// longer than the retention period, along with the content of their
+// attachments
+type Purger struct {
+	trashRepo repository.TrashRepository
+	blobStore repository.BlobStore
+	tx        repository.Transactor
+	interval  time.Duration
+	retention time.Duration
+	log       logger.Logger
+}
+
+func NewPurger(trashRepo repository.TrashRepository, blobStore repository.BlobStore, tx repository.Transactor, interval, retention time.Duration, log logger.Logger) *Purger {
+	return &Purger{
+		trashRepo: trashRepo,
+		blobStore: blobStore,
+		tx:        tx,
+		interval:  interval,
+		retention: retention,
+		log:       log,
+	}
+}
+
+// Run ticks right away and then every interval until the context is done
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.Tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick purges what was deleted more than the retention period before the
+// given moment. Attachment content is removed once the rows are gone, on a
+// best-effort basis like DeleteAttachment does.
+func (p *Purger) Tick(ctx context.Context, now time.Time) {
+	header := "TrashPurger: "
+
+	var keys []string
+
+	err := p.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		keys, err = p.trashRepo.PurgeItems(ctx, now.Add(-p.retention))
+		return err
+	})
+
+	if err != nil {
+		p.log.Error(ctx, header+"Failed to purge trash", "err", err.Error())
+		return
+	}
+
+	for _, key := range keys {
+		if err := p.blobStore.Delete(ctx, key); err != nil {
+			p.log.Warn(ctx, header+"Failed to delete attachment content", "key", key, "err", err.Error())
+		}
+	}
+
+	p.log.Info(ctx, header+"Trash purged", "attachments", len(keys))
+}
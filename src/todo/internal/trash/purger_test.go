@@ -0,0 +1,62 @@
+package trash_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"todo/internal/common/logger"
+	"todo/internal/trash"
+	"todo/mocks"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+type nopTransactor struct{}
+
+func (nopTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestTick(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+
+	trashRepo := new(mocks.TrashRepository)
+	blobStore := new(mocks.BlobStore)
+
+	trashRepo.On("PurgeItems", ctx, now.Add(-retention)).Return([]string{"kept", "gone"}, nil)
+	blobStore.On("Delete", ctx, "kept").Return(errors.New("unavailable"))
+	blobStore.On("Delete", ctx, "gone").Return(nil)
+
+	purger := trash.NewPurger(trashRepo, blobStore, nopTransactor{}, time.Hour, retention, nopLogger{})
+	purger.Tick(ctx, now)
+
+	// a failed blob does not stop the rest from being deleted
+	blobStore.AssertCalled(t, "Delete", ctx, "kept")
+	blobStore.AssertCalled(t, "Delete", ctx, "gone")
+}
+
+func TestTickWhenPurgeFails(t *testing.T) {
+	ctx := context.TODO()
+
+	trashRepo := new(mocks.TrashRepository)
+	blobStore := new(mocks.BlobStore)
+
+	trashRepo.On("PurgeItems", ctx, mock.Anything).Return(nil, errors.New(""))
+
+	purger := trash.NewPurger(trashRepo, blobStore, nopTransactor{}, time.Hour, time.Hour, nopLogger{})
+	purger.Tick(ctx, time.Now())
+
+	blobStore.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
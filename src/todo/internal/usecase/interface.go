@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"io"
 	"time"
 	"todo/internal/entity"
 
@@ -11,20 +12,118 @@ import (
 type TodoUseCase interface {
 	CreateBoard(ctx context.Context, board *entity.Board) error
 	GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error)
-	GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+	GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit, offset int) ([]entity.Board, error)
+	// GetBoardsPage returns a page of the user's boards and the cursor of the
+	// next page, which is nil on the last page
+	GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, *entity.Cursor, error)
+	// UpdateBoard fails with ErrVersionConflict when board.Version is set and
+	// the board has moved past it
 	UpdateBoard(ctx context.Context, board *entity.Board) error
 	DeleteBoard(ctx context.Context, id uuid.UUID) error
+	SetBoardPublic(ctx context.Context, id uuid.UUID, isPublic bool) error
+	ArchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error)
+	UnarchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error)
+	CloneBoard(ctx context.Context, id uuid.UUID, opts entity.CloneOptions) (*entity.Board, error)
+	GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+	ExportBoard(ctx context.Context, id uuid.UUID) (*entity.BoardExport, error)
+	ImportBoard(ctx context.Context, export *entity.BoardExport) (*entity.Board, error)
+	ImportTrelloBoard(ctx context.Context, trello *entity.TrelloBoard, dryRun bool) (*entity.ImportSummary, error)
 
 	CreateColumn(ctx context.Context, column *entity.Column) error
 	GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error)
-	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error)
+	GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit, offset int) ([]entity.Column, error)
+	GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, *entity.Cursor, error)
+	// UpdateColumn fails with ErrVersionConflict when column.Version is set and
+	// the column has moved past it
 	UpdateColumn(ctx context.Context, column *entity.Column) error
 	DeleteColumn(ctx context.Context, id uuid.UUID) error
+	RepositionColumn(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Column, error)
+	ArchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error)
+	UnarchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error)
+	// SetColumnWIPLimit replaces the WIP limit of the column; a zero Max
+	// lifts it
+	SetColumnWIPLimit(ctx context.Context, id uuid.UUID, limit entity.WIPLimit) (*entity.Column, error)
 
 	CreateCard(ctx context.Context, card *entity.Card) error
 	GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error)
-	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error)
+	GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit, offset int) ([]entity.Card, error)
+	GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, *entity.Cursor, error)
 	GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	// UpdateCard fails with ErrVersionConflict when card.Version is set and
+	// the card has moved past it
 	UpdateCard(ctx context.Context, card *entity.Card) error
 	DeleteCard(ctx context.Context, id uuid.UUID) error
+	RepositionCard(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Card, error)
+	MoveCard(ctx context.Context, id uuid.UUID, move entity.CardMove) (*entity.Card, error)
+	SetCardDates(ctx context.Context, id uuid.UUID, dates entity.CardDates) (*entity.Card, error)
+	GetOverdueCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error)
+	GetDueCards(ctx context.Context, userID uuid.UUID, days, limit, offset int) ([]entity.Card, error)
+	AssignCard(ctx context.Context, cardID, userID uuid.UUID) error
+	UnassignCard(ctx context.Context, cardID, userID uuid.UUID) error
+	GetAssignedCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error)
+	SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit, offset int) ([]entity.CardMatch, error)
+	// GetCardRevisions returns the revisions of the card newest first
+	GetCardRevisions(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.CardRevision, error)
+	DiffCardRevisions(ctx context.Context, cardID uuid.UUID, from, to int) (*entity.RevisionDiff, error)
+	// RevertCard restores the title and description of a revision as a new
+	// revision of the card
+	RevertCard(ctx context.Context, cardID uuid.UUID, number int) (*entity.Card, error)
+
+	UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) error
+	// GetAttachment returns the metadata and the content of the attachment;
+	// the caller has to close the content
+	GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+
+	// GetBoardActivity and GetCardActivity return the activity log newest
+	// first; the log of a deleted card stays readable to the viewers of the
+	// board it was deleted from
+	GetBoardActivity(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+	GetCardActivity(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+	// SubscribeBoard streams the changes to the board, its columns and cards
+	// until ctx is done; lastEventID resumes after an event already seen
+	SubscribeBoard(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error)
+
+	// Deleting a board, column or card moves it to the trash, where it can
+	// be restored from until it is purged
+	GetTrash(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.TrashItem, error)
+	RestoreItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error)
+
+	// RunBatch runs creates, updates, moves and deletes of boards, columns
+	// and cards in order in one transaction and reports on each of them
+	RunBatch(ctx context.Context, mode entity.BatchMode, ops []entity.BatchOp) ([]entity.BatchResult, error)
+
+	CreateShareToken(ctx context.Context, boardID uuid.UUID) (*entity.ShareToken, error)
+	GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error)
+	RevokeShareToken(ctx context.Context, boardID uuid.UUID, token string) error
+	GetSharedBoard(ctx context.Context, token string) (*entity.BoardTree, error)
+	GetSharedCard(ctx context.Context, token string, cardID uuid.UUID) (*entity.Card, error)
+
+	InviteMember(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error)
+	AcceptInvitation(ctx context.Context, boardID uuid.UUID) (*entity.BoardMember, error)
+	UpdateMemberRole(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error)
+	RemoveMember(ctx context.Context, boardID, userID uuid.UUID) error
+	GetBoardMembers(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error)
+	GetInvitations(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error)
+
+	CreateLabel(ctx context.Context, label *entity.Label) error
+	GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error)
+	UpdateLabel(ctx context.Context, label *entity.Label) error
+	DeleteLabel(ctx context.Context, id uuid.UUID) error
+	AttachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+	DetachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+
+	CreateChecklist(ctx context.Context, checklist *entity.Checklist) error
+	GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error)
+	UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error
+	DeleteChecklist(ctx context.Context, id uuid.UUID) error
+	CreateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error
+	UpdateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error
+	SetChecklistItemDone(ctx context.Context, id uuid.UUID, done bool) (*entity.ChecklistItem, error)
+	DeleteChecklistItem(ctx context.Context, id uuid.UUID) error
+
+	CreateComment(ctx context.Context, comment *entity.Comment) error
+	GetCommentsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Comment, error)
+	UpdateComment(ctx context.Context, comment *entity.Comment) error
+	DeleteComment(ctx context.Context, id uuid.UUID) error
 }
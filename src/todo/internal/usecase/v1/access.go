@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNoCaller  = errors.New("caller identity is missing")
+	ErrForbidden = errors.New("access denied")
+	ErrArchived  = errors.New("board or column is archived")
+)
+
+func callerFromContext(ctx context.Context) (identity.Caller, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return identity.Caller{}, ErrNoCaller
+	}
+
+	return caller, nil
+}
+
+// authorizeUser checks that the caller acts on their own behalf
+func authorizeUser(ctx context.Context, userID uuid.UUID) error {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if caller.IsAdmin() || caller.UserID == userID {
+		return nil
+	}
+
+	return ErrForbidden
+}
+
+func requireAdmin(ctx context.Context) error {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !caller.IsAdmin() {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// authorizeBoard loads the board and checks that the caller holds at least
+// the required role on it. The board is loaded for admins too, so a missing
+// board is always reported as not found rather than forbidden. An archived
+// board is read-only, so editor and owner access to it is refused.
+func (uc *todoUseCase) authorizeBoard(ctx context.Context, boardID uuid.UUID, required entity.MemberRole) (*entity.Board, error) {
+	board, err := uc.boardAccess(ctx, boardID, required)
+	if err != nil {
+		return nil, err
+	}
+
+	if required.Includes(entity.RoleEditor) && board.ArchivedAt != nil {
+		return nil, ErrArchived
+	}
+
+	return board, nil
+}
+
+// boardAccess is authorizeBoard without the archive check. It guards the
+// operations that stay allowed on an archived board: archiving itself,
+// deletion, sharing and membership.
+func (uc *todoUseCase) boardAccess(ctx context.Context, boardID uuid.UUID, required entity.MemberRole) (*entity.Board, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if caller.IsAdmin() {
+		return board, nil
+	}
+
+	role, err := uc.boardRole(ctx, board, caller.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.Includes(required) {
+		return nil, ErrForbidden
+	}
+
+	return board, nil
+}
+
+// boardRole returns the role the user holds on the board. The board creator
+// is always its owner; pending invitations grant nothing.
+func (uc *todoUseCase) boardRole(ctx context.Context, board *entity.Board, userID uuid.UUID) (entity.MemberRole, error) {
+	if board.UserID == userID {
+		return entity.RoleOwner, nil
+	}
+
+	member, err := uc.memberRepo.GetMember(ctx, board.ID, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", ErrForbidden
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if !member.Accepted {
+		return "", ErrForbidden
+	}
+
+	return member.Role, nil
+}
+
+// authorizeColumn checks the access to the board of the column. Like the
+// board, an archived column is read-only.
+func (uc *todoUseCase) authorizeColumn(ctx context.Context, columnID uuid.UUID, required entity.MemberRole) (*entity.Column, error) {
+	column, err := uc.columnAccess(ctx, columnID, required)
+	if err != nil {
+		return nil, err
+	}
+
+	if required.Includes(entity.RoleEditor) && column.ArchivedAt != nil {
+		return nil, ErrArchived
+	}
+
+	return column, nil
+}
+
+// columnAccess is authorizeColumn without the archive check on the column
+// itself; the board still has to be writable for editor access.
+func (uc *todoUseCase) columnAccess(ctx context.Context, columnID uuid.UUID, required entity.MemberRole) (*entity.Column, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeBoard(ctx, column.BoardID, required); err != nil {
+		return nil, err
+	}
+
+	return column, nil
+}
+
+func (uc *todoUseCase) authorizeCard(ctx context.Context, cardID uuid.UUID, required entity.MemberRole) (*entity.Card, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	card, err := uc.cardRepo.GetCardByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeColumn(ctx, card.ColumnID, required); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// requireWritableColumn refuses changes in an archived column or board. It
+// is for the writes that need less than editor access, such as editing a
+// comment of one's own.
+func (uc *todoUseCase) requireWritableColumn(ctx context.Context, columnID uuid.UUID) error {
+	column, err := uc.columnRepo.GetColumnByID(ctx, columnID)
+	if err != nil {
+		return err
+	}
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, column.BoardID)
+	if err != nil {
+		return err
+	}
+
+	if column.ArchivedAt != nil || board.ArchivedAt != nil {
+		return ErrArchived
+	}
+
+	return nil
+}
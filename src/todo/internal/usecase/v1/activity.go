@@ -0,0 +1,255 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func (uc *todoUseCase) GetBoardActivity(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Activity, error) {
+	header := "GetBoardActivity: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "boardID", boardID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to activity repo (GetActivityByBoard)", "boardID", boardID)
+
+	activities, err := uc.activityRepo.GetActivityByBoard(ctx, boardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get activity"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got activity", "count", len(activities))
+
+	return activities, nil
+}
+
+func (uc *todoUseCase) GetCardActivity(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Activity, error) {
+	header := "GetCardActivity: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "cardID", cardID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = uc.authorizeCardActivity(ctx, cardID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to activity repo (GetActivityByTarget)", "cardID", cardID)
+
+	activities, err := uc.activityRepo.GetActivityByTarget(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get activity"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got activity", "count", len(activities))
+
+	return activities, nil
+}
+
+// authorizeCardActivity lets viewers of the board of the card read its log.
+// A deleted card is checked against the board it was deleted from, so that
+// its log can still tell who deleted it.
+func (uc *todoUseCase) authorizeCardActivity(ctx context.Context, cardID uuid.UUID) error {
+	_, err := uc.authorizeCard(ctx, cardID, entity.RoleViewer)
+	if !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	latest, err := uc.activityRepo.GetActivityByTarget(ctx, cardID, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	if len(latest) == 0 || latest[0].TargetType != entity.ActivityTargetCard || len(latest[0].BoardIDs) == 0 {
+		return repository.ErrNotFound
+	}
+
+	_, err = uc.authorizeBoard(ctx, latest[0].BoardIDs[0], entity.RoleViewer)
+
+	return err
+}
+
+// recordActivity appends an entry to the activity log on behalf of the
+// caller. It is called with the context of the transaction that makes the
+// change, so that the change is never committed without its entry, nor
+// without the domain events that carry it to the change feed of its boards.
+// Updates and moves that change nothing are not recorded.
+func (uc *todoUseCase) recordActivity(ctx context.Context, action entity.ActivityAction, target entity.ActivityTarget, targetID uuid.UUID, boardIDs []uuid.UUID, changes []entity.FieldChange) error {
+	if len(changes) == 0 && (action == entity.ActivityUpdate || action == entity.ActivityMove) {
+		return nil
+	}
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	activity := &entity.Activity{
+		ID:         uuid.New(),
+		BoardIDs:   boardIDs,
+		TargetType: target,
+		TargetID:   targetID,
+		Action:     action,
+		ActorID:    caller.UserID,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := uc.activityRepo.CreateActivity(ctx, activity); err != nil {
+		return err
+	}
+
+	return uc.outboxRepo.CreateOutboxEvents(ctx, outboxEvents(activity))
+}
+
+// outboxEvents are the domain events of an activity, one for each board whose
+// feed shows it
+func outboxEvents(activity *entity.Activity) []entity.OutboxEvent {
+	events := make([]entity.OutboxEvent, len(activity.BoardIDs))
+	for i, boardID := range activity.BoardIDs {
+		events[i] = entity.OutboxEvent{
+			ID:            uuid.New(),
+			Type:          entity.OutboxEventType(activity.TargetType, activity.Action),
+			AggregateType: activity.TargetType,
+			AggregateID:   activity.TargetID,
+			BoardID:       boardID,
+			Change:        *activity,
+			NextAttemptAt: activity.CreatedAt,
+			CreatedAt:     activity.CreatedAt,
+		}
+	}
+
+	return events
+}
+
+// boardOfCard returns the board the card is on
+func (uc *todoUseCase) boardOfCard(ctx context.Context, card *entity.Card) (uuid.UUID, error) {
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return column.BoardID, nil
+}
+
+// trackedFields are the values of the fields the activity log keeps track
+// of, formatted for display; a nil map stands for a missing item
+type trackedFields map[string]*string
+
+func boardFields(board *entity.Board) trackedFields {
+	return trackedFields{
+		"title":     stringField(board.Title),
+		"is_public": stringField(strconv.FormatBool(board.IsPublic)),
+	}
+}
+
+func columnFields(column *entity.Column) trackedFields {
+	return trackedFields{
+		"title":      stringField(column.Title),
+		"position":   floatField(column.Position),
+		"wip_limit":  wipLimitField(column.WIPLimit.Max),
+		"wip_strict": stringField(strconv.FormatBool(column.WIPLimit.Strict)),
+	}
+}
+
+func cardFields(card *entity.Card) trackedFields {
+	return trackedFields{
+		"title":       stringField(card.Title),
+		"description": stringField(card.Description),
+		"column_id":   stringField(card.ColumnID.String()),
+		"position":    floatField(card.Position),
+		"start_date":  timeField(card.StartDate),
+		"due_date":    timeField(card.DueDate),
+	}
+}
+
+func stringField(s string) *string {
+	return &s
+}
+
+func floatField(f float64) *string {
+	return stringField(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// wipLimitField leaves out the limit of a column that has none
+func wipLimitField(max int) *string {
+	if max == 0 {
+		return nil
+	}
+
+	return stringField(strconv.Itoa(max))
+}
+
+func timeField(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	return stringField(t.UTC().Format(time.RFC3339))
+}
+
+// fieldChanges lists the fields whose values differ, sorted by name
+func fieldChanges(before, after trackedFields) []entity.FieldChange {
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []entity.FieldChange
+	for _, name := range names {
+		b, a := before[name], after[name]
+
+		if b == nil && a == nil || b != nil && a != nil && *b == *a {
+			continue
+		}
+
+		changes = append(changes, entity.FieldChange{Field: name, Before: b, After: a})
+	}
+
+	return changes
+}
@@ -0,0 +1,293 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCardUpdateIsRecorded(t *testing.T) {
+	ts := setup()
+	caller, _ := identity.FromContext(ts.ctx)
+
+	t.Run("only changed fields", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		boardID := ts.mockCardOnBoard(cardID)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.Anything).Return(nil)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, &entity.Card{ID: cardID, Title: "Renamed"})
+
+		assert.Nil(t, err)
+		ts.mockActivityRepo.AssertCalled(t, "CreateActivity", ts.ctx, mock.MatchedBy(func(a *entity.Activity) bool {
+			return a.TargetID == cardID &&
+				a.TargetType == entity.ActivityTargetCard &&
+				a.Action == entity.ActivityUpdate &&
+				a.ActorID == caller.UserID &&
+				assert.ObjectsAreEqual([]uuid.UUID{boardID}, a.BoardIDs) &&
+				len(a.Changes) == 1 &&
+				a.Changes[0].Field == "title" &&
+				*a.Changes[0].Before == "" &&
+				*a.Changes[0].After == "Renamed"
+		}))
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		ts.mockCardOnBoard(cardID)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.Anything).Return(nil)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, &entity.Card{ID: cardID})
+
+		assert.Nil(t, err)
+		ts.mockActivityRepo.AssertNotCalled(t, "CreateActivity", ts.ctx, mock.MatchedBy(func(a *entity.Activity) bool {
+			return a.TargetID == cardID
+		}))
+	})
+}
+
+func TestCardDeleteIsRecorded(t *testing.T) {
+	ts := setup()
+
+	cardID := uuid.New()
+	boardID := ts.mockCardOnBoard(cardID)
+	ts.mockCardRepo.On("DeleteCard", ts.ctx, cardID, mock.Anything).Return(nil)
+
+	err := ts.todoUseCase.DeleteCard(ts.ctx, cardID)
+
+	assert.Nil(t, err)
+	ts.mockActivityRepo.AssertCalled(t, "CreateActivity", ts.ctx, mock.MatchedBy(func(a *entity.Activity) bool {
+		return a.TargetID == cardID &&
+			a.Action == entity.ActivityDelete &&
+			assert.ObjectsAreEqual([]uuid.UUID{boardID}, a.BoardIDs)
+	}))
+}
+
+func TestCrossBoardMoveIsRecordedOnBothBoards(t *testing.T) {
+	ts := setup()
+
+	cardID, sourceID, targetID := uuid.New(), uuid.New(), uuid.New()
+	sourceBoardID, targetBoardID := uuid.New(), uuid.New()
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: sourceID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, sourceID).Return(&entity.Column{ID: sourceID, BoardID: sourceBoardID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, targetID).Return(&entity.Column{ID: targetID, BoardID: targetBoardID}, nil)
+	ts.mockBoardAccess(sourceBoardID)
+	ts.mockBoardAccess(targetBoardID)
+	ts.mockColumnRepo.On("LockColumn", ts.ctx, mock.Anything).Return(nil)
+	ts.mockCardRepo.On("GetCardPositions", ts.ctx, targetID).Return([]entity.Position{}, nil)
+	ts.mockCardRepo.On("MoveCard", ts.ctx, mock.Anything).Return(nil)
+	ts.mockLabelRepo.On("DetachForeignLabels", ts.ctx, cardID, targetBoardID).Return(nil)
+
+	_, err := ts.todoUseCase.MoveCard(ts.ctx, cardID, entity.CardMove{ColumnID: targetID})
+
+	assert.Nil(t, err)
+	ts.mockActivityRepo.AssertCalled(t, "CreateActivity", ts.ctx, mock.MatchedBy(func(a *entity.Activity) bool {
+		return a.TargetID == cardID &&
+			a.Action == entity.ActivityMove &&
+			assert.ObjectsAreEqual([]uuid.UUID{targetBoardID, sourceBoardID}, a.BoardIDs)
+	}))
+}
+
+func TestChangeFailsWithoutItsActivity(t *testing.T) {
+	ts := setup()
+	ts.mockActivityRepo.ExpectedCalls = nil
+	ts.mockActivityRepo.On("CreateActivity", ts.ctx, mock.Anything).Return(errors.New("disk full"))
+	ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+
+	err := ts.todoUseCase.CreateBoard(ts.ctx, &entity.Board{UserID: uuid.New(), Title: "Board"})
+
+	assert.EqualError(t, err, "CreateBoard: Failed to create board: disk full")
+}
+
+func TestCrossBoardMoveWritesOutboxEventForBothBoards(t *testing.T) {
+	ts := setup()
+
+	cardID, sourceID, targetID := uuid.New(), uuid.New(), uuid.New()
+	sourceBoardID, targetBoardID := uuid.New(), uuid.New()
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: sourceID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, sourceID).Return(&entity.Column{ID: sourceID, BoardID: sourceBoardID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, targetID).Return(&entity.Column{ID: targetID, BoardID: targetBoardID}, nil)
+	ts.mockBoardAccess(sourceBoardID)
+	ts.mockBoardAccess(targetBoardID)
+	ts.mockColumnRepo.On("LockColumn", ts.ctx, mock.Anything).Return(nil)
+	ts.mockCardRepo.On("GetCardPositions", ts.ctx, targetID).Return([]entity.Position{}, nil)
+	ts.mockCardRepo.On("MoveCard", ts.ctx, mock.Anything).Return(nil)
+	ts.mockLabelRepo.On("DetachForeignLabels", ts.ctx, cardID, targetBoardID).Return(nil)
+
+	_, err := ts.todoUseCase.MoveCard(ts.ctx, cardID, entity.CardMove{ColumnID: targetID})
+
+	assert.Nil(t, err)
+	ts.mockOutboxRepo.AssertCalled(t, "CreateOutboxEvents", ts.ctx, mock.MatchedBy(func(events []entity.OutboxEvent) bool {
+		if len(events) != 2 {
+			return false
+		}
+
+		for _, e := range events {
+			if e.Type != "CardMoved" || e.AggregateType != entity.ActivityTargetCard || e.AggregateID != cardID || e.Change.TargetID != cardID {
+				return false
+			}
+		}
+
+		return events[0].BoardID == targetBoardID && events[1].BoardID == sourceBoardID &&
+			events[0].ID != events[1].ID
+	}))
+}
+
+func TestChangeFailsWithoutItsOutboxEvents(t *testing.T) {
+	ts := setup()
+	ts.mockOutboxRepo.ExpectedCalls = nil
+	ts.mockOutboxRepo.On("CreateOutboxEvents", ts.ctx, mock.Anything).Return(errors.New("disk full"))
+	ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+
+	err := ts.todoUseCase.CreateBoard(ts.ctx, &entity.Board{UserID: uuid.New(), Title: "Board"})
+
+	assert.EqualError(t, err, "CreateBoard: Failed to create board: disk full")
+}
+
+// GetBoardActivity(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+func TestGetBoardActivity(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		limit      int
+		mockRepoFn func(boardID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success",
+			limit: 10,
+			mockRepoFn: func(boardID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockActivityRepo.On("GetActivityByBoard", ts.ctx, boardID, 10, 0).Return([]entity.Activity{{ID: uuid.New()}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "zero limit",
+			limit:      0,
+			mockRepoFn: func(boardID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrZeroLimit,
+		},
+		{
+			name:  "board not found",
+			limit: 10,
+			mockRepoFn: func(boardID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			tt.mockRepoFn(boardID)
+
+			activities, err := ts.todoUseCase.GetBoardActivity(ts.ctx, boardID, tt.limit, 0)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, activities)
+			} else {
+				assert.Nil(t, err)
+				assert.Len(t, activities, 1)
+			}
+		})
+	}
+}
+
+// GetCardActivity(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Activity, error)
+func TestGetCardActivity(t *testing.T) {
+	ts := setup()
+	outsider := userContext(uuid.New())
+
+	deletedOn := func(cardID, boardID uuid.UUID) []entity.Activity {
+		return []entity.Activity{{ID: uuid.New(), BoardIDs: []uuid.UUID{boardID}, TargetType: entity.ActivityTargetCard, TargetID: cardID, Action: entity.ActivityDelete}}
+	}
+
+	tests := []struct {
+		name       string
+		asOutsider bool
+		mockRepoFn func(cardID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "existing card",
+			mockRepoFn: func(cardID uuid.UUID) {
+				ts.mockCardAccess(cardID)
+				ts.mockActivityRepo.On("GetActivityByTarget", ts.ctx, cardID, 10, 0).Return([]entity.Activity{{ID: uuid.New()}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "deleted card",
+			mockRepoFn: func(cardID uuid.UUID) {
+				boardID := uuid.New()
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(nil, repository.ErrNotFound)
+				ts.mockActivityRepo.On("GetActivityByTarget", ts.ctx, cardID, 1, 0).Return(deletedOn(cardID, boardID), nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockActivityRepo.On("GetActivityByTarget", ts.ctx, cardID, 10, 0).Return(deletedOn(cardID, boardID), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "deleted card of a board the caller cannot see",
+			asOutsider: true,
+			mockRepoFn: func(cardID uuid.UUID) {
+				boardID := uuid.New()
+				ts.mockCardRepo.On("GetCardByID", outsider, cardID).Return(nil, repository.ErrNotFound)
+				ts.mockActivityRepo.On("GetActivityByTarget", outsider, cardID, 1, 0).Return(deletedOn(cardID, boardID), nil)
+				ts.mockBoardRepo.On("GetBoardByID", outsider, boardID).Return(&entity.Board{ID: boardID, UserID: uuid.New()}, nil)
+				ts.mockMemberRepo.On("GetMember", outsider, boardID, mock.Anything).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrForbidden,
+		},
+		{
+			name: "unknown card",
+			mockRepoFn: func(cardID uuid.UUID) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(nil, repository.ErrNotFound)
+				ts.mockActivityRepo.On("GetActivityByTarget", ts.ctx, cardID, 1, 0).Return([]entity.Activity{}, nil)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID := uuid.New()
+			tt.mockRepoFn(cardID)
+
+			ctx := ts.ctx
+			if tt.asOutsider {
+				ctx = outsider
+			}
+
+			activities, err := ts.todoUseCase.GetCardActivity(ctx, cardID, 10, 0)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, activities)
+			} else {
+				assert.Nil(t, err)
+				assert.Len(t, activities, 1)
+			}
+		})
+	}
+}
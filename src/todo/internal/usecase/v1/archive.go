@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func (uc *todoUseCase) ArchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	return uc.setBoardArchived(ctx, "ArchiveBoard: ", id, true)
+}
+
+func (uc *todoUseCase) UnarchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	return uc.setBoardArchived(ctx, "UnarchiveBoard: ", id, false)
+}
+
+func (uc *todoUseCase) ArchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	return uc.setColumnArchived(ctx, "ArchiveColumn: ", id, true)
+}
+
+func (uc *todoUseCase) UnarchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	return uc.setColumnArchived(ctx, "UnarchiveColumn: ", id, false)
+}
+
+// setBoardArchived archives or unarchives the board; only its owner may do
+// either. Archiving an archived board again changes nothing.
+func (uc *todoUseCase) setBoardArchived(ctx context.Context, header string, id uuid.UUID, archived bool) (*entity.Board, error) {
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id)
+
+	board, err := uc.boardAccess(ctx, id, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if (board.ArchivedAt != nil) == archived {
+		uc.log.Info(ctx, header+"Board is already in the requested state")
+		return board, nil
+	}
+
+	now := time.Now()
+
+	board.ArchivedAt = nil
+	if archived {
+		board.ArchivedAt = &now
+	}
+	board.UpdatedAt = now
+
+	uc.log.Info(ctx, header+"Making request to board repo (UpdateBoardArchived)", "board", board)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.UpdateBoardArchived(ctx, board); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, archiveAction(archived), entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, nil)
+	})
+
+	if err != nil {
+		info := "Failed to update board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully updated")
+
+	return board, nil
+}
+
+// setColumnArchived archives or unarchives the column. Editors of the board
+// may do either as long as the board itself is not archived.
+func (uc *todoUseCase) setColumnArchived(ctx context.Context, header string, id uuid.UUID, archived bool) (*entity.Column, error) {
+	uc.log.Info(ctx, header+"Usecase called; Checking access to column", "id", id)
+
+	column, err := uc.columnAccess(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if (column.ArchivedAt != nil) == archived {
+		uc.log.Info(ctx, header+"Column is already in the requested state")
+		return column, nil
+	}
+
+	now := time.Now()
+
+	column.ArchivedAt = nil
+	if archived {
+		column.ArchivedAt = &now
+	}
+	column.UpdatedAt = now
+
+	uc.log.Info(ctx, header+"Making request to column repo (UpdateColumnArchived)", "column", column)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.columnRepo.UpdateColumnArchived(ctx, column); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, archiveAction(archived), entity.ActivityTargetColumn, column.ID, []uuid.UUID{column.BoardID}, nil)
+	})
+
+	if err != nil {
+		info := "Failed to update column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Column successfully updated")
+
+	return column, nil
+}
+
+func archiveAction(archived bool) entity.ActivityAction {
+	if archived {
+		return entity.ActivityArchive
+	}
+
+	return entity.ActivityUnarchive
+}
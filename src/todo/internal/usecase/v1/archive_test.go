@@ -0,0 +1,178 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ArchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error)
+func TestArchiveBoard(t *testing.T) {
+	ownerID := uuid.New()
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		caller     uuid.UUID
+		archivedAt *time.Time
+		mockRepoFn func(ts *testSetup)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			caller: ownerID,
+			mockRepoFn: func(ts *testSetup) {
+				ts.mockBoardRepo.On("UpdateBoardArchived", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "already archived",
+			caller:     ownerID,
+			archivedAt: &archivedAt,
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    false,
+		},
+		{
+			name:       "not the owner",
+			caller:     uuid.New(),
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    true,
+			err:        v1.ErrForbidden,
+		},
+		{
+			name:   "repo error",
+			caller: ownerID,
+			mockRepoFn: func(ts *testSetup) {
+				ts.mockBoardRepo.On("UpdateBoardArchived", mock.Anything, mock.Anything).Return(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+			ctx := userContext(tt.caller)
+			boardID := uuid.New()
+
+			ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: ownerID, ArchivedAt: tt.archivedAt}, nil)
+			ts.mockMemberRepo.On("GetMember", ctx, boardID, tt.caller).Return(&entity.BoardMember{BoardID: boardID, UserID: tt.caller, Role: entity.RoleEditor, Accepted: true}, nil)
+			tt.mockRepoFn(ts)
+
+			board, err := ts.todoUseCase.ArchiveBoard(ctx, boardID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, board)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotNil(t, board.ArchivedAt)
+
+			if tt.archivedAt != nil {
+				ts.mockBoardRepo.AssertNotCalled(t, "UpdateBoardArchived", mock.Anything, mock.Anything)
+				ts.mockActivityRepo.AssertNotCalled(t, "CreateActivity", mock.Anything, mock.Anything)
+			} else {
+				ts.mockActivityRepo.AssertCalled(t, "CreateActivity", mock.Anything, mock.MatchedBy(func(a *entity.Activity) bool {
+					return a.Action == entity.ActivityArchive && a.TargetID == boardID
+				}))
+			}
+		})
+	}
+}
+
+// UnarchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error)
+func TestUnarchiveColumn(t *testing.T) {
+	ts := setup()
+	columnID := uuid.New()
+	boardID := uuid.New()
+	archivedAt := time.Now()
+
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID, ArchivedAt: &archivedAt}, nil)
+	ts.mockBoardAccess(boardID)
+	ts.mockColumnRepo.On("UpdateColumnArchived", ts.ctx, mock.Anything).Return(nil)
+
+	column, err := ts.todoUseCase.UnarchiveColumn(ts.ctx, columnID)
+
+	assert.Nil(t, err)
+	assert.Nil(t, column.ArchivedAt)
+	ts.mockActivityRepo.AssertCalled(t, "CreateActivity", mock.Anything, mock.MatchedBy(func(a *entity.Activity) bool {
+		return a.Action == entity.ActivityUnarchive && a.TargetID == columnID
+	}))
+}
+
+func TestArchivedIsReadOnly(t *testing.T) {
+	archivedAt := time.Now()
+
+	t.Run("update of an archived board", func(t *testing.T) {
+		ts := setup()
+		boardID := uuid.New()
+		ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, ArchivedAt: &archivedAt}, nil)
+
+		err := ts.todoUseCase.UpdateBoard(ts.ctx, &entity.Board{ID: boardID, Title: "Title"})
+
+		assert.ErrorIs(t, err, v1.ErrArchived)
+		ts.mockBoardRepo.AssertNotCalled(t, "UpdateBoard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("card in an archived column", func(t *testing.T) {
+		ts := setup()
+		columnID := uuid.New()
+		boardID := uuid.New()
+		ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID, ArchivedAt: &archivedAt}, nil)
+		ts.mockBoardAccess(boardID)
+
+		err := ts.todoUseCase.CreateCard(ts.ctx, &entity.Card{UserID: uuid.New(), ColumnID: columnID, Title: "Title"})
+
+		assert.ErrorIs(t, err, v1.ErrArchived)
+		ts.mockCardRepo.AssertNotCalled(t, "CreateCard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reading an archived board", func(t *testing.T) {
+		ts := setup()
+		boardID := uuid.New()
+		ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, ArchivedAt: &archivedAt}, nil)
+
+		board, err := ts.todoUseCase.GetBoardByID(ts.ctx, boardID)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, board)
+	})
+
+	t.Run("deleting an archived board", func(t *testing.T) {
+		ts := setup()
+		boardID := uuid.New()
+		ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, ArchivedAt: &archivedAt}, nil)
+		ts.mockBoardRepo.On("DeleteBoard", ts.ctx, boardID, mock.Anything).Return(nil)
+
+		err := ts.todoUseCase.DeleteBoard(ts.ctx, boardID)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetArchivedBoards(t *testing.T) {
+	ts := setup()
+	userID := uuid.New()
+	ctx := userContext(userID)
+
+	ts.mockBoardRepo.On("GetBoardsByUser", ctx, userID, true, 10, 0).Return([]entity.Board{{ID: uuid.New()}}, nil)
+
+	boards, err := ts.todoUseCase.GetBoardsByUser(ctx, userID, true, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, boards, 1)
+	ts.mockBoardRepo.AssertCalled(t, "GetBoardsByUser", ctx, userID, true, 10, 0)
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAssigneeNoUserID  = errors.New("assignee should have a user id")
+	ErrAssigneeNotMember = errors.New("assignee should be a member of the board")
+)
+
+func (uc *todoUseCase) AssignCard(ctx context.Context, cardID, userID uuid.UUID) error {
+	header := "AssignCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating assignee", "cardID", cardID, "userID", userID)
+
+	if userID == uuid.Nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrAssigneeNoUserID.Error())
+		return fmt.Errorf(header+info+": %w", ErrAssigneeNoUserID)
+	}
+
+	err := uc.authorizeCardAssignee(ctx, cardID, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to assignee repo (AddAssignee)", "cardID", cardID, "userID", userID)
+
+	err = uc.assigneeRepo.AddAssignee(ctx, cardID, userID)
+
+	if err != nil {
+		info := "Failed to assign card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully assigned")
+
+	return nil
+}
+
+func (uc *todoUseCase) UnassignCard(ctx context.Context, cardID, userID uuid.UUID) error {
+	header := "UnassignCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card", "cardID", cardID, "userID", userID)
+
+	// Users who have left the board can still be unassigned
+	_, err := uc.authorizeCard(ctx, cardID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to assignee repo (RemoveAssignee)", "cardID", cardID, "userID", userID)
+
+	err = uc.assigneeRepo.RemoveAssignee(ctx, cardID, userID)
+
+	if err != nil {
+		info := "Failed to unassign card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully unassigned")
+
+	return nil
+}
+
+func (uc *todoUseCase) GetAssignedCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+	header := "GetAssignedCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "userID", userID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardsByAssignee)", "userID", userID)
+
+	cards, err := uc.cardRepo.GetCardsByAssignee(ctx, userID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get assigned cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got cards", "cards", cards)
+
+	return cards, nil
+}
+
+// authorizeCardAssignee checks that the caller may edit the card and that the
+// user to assign can see the board the card is on. Whether the user exists at
+// all is up to the user service.
+func (uc *todoUseCase) authorizeCardAssignee(ctx context.Context, cardID, userID uuid.UUID) error {
+	card, err := uc.authorizeCard(ctx, cardID, entity.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+	if err != nil {
+		return err
+	}
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, column.BoardID)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.boardRole(ctx, board, userID)
+	if errors.Is(err, ErrForbidden) {
+		return ErrAssigneeNotMember
+	}
+
+	return err
+}
+
+// withAssignees fills in the assignees of the cards
+func (uc *todoUseCase) withAssignees(ctx context.Context, cards []entity.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(cards))
+	for i, card := range cards {
+		ids[i] = card.ID
+	}
+
+	assignees, err := uc.assigneeRepo.GetAssigneesByCards(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range cards {
+		cards[i].Assignees = assignees[cards[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// AssignCard(ctx context.Context, cardID, userID uuid.UUID) error
+func TestAssignCard(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		noUserID   bool
+		mockRepoFn func(cardID, userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			mockRepoFn: func(cardID, userID uuid.UUID) {
+				boardID := ts.mockCardOnBoard(cardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID, Role: entity.RoleViewer, Accepted: true}, nil)
+				ts.mockAssigneeRepo.On("AddAssignee", ts.ctx, cardID, userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "no user id",
+			noUserID:   true,
+			mockRepoFn: func(cardID, userID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrAssigneeNoUserID,
+		},
+		{
+			name: "not a member",
+			mockRepoFn: func(cardID, userID uuid.UUID) {
+				boardID := ts.mockCardOnBoard(cardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrAssigneeNotMember,
+		},
+		{
+			name: "pending invitation",
+			mockRepoFn: func(cardID, userID uuid.UUID) {
+				boardID := ts.mockCardOnBoard(cardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID, Role: entity.RoleEditor}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrAssigneeNotMember,
+		},
+		{
+			name: "card not found",
+			mockRepoFn: func(cardID, userID uuid.UUID) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID, userID := uuid.New(), uuid.New()
+			if tt.noUserID {
+				userID = uuid.Nil
+			}
+			tt.mockRepoFn(cardID, userID)
+
+			err := ts.todoUseCase.AssignCard(ts.ctx, cardID, userID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockAssigneeRepo.AssertNotCalled(t, "AddAssignee", ts.ctx, cardID, userID)
+			} else {
+				assert.Nil(t, err)
+				ts.mockAssigneeRepo.AssertCalled(t, "AddAssignee", ts.ctx, cardID, userID)
+			}
+		})
+	}
+}
+
+// UnassignCard(ctx context.Context, cardID, userID uuid.UUID) error
+func TestUnassignCard(t *testing.T) {
+	ts := setup()
+
+	cardID, userID := uuid.New(), uuid.New()
+	ts.mockCardAccess(cardID)
+	ts.mockAssigneeRepo.On("RemoveAssignee", ts.ctx, cardID, userID).Return(errors.New(""))
+
+	err := ts.todoUseCase.UnassignCard(ts.ctx, cardID, userID)
+
+	assert.EqualError(t, err, "UnassignCard: Failed to unassign card: ")
+	ts.mockMemberRepo.AssertNotCalled(t, "GetMember", ts.ctx, mock.Anything, userID)
+}
+
+// GetAssignedCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error)
+func TestGetAssignedCards(t *testing.T) {
+	ts := setup()
+
+	userID, otherID := uuid.New(), uuid.New()
+	cards := []entity.Card{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	ts.mockCardRepo.On("GetCardsByAssignee", ts.ctx, userID, 10, 0).Return(cards, nil)
+	ts.mockLabelRepo.On("GetLabelsByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]entity.Label{}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", ts.ctx, []uuid.UUID{cards[0].ID, cards[1].ID}).Return(map[uuid.UUID][]uuid.UUID{
+		cards[0].ID: {userID},
+		cards[1].ID: {otherID, userID},
+	}, nil)
+
+	got, err := ts.todoUseCase.GetAssignedCards(ts.ctx, userID, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{userID}, got[0].Assignees)
+	assert.Equal(t, []uuid.UUID{otherID, userID}, got[1].Assignees)
+}
+
+func TestGetAssignedCardsOfAnotherUser(t *testing.T) {
+	ts := setup()
+
+	_, err := ts.todoUseCase.GetAssignedCards(userContext(uuid.New()), uuid.New(), 10, 0)
+
+	assert.ErrorIs(t, err, v1.ErrForbidden)
+	ts.mockCardRepo.AssertNotCalled(t, "GetCardsByAssignee", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,228 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxAttachmentSize           = 10 << 20
+	maxAttachmentFilenameLength = 255
+	// sniffLength is the most bytes http.DetectContentType looks at
+	sniffLength = 512
+)
+
+var (
+	ErrAttachmentNoFilename       = errors.New("attachment should have a filename")
+	ErrAttachmentFilenameTooLong  = fmt.Errorf("attachment filename cannot be longer than %d characters", maxAttachmentFilenameLength)
+	ErrAttachmentEmpty            = errors.New("attachment cannot be empty")
+	ErrAttachmentSizeUnknown      = errors.New("attachment size should be known before the upload")
+	ErrAttachmentTooLarge         = fmt.Errorf("attachment cannot be larger than %d bytes", maxAttachmentSize)
+	ErrNotAttachmentUploader      = fmt.Errorf("%w: only the uploader can delete an attachment", ErrForbidden)
+	ErrAttachmentContentNotStored = errors.New("attachment content is missing from the blob store")
+)
+
+// validateAttachment checks the declared metadata of an upload. Only the base
+// name of the file is kept, the client's directories are of no use to anyone.
+func validateAttachment(attachment *entity.Attachment) error {
+	attachment.Filename = strings.TrimSpace(path.Base(strings.ReplaceAll(attachment.Filename, `\`, "/")))
+
+	if attachment.Filename == "" || attachment.Filename == "." || attachment.Filename == "/" {
+		return ErrAttachmentNoFilename
+	}
+
+	if len([]rune(attachment.Filename)) > maxAttachmentFilenameLength {
+		return ErrAttachmentFilenameTooLong
+	}
+
+	if attachment.Size < 0 {
+		return ErrAttachmentSizeUnknown
+	}
+
+	if attachment.Size == 0 {
+		return ErrAttachmentEmpty
+	}
+
+	if attachment.Size > maxAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) error {
+	header := "UploadAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating attachment", "cardID", attachment.CardID, "filename", attachment.Filename, "size", attachment.Size)
+
+	err := validateAttachment(attachment)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	caller, err := callerFromContext(ctx)
+
+	if err == nil {
+		_, err = uc.authorizeCard(ctx, attachment.CardID, entity.RoleEditor)
+	}
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	// The type the client claims is not trusted, it is sniffed from the
+	// first bytes of the content instead
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		info := "Failed to read attachment"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	attachment.ID = uuid.New()
+	attachment.UploaderID = caller.UserID
+	attachment.ContentType = http.DetectContentType(head[:n])
+	attachment.StorageKey = fmt.Sprintf("cards/%s/%s", attachment.CardID, attachment.ID)
+	attachment.CreatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Putting content into blob store", "key", attachment.StorageKey, "contentType", attachment.ContentType)
+
+	// The content is stored before the metadata: if the service stops in
+	// between, an unreferenced blob is left, never an attachment without
+	// content
+	body := io.MultiReader(bytes.NewReader(head[:n]), content)
+	err = uc.blobStore.Put(ctx, attachment.StorageKey, body, attachment.Size, attachment.ContentType)
+
+	if err != nil {
+		info := "Failed to store attachment content"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to attachment repo (CreateAttachment)", "attachment", attachment)
+
+	err = uc.attachmentRepo.CreateAttachment(ctx, attachment)
+
+	if err != nil {
+		if err := uc.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			uc.log.Warn(ctx, header+"Failed to remove stored content", "key", attachment.StorageKey, "err", err.Error())
+		}
+
+		info := "Failed to create attachment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Attachment successfully uploaded")
+
+	return nil
+}
+
+// GetAttachment returns the metadata and the content of the attachment; the
+// caller has to close the content
+func (uc *todoUseCase) GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error) {
+	header := "GetAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to attachment", "id", id)
+
+	attachment, err := uc.authorizeAttachment(ctx, id, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Getting content from blob store", "key", attachment.StorageKey)
+
+	content, err := uc.blobStore.Get(ctx, attachment.StorageKey)
+
+	if err != nil {
+		info := "Failed to get attachment content"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w: %w", ErrAttachmentContentNotStored, err)
+	}
+
+	uc.log.Info(ctx, header+"Got attachment", "attachment", attachment)
+
+	return attachment, content, nil
+}
+
+func (uc *todoUseCase) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteAttachment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to attachment", "id", id)
+
+	caller, err := callerFromContext(ctx)
+
+	var attachment *entity.Attachment
+	if err == nil {
+		attachment, err = uc.authorizeAttachment(ctx, id, entity.RoleEditor)
+	}
+
+	if err == nil && !caller.IsAdmin() && caller.UserID != attachment.UploaderID {
+		err = ErrNotAttachmentUploader
+	}
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to attachment repo (DeleteAttachment)", "id", id)
+
+	err = uc.attachmentRepo.DeleteAttachment(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete attachment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	// A blob that cannot be removed now is unreachable anyway, so it does
+	// not fail the request
+	if err := uc.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+		uc.log.Warn(ctx, header+"Failed to remove stored content", "key", attachment.StorageKey, "err", err.Error())
+	}
+
+	uc.log.Info(ctx, header+"Attachment successfully deleted")
+
+	return nil
+}
+
+// authorizeAttachment checks that the caller has the required role on the
+// board of the card the attachment belongs to
+func (uc *todoUseCase) authorizeAttachment(ctx context.Context, id uuid.UUID, required entity.MemberRole) (*entity.Attachment, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	attachment, err := uc.attachmentRepo.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeCard(ctx, attachment.CardID, required); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,245 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) error
+func TestUploadAttachment(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		attachment *entity.Attachment
+		content    string
+		mockRepoFn func(attachment *entity.Attachment)
+		wantErr    bool
+		err        error
+		// stored is whether the content reached the blob store
+		stored bool
+	}{
+		{
+			name:       "success",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "C:\\logs\\app.log", Size: 11},
+			content:    "line\nline\n\n",
+			mockRepoFn: func(attachment *entity.Attachment) {
+				ts.mockCardAccess(attachment.CardID)
+				ts.mockAttachmentRepo.On("CreateAttachment", ts.ctx, attachment).Return(nil)
+			},
+			wantErr: false,
+			stored:  true,
+		},
+		{
+			name:       "no filename",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: " ", Size: 4},
+			content:    "line",
+			mockRepoFn: func(attachment *entity.Attachment) {},
+			wantErr:    true,
+			err:        v1.ErrAttachmentNoFilename,
+		},
+		{
+			name:       "empty",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: 0},
+			mockRepoFn: func(attachment *entity.Attachment) {},
+			wantErr:    true,
+			err:        v1.ErrAttachmentEmpty,
+		},
+		{
+			name:       "unknown size",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: -1},
+			content:    "line",
+			mockRepoFn: func(attachment *entity.Attachment) {},
+			wantErr:    true,
+			err:        v1.ErrAttachmentSizeUnknown,
+		},
+		{
+			name:       "too large",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: 10<<20 + 1},
+			content:    "line",
+			mockRepoFn: func(attachment *entity.Attachment) {},
+			wantErr:    true,
+			err:        v1.ErrAttachmentTooLarge,
+		},
+		{
+			name:       "card not found",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: 4},
+			content:    "line",
+			mockRepoFn: func(attachment *entity.Attachment) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, attachment.CardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+		{
+			name:       "failed to create attachment",
+			attachment: &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: 4},
+			content:    "line",
+			mockRepoFn: func(attachment *entity.Attachment) {
+				ts.mockCardAccess(attachment.CardID)
+				ts.mockAttachmentRepo.On("CreateAttachment", ts.ctx, attachment).Return(errors.New(""))
+			},
+			wantErr: true,
+			stored:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.attachment)
+
+			var stored strings.Builder
+			onCard := mock.MatchedBy(func(key string) bool {
+				return strings.HasPrefix(key, "cards/"+tt.attachment.CardID.String()+"/")
+			})
+			ts.mockBlobStore.On("Put", ts.ctx, onCard, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				io.Copy(&stored, args.Get(2).(io.Reader))
+			}).Return(nil)
+			ts.mockBlobStore.On("Delete", ts.ctx, onCard).Return(nil)
+
+			err := ts.todoUseCase.UploadAttachment(ts.ctx, tt.attachment, strings.NewReader(tt.content))
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, "app.log", tt.attachment.Filename)
+				assert.Equal(t, "text/plain; charset=utf-8", tt.attachment.ContentType)
+				ts.mockAttachmentRepo.AssertCalled(t, "CreateAttachment", ts.ctx, tt.attachment)
+				ts.mockBlobStore.AssertNotCalled(t, "Delete", ts.ctx, tt.attachment.StorageKey)
+			}
+
+			if tt.stored {
+				assert.Equal(t, tt.content, stored.String())
+				assert.Equal(t, "cards/"+tt.attachment.CardID.String()+"/"+tt.attachment.ID.String(), tt.attachment.StorageKey)
+			} else {
+				assert.Empty(t, stored.String())
+				ts.mockAttachmentRepo.AssertNotCalled(t, "CreateAttachment", ts.ctx, tt.attachment)
+			}
+
+			if tt.stored && tt.wantErr {
+				// The content of an attachment that failed to be created is removed
+				ts.mockBlobStore.AssertCalled(t, "Delete", ts.ctx, tt.attachment.StorageKey)
+			}
+		})
+	}
+}
+
+func TestUploadAttachmentStoreFailed(t *testing.T) {
+	ts := setup()
+
+	attachment := &entity.Attachment{CardID: uuid.New(), Filename: "app.log", Size: 4}
+	ts.mockCardAccess(attachment.CardID)
+	ts.mockBlobStore.On("Put", ts.ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("disk full"))
+
+	err := ts.todoUseCase.UploadAttachment(ts.ctx, attachment, strings.NewReader("line"))
+
+	assert.EqualError(t, err, "UploadAttachment: Failed to store attachment content: disk full")
+	ts.mockAttachmentRepo.AssertNotCalled(t, "CreateAttachment", mock.Anything, mock.Anything)
+}
+
+// GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error)
+func TestGetAttachment(t *testing.T) {
+	ts := setup()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		attachment := &entity.Attachment{ID: uuid.New(), CardID: uuid.New(), StorageKey: "key-1"}
+		ts.mockAttachmentRepo.On("GetAttachmentByID", ts.ctx, attachment.ID).Return(attachment, nil)
+		ts.mockCardAccess(attachment.CardID)
+		ts.mockBlobStore.On("Get", ts.ctx, "key-1").Return(io.NopCloser(strings.NewReader("line")), nil)
+
+		got, content, err := ts.todoUseCase.GetAttachment(ts.ctx, attachment.ID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, attachment, got)
+		data, _ := io.ReadAll(content)
+		assert.Equal(t, "line", string(data))
+	})
+
+	t.Run("content missing", func(t *testing.T) {
+		t.Parallel()
+		attachment := &entity.Attachment{ID: uuid.New(), CardID: uuid.New(), StorageKey: "key-2"}
+		ts.mockAttachmentRepo.On("GetAttachmentByID", ts.ctx, attachment.ID).Return(attachment, nil)
+		ts.mockCardAccess(attachment.CardID)
+		ts.mockBlobStore.On("Get", ts.ctx, "key-2").Return(nil, repository.ErrNotFound)
+
+		_, _, err := ts.todoUseCase.GetAttachment(ts.ctx, attachment.ID)
+
+		// A row without content is a fault of the service, not a missing attachment
+		assert.ErrorIs(t, err, v1.ErrAttachmentContentNotStored)
+	})
+
+	t.Run("attachment not found", func(t *testing.T) {
+		t.Parallel()
+		id := uuid.New()
+		ts.mockAttachmentRepo.On("GetAttachmentByID", ts.ctx, id).Return(nil, repository.ErrNotFound)
+
+		_, _, err := ts.todoUseCase.GetAttachment(ts.ctx, id)
+
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+// DeleteAttachment(ctx context.Context, id uuid.UUID) error
+func TestDeleteAttachment(t *testing.T) {
+	ts := setup()
+
+	t.Run("by the uploader", func(t *testing.T) {
+		t.Parallel()
+		id, uploaderID := uuid.New(), uuid.New()
+		ctx := userContext(uploaderID)
+		key := ts.mockAttachedCard(ctx, id, uploaderID, uuid.New())
+		ts.mockAttachmentRepo.On("DeleteAttachment", ctx, id).Return(nil)
+		ts.mockBlobStore.On("Delete", ctx, key).Return(errors.New("unreachable"))
+
+		err := ts.todoUseCase.DeleteAttachment(ctx, id)
+
+		// The content is removed on a best-effort basis
+		assert.Nil(t, err)
+		ts.mockAttachmentRepo.AssertCalled(t, "DeleteAttachment", ctx, id)
+		ts.mockBlobStore.AssertCalled(t, "Delete", ctx, key)
+	})
+
+	t.Run("by the board owner", func(t *testing.T) {
+		t.Parallel()
+		id, ownerID := uuid.New(), uuid.New()
+		ctx := userContext(ownerID)
+		ts.mockAttachedCard(ctx, id, uuid.New(), ownerID)
+
+		err := ts.todoUseCase.DeleteAttachment(ctx, id)
+
+		assert.ErrorIs(t, err, v1.ErrNotAttachmentUploader)
+		assert.ErrorIs(t, err, v1.ErrForbidden)
+		ts.mockAttachmentRepo.AssertNotCalled(t, "DeleteAttachment", ctx, id)
+	})
+}
+
+// mockAttachedCard puts an attachment of the uploader on a card of a board
+// owned by the owner, where every other caller is an editor; it returns the
+// storage key of the attachment
+func (ts *testSetup) mockAttachedCard(ctx context.Context, attachmentID, uploaderID, ownerID uuid.UUID) string {
+	cardID, columnID, boardID := uuid.New(), uuid.New(), uuid.New()
+	key := "cards/" + cardID.String() + "/" + attachmentID.String()
+	ts.mockAttachmentRepo.On("GetAttachmentByID", ctx, attachmentID).Return(&entity.Attachment{ID: attachmentID, CardID: cardID, UploaderID: uploaderID, StorageKey: key}, nil)
+	ts.mockCardRepo.On("GetCardByID", ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+	ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: ownerID}, nil)
+	ts.mockMemberRepo.On("GetMember", ctx, boardID, mock.Anything).Return(&entity.BoardMember{BoardID: boardID, Role: entity.RoleEditor, Accepted: true}, nil)
+
+	return key
+}
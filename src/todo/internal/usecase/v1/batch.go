@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const maxBatchOps = 100
+
+var (
+	ErrBatchEmpty       = errors.New("batch should have at least one operation")
+	ErrBatchTooLarge    = fmt.Errorf("batch cannot have more than %d operations", maxBatchOps)
+	ErrBatchInvalidMode = errors.New("batch mode should be atomic or best_effort")
+	ErrBatchInvalidOp   = errors.New("invalid batch operation")
+)
+
+func validateBatch(mode entity.BatchMode, ops []entity.BatchOp) error {
+	if mode != entity.BatchAtomic && mode != entity.BatchBestEffort {
+		return ErrBatchInvalidMode
+	}
+
+	if len(ops) == 0 {
+		return ErrBatchEmpty
+	}
+
+	if len(ops) > maxBatchOps {
+		return ErrBatchTooLarge
+	}
+
+	for i, op := range ops {
+		if !validBatchOp(op) {
+			return fmt.Errorf("operation %d: %w", i, ErrBatchInvalidOp)
+		}
+	}
+
+	return nil
+}
+
+// validBatchOp tells whether the operation carries what its action needs;
+// boards have no place to be moved to
+func validBatchOp(op entity.BatchOp) bool {
+	switch op.Action {
+	case entity.ActivityCreate, entity.ActivityUpdate:
+		switch op.Target {
+		case entity.ActivityTargetBoard:
+			return op.Board != nil
+		case entity.ActivityTargetColumn:
+			return op.Column != nil
+		case entity.ActivityTargetCard:
+			return op.Card != nil
+		}
+	case entity.ActivityMove:
+		switch op.Target {
+		case entity.ActivityTargetColumn:
+			anchor, _ := op.Placement.Anchor()
+			return op.ID != uuid.Nil && anchor != uuid.Nil
+		case entity.ActivityTargetCard:
+			return op.ID != uuid.Nil && op.Move.ColumnID != uuid.Nil
+		}
+	case entity.ActivityDelete:
+		switch op.Target {
+		case entity.ActivityTargetBoard, entity.ActivityTargetColumn, entity.ActivityTargetCard:
+			return op.ID != uuid.Nil
+		}
+	}
+
+	return false
+}
+
+// RunBatch runs the operations in order in a single transaction, each with
+// the same checks as on its own. In atomic mode the first failure undoes the
+// whole batch; in best-effort mode only the failed operations are undone.
+// The error is only set when the batch as a whole could not be run.
+func (uc *todoUseCase) RunBatch(ctx context.Context, mode entity.BatchMode, ops []entity.BatchOp) ([]entity.BatchResult, error) {
+	header := "RunBatch: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating batch", "mode", mode, "count", len(ops))
+
+	err := validateBatch(mode, ops)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = callerFromContext(ctx)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	results := make([]entity.BatchResult, len(ops))
+	for i := range results {
+		results[i].Status = entity.BatchSkipped
+	}
+
+	failed := -1
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i, op := range ops {
+			// Each operation gets a nested transaction of its own, so a
+			// failure only undoes what that operation did
+			err := uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+				var err error
+				results[i].ID, results[i].Version, err = uc.runBatchOp(ctx, op)
+				return err
+			})
+
+			if err != nil {
+				uc.log.Info(ctx, header+"Operation failed", "index", i, "err", err.Error())
+
+				// A failed create leaves no item behind to point at
+				id := results[i].ID
+				if op.Action == entity.ActivityCreate {
+					id = uuid.Nil
+				}
+
+				results[i] = entity.BatchResult{Status: entity.BatchFailed, ID: id, Err: err}
+
+				if mode == entity.BatchAtomic {
+					failed = i
+					return err
+				}
+
+				continue
+			}
+
+			results[i].Status = entity.BatchApplied
+		}
+
+		return nil
+	})
+
+	if err != nil && failed < 0 {
+		info := "Failed to run batch"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	for i := 0; i < failed; i++ {
+		results[i].Status = entity.BatchRolledBack
+	}
+
+	uc.log.Info(ctx, header+"Batch run", "results", results)
+
+	return results, nil
+}
+
+// runBatchOp runs one operation of a batch and returns the id and version of
+// the item it leaves behind
+func (uc *todoUseCase) runBatchOp(ctx context.Context, op entity.BatchOp) (uuid.UUID, int, error) {
+	switch op.Action {
+	case entity.ActivityCreate:
+		switch op.Target {
+		case entity.ActivityTargetBoard:
+			err := uc.CreateBoard(ctx, op.Board)
+			return op.Board.ID, op.Board.Version, err
+		case entity.ActivityTargetColumn:
+			err := uc.CreateColumn(ctx, op.Column)
+			return op.Column.ID, op.Column.Version, err
+		case entity.ActivityTargetCard:
+			err := uc.CreateCard(ctx, op.Card)
+			return op.Card.ID, op.Card.Version, err
+		}
+	case entity.ActivityUpdate:
+		switch op.Target {
+		case entity.ActivityTargetBoard:
+			err := uc.UpdateBoard(ctx, op.Board)
+			return op.Board.ID, op.Board.Version, err
+		case entity.ActivityTargetColumn:
+			err := uc.UpdateColumn(ctx, op.Column)
+			return op.Column.ID, op.Column.Version, err
+		case entity.ActivityTargetCard:
+			err := uc.UpdateCard(ctx, op.Card)
+			return op.Card.ID, op.Card.Version, err
+		}
+	case entity.ActivityMove:
+		switch op.Target {
+		case entity.ActivityTargetColumn:
+			column, err := uc.RepositionColumn(ctx, op.ID, op.Placement)
+			if err != nil {
+				return op.ID, 0, err
+			}
+			return column.ID, column.Version, nil
+		case entity.ActivityTargetCard:
+			card, err := uc.MoveCard(ctx, op.ID, op.Move)
+			if err != nil {
+				return op.ID, 0, err
+			}
+			return card.ID, card.Version, nil
+		}
+	case entity.ActivityDelete:
+		switch op.Target {
+		case entity.ActivityTargetBoard:
+			return op.ID, 0, uc.DeleteBoard(ctx, op.ID)
+		case entity.ActivityTargetColumn:
+			return op.ID, 0, uc.DeleteColumn(ctx, op.ID)
+		case entity.ActivityTargetCard:
+			return op.ID, 0, uc.DeleteCard(ctx, op.ID)
+		}
+	}
+
+	return op.ID, 0, ErrBatchInvalidOp
+}
@@ -0,0 +1,146 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRunBatchValidation(t *testing.T) {
+	cardID := uuid.New()
+
+	tests := []struct {
+		name    string
+		mode    entity.BatchMode
+		ops     []entity.BatchOp
+		wantErr error
+	}{
+		{name: "unknown mode", mode: "eventually", ops: []entity.BatchOp{{Action: entity.ActivityDelete, Target: entity.ActivityTargetCard, ID: cardID}}, wantErr: v1.ErrBatchInvalidMode},
+		{name: "no operations", mode: entity.BatchAtomic, wantErr: v1.ErrBatchEmpty},
+		{name: "too many operations", mode: entity.BatchAtomic, ops: make([]entity.BatchOp, 101), wantErr: v1.ErrBatchTooLarge},
+		{name: "create without an item", mode: entity.BatchAtomic, ops: []entity.BatchOp{{Action: entity.ActivityCreate, Target: entity.ActivityTargetCard}}, wantErr: v1.ErrBatchInvalidOp},
+		{name: "board move", mode: entity.BatchAtomic, ops: []entity.BatchOp{{Action: entity.ActivityMove, Target: entity.ActivityTargetBoard, ID: uuid.New()}}, wantErr: v1.ErrBatchInvalidOp},
+		{name: "card move without a column", mode: entity.BatchBestEffort, ops: []entity.BatchOp{{Action: entity.ActivityMove, Target: entity.ActivityTargetCard, ID: cardID}}, wantErr: v1.ErrBatchInvalidOp},
+		{name: "delete without an id", mode: entity.BatchBestEffort, ops: []entity.BatchOp{{Action: entity.ActivityDelete, Target: entity.ActivityTargetColumn}}, wantErr: v1.ErrBatchInvalidOp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+
+			results, err := ts.todoUseCase.RunBatch(ts.ctx, tt.mode, tt.ops)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, results)
+		})
+	}
+
+	t.Run("no caller", func(t *testing.T) {
+		ts := setup()
+
+		_, err := ts.todoUseCase.RunBatch(context.TODO(), entity.BatchAtomic, []entity.BatchOp{{Action: entity.ActivityDelete, Target: entity.ActivityTargetCard, ID: cardID}})
+
+		assert.ErrorIs(t, err, v1.ErrNoCaller)
+	})
+}
+
+// batchSetup lets the first card be updated and the second one fail with a
+// version conflict
+func batchSetup() (*testSetup, []entity.BatchOp) {
+	ts := setup()
+
+	columnID := uuid.New()
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: uuid.New()}, nil)
+	ts.mockBoardRepo.On("GetBoardByID", ts.ctx, mock.Anything).Return(&entity.Board{}, nil)
+
+	updated, stale, deleted := uuid.New(), uuid.New(), uuid.New()
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, updated).Return(&entity.Card{ID: updated, ColumnID: columnID, Version: 2}, nil)
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, stale).Return(&entity.Card{ID: stale, ColumnID: columnID, Version: 5}, nil)
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, deleted).Return(&entity.Card{ID: deleted, ColumnID: columnID, Version: 1}, nil)
+	ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Card).Version++
+	})
+	ts.mockCardRepo.On("DeleteCard", ts.ctx, deleted, mock.Anything).Return(nil)
+
+	return ts, []entity.BatchOp{
+		{Action: entity.ActivityUpdate, Target: entity.ActivityTargetCard, Card: &entity.Card{ID: updated, Title: "Title", Version: 2}},
+		{Action: entity.ActivityUpdate, Target: entity.ActivityTargetCard, Card: &entity.Card{ID: stale, Title: "Title", Version: 4}},
+		{Action: entity.ActivityDelete, Target: entity.ActivityTargetCard, ID: deleted},
+	}
+}
+
+func TestRunBatchAtomic(t *testing.T) {
+	ts, ops := batchSetup()
+
+	results, err := ts.todoUseCase.RunBatch(ts.ctx, entity.BatchAtomic, ops)
+
+	assert.Nil(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, entity.BatchRolledBack, results[0].Status)
+	assert.Equal(t, entity.BatchFailed, results[1].Status)
+	assert.ErrorIs(t, results[1].Err, v1.ErrVersionConflict)
+	assert.Equal(t, ops[1].Card.ID, results[1].ID)
+	assert.Equal(t, entity.BatchSkipped, results[2].Status)
+
+	ts.mockCardRepo.AssertNotCalled(t, "DeleteCard", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunBatchBestEffort(t *testing.T) {
+	ts, ops := batchSetup()
+
+	results, err := ts.todoUseCase.RunBatch(ts.ctx, entity.BatchBestEffort, ops)
+
+	assert.Nil(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, entity.BatchResult{Status: entity.BatchApplied, ID: ops[0].Card.ID, Version: 3}, results[0])
+	assert.Equal(t, entity.BatchFailed, results[1].Status)
+	assert.ErrorIs(t, results[1].Err, v1.ErrVersionConflict)
+	assert.Equal(t, entity.BatchApplied, results[2].Status)
+
+	ts.mockCardRepo.AssertCalled(t, "DeleteCard", ts.ctx, ops[2].ID, mock.Anything)
+}
+
+func TestRunBatchOperations(t *testing.T) {
+	ts := setup()
+
+	boardID := uuid.New()
+	ts.mockBoardAccess(boardID)
+
+	column := &entity.Column{ID: uuid.New(), BoardID: boardID}
+	anchor := &entity.Column{ID: uuid.New(), BoardID: boardID, Position: 1024}
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, column.ID).Return(column, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, anchor.ID).Return(anchor, nil)
+	ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Column).Version = 1
+	})
+	ts.mockBoardRepo.On("LockBoard", ts.ctx, boardID).Return(nil)
+	ts.mockColumnRepo.On("GetColumnPositions", ts.ctx, boardID).Return([]entity.Position{{ID: column.ID}, {ID: anchor.ID, Position: anchor.Position}}, nil)
+	ts.mockColumnRepo.On("UpdateColumnPosition", ts.ctx, column.ID, mock.Anything).Return(nil)
+	ts.mockColumnRepo.On("DeleteColumn", ts.ctx, anchor.ID, mock.Anything).Return(nil)
+	ts.mockColumnRepo.On("UpdateColumn", ts.ctx, mock.Anything).Return(repository.ErrNotFound)
+
+	ops := []entity.BatchOp{
+		{Action: entity.ActivityCreate, Target: entity.ActivityTargetColumn, Column: &entity.Column{UserID: uuid.New(), BoardID: boardID, Title: "New"}},
+		{Action: entity.ActivityMove, Target: entity.ActivityTargetColumn, ID: column.ID, Placement: entity.Placement{AfterID: anchor.ID}},
+		{Action: entity.ActivityDelete, Target: entity.ActivityTargetColumn, ID: anchor.ID},
+	}
+
+	results, err := ts.todoUseCase.RunBatch(ts.ctx, entity.BatchAtomic, ops)
+
+	assert.Nil(t, err)
+	for i, result := range results {
+		assert.Equal(t, entity.BatchApplied, result.Status, "operation %d", i)
+	}
+
+	// Created items are reported with the id they were given
+	assert.NotEqual(t, uuid.Nil, results[0].ID)
+	assert.Equal(t, ops[0].Column.ID, results[0].ID)
+	assert.Equal(t, 1, results[0].Version)
+	assert.Equal(t, column.ID, results[1].ID)
+}
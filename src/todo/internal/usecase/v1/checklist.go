@@ -0,0 +1,434 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxChecklistTitleLength = 128
+	maxChecklistItemLength  = 512
+)
+
+var (
+	ErrChecklistEmptyTitle    = errors.New("checklist should have a title")
+	ErrChecklistTitleTooLong  = fmt.Errorf("checklist title cannot be longer than %d characters", maxChecklistTitleLength)
+	ErrChecklistItemEmptyText = errors.New("checklist item should have a text")
+	ErrChecklistItemTooLong   = fmt.Errorf("checklist item cannot be longer than %d characters", maxChecklistItemLength)
+)
+
+func validateChecklist(checklist *entity.Checklist) error {
+	if strings.TrimSpace(checklist.Title) == "" {
+		return ErrChecklistEmptyTitle
+	}
+
+	if len([]rune(checklist.Title)) > maxChecklistTitleLength {
+		return ErrChecklistTitleTooLong
+	}
+
+	return nil
+}
+
+func validateChecklistItem(item *entity.ChecklistItem) error {
+	if strings.TrimSpace(item.Text) == "" {
+		return ErrChecklistItemEmptyText
+	}
+
+	if len([]rune(item.Text)) > maxChecklistItemLength {
+		return ErrChecklistItemTooLong
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) CreateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	header := "CreateChecklist: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating checklist", "checklist", checklist)
+
+	err := validateChecklist(checklist)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeCard(ctx, checklist.CardID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	checklist.ID = uuid.New()
+	checklist.CreatedAt = time.Now()
+	checklist.UpdatedAt = time.Now()
+	checklist.Items = nil
+
+	uc.log.Info(ctx, header+"Appending checklist to locked card", "checklist", checklist)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cardRepo.LockCard(ctx, checklist.CardID); err != nil {
+			return err
+		}
+
+		siblings, err := uc.checklistRepo.GetChecklistPositions(ctx, checklist.CardID)
+		if err != nil {
+			return err
+		}
+
+		checklist.Position = placeLast(siblings)
+
+		return uc.checklistRepo.CreateChecklist(ctx, checklist)
+	})
+
+	if err != nil {
+		info := "Failed to create checklist"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist successfully created")
+
+	return nil
+}
+
+func (uc *todoUseCase) GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error) {
+	header := "GetChecklistsByCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card", "cardID", cardID)
+
+	_, err := uc.authorizeCard(ctx, cardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (GetChecklistsByCard)", "cardID", cardID)
+
+	checklists, err := uc.checklistRepo.GetChecklistsByCard(ctx, cardID)
+
+	if err != nil {
+		info := "Failed to get checklists"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (GetItemsByCard)", "cardID", cardID)
+
+	items, err := uc.checklistRepo.GetItemsByCard(ctx, cardID)
+
+	if err != nil {
+		info := "Failed to get checklist items"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	index := make(map[uuid.UUID]int, len(checklists))
+	for i, checklist := range checklists {
+		index[checklist.ID] = i
+		checklists[i].Items = []entity.ChecklistItem{}
+	}
+
+	// Items come ordered by position, so appending keeps the order
+	for _, item := range items {
+		if i, ok := index[item.ChecklistID]; ok {
+			checklists[i].Items = append(checklists[i].Items, item)
+		}
+	}
+
+	uc.log.Info(ctx, header+"Got checklists", "count", len(checklists), "items", len(items))
+
+	return checklists, nil
+}
+
+func (uc *todoUseCase) UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	header := "UpdateChecklist: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating checklist", "checklist", checklist)
+
+	err := validateChecklist(checklist)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	current, err := uc.authorizeChecklist(ctx, checklist.ID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	checklist.CardID = current.CardID
+	checklist.Position = current.Position
+	checklist.CreatedAt = current.CreatedAt
+	checklist.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (UpdateChecklist)", "checklist", checklist)
+
+	err = uc.checklistRepo.UpdateChecklist(ctx, checklist)
+
+	if err != nil {
+		info := "Failed to update checklist"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist successfully updated")
+
+	return nil
+}
+
+func (uc *todoUseCase) DeleteChecklist(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteChecklist: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to checklist", "id", id)
+
+	_, err := uc.authorizeChecklist(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (DeleteChecklist)", "id", id)
+
+	err = uc.checklistRepo.DeleteChecklist(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete checklist"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist successfully deleted")
+
+	return nil
+}
+
+func (uc *todoUseCase) CreateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error {
+	header := "CreateChecklistItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating item", "item", item)
+
+	err := validateChecklistItem(item)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeChecklist(ctx, item.ChecklistID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	item.ID = uuid.New()
+	item.Done = false
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Appending item to locked checklist", "item", item)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.checklistRepo.LockChecklist(ctx, item.ChecklistID); err != nil {
+			return err
+		}
+
+		siblings, err := uc.checklistRepo.GetItemPositions(ctx, item.ChecklistID)
+		if err != nil {
+			return err
+		}
+
+		item.Position = placeLast(siblings)
+
+		return uc.checklistRepo.CreateItem(ctx, item)
+	})
+
+	if err != nil {
+		info := "Failed to create checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist item successfully created")
+
+	return nil
+}
+
+func (uc *todoUseCase) UpdateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error {
+	header := "UpdateChecklistItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating item", "item", item)
+
+	err := validateChecklistItem(item)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	current, err := uc.authorizeChecklistItem(ctx, item.ID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	item.ChecklistID = current.ChecklistID
+	item.Done = current.Done
+	item.Position = current.Position
+	item.CreatedAt = current.CreatedAt
+	item.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (UpdateItem)", "item", item)
+
+	err = uc.checklistRepo.UpdateItem(ctx, item)
+
+	if err != nil {
+		info := "Failed to update checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist item successfully updated")
+
+	return nil
+}
+
+func (uc *todoUseCase) SetChecklistItemDone(ctx context.Context, id uuid.UUID, done bool) (*entity.ChecklistItem, error) {
+	header := "SetChecklistItemDone: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to item", "id", id, "done", done)
+
+	item, err := uc.authorizeChecklistItem(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	item.Done = done
+	item.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (UpdateItem)", "item", item)
+
+	err = uc.checklistRepo.UpdateItem(ctx, item)
+
+	if err != nil {
+		info := "Failed to update checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist item successfully updated")
+
+	return item, nil
+}
+
+func (uc *todoUseCase) DeleteChecklistItem(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteChecklistItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to item", "id", id)
+
+	_, err := uc.authorizeChecklistItem(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to checklist repo (DeleteItem)", "id", id)
+
+	err = uc.checklistRepo.DeleteItem(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete checklist item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Checklist item successfully deleted")
+
+	return nil
+}
+
+func (uc *todoUseCase) authorizeChecklist(ctx context.Context, checklistID uuid.UUID, required entity.MemberRole) (*entity.Checklist, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	checklist, err := uc.checklistRepo.GetChecklistByID(ctx, checklistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeCard(ctx, checklist.CardID, required); err != nil {
+		return nil, err
+	}
+
+	return checklist, nil
+}
+
+func (uc *todoUseCase) authorizeChecklistItem(ctx context.Context, itemID uuid.UUID, required entity.MemberRole) (*entity.ChecklistItem, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	item, err := uc.checklistRepo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeChecklist(ctx, item.ChecklistID, required); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// withProgress fills in the checklist progress of the cards
+func (uc *todoUseCase) withProgress(ctx context.Context, cards []entity.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(cards))
+	for i, card := range cards {
+		ids[i] = card.ID
+	}
+
+	progress, err := uc.checklistRepo.GetProgressByCards(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range cards {
+		cards[i].Progress = progress[cards[i].ID]
+	}
+
+	return nil
+}
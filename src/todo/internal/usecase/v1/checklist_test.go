@@ -0,0 +1,304 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateChecklist(ctx context.Context, checklist *entity.Checklist) error
+func TestCreateChecklist(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name         string
+		checklist    *entity.Checklist
+		mockRepoFn   func(checklist *entity.Checklist)
+		wantErr      bool
+		err          error
+		wantPosition float64
+	}{
+		{
+			name:      "first checklist",
+			checklist: &entity.Checklist{CardID: uuid.New(), Title: "Release"},
+			mockRepoFn: func(checklist *entity.Checklist) {
+				ts.mockCardAccess(checklist.CardID)
+				ts.mockCardRepo.On("LockCard", ts.ctx, checklist.CardID).Return(nil)
+				ts.mockChecklistRepo.On("GetChecklistPositions", ts.ctx, checklist.CardID).Return([]entity.Position{}, nil)
+				ts.mockChecklistRepo.On("CreateChecklist", ts.ctx, checklist).Return(nil)
+			},
+			wantErr:      false,
+			wantPosition: 1024,
+		},
+		{
+			name:      "after the last checklist",
+			checklist: &entity.Checklist{CardID: uuid.New(), Title: "Release"},
+			mockRepoFn: func(checklist *entity.Checklist) {
+				ts.mockCardAccess(checklist.CardID)
+				ts.mockCardRepo.On("LockCard", ts.ctx, checklist.CardID).Return(nil)
+				ts.mockChecklistRepo.On("GetChecklistPositions", ts.ctx, checklist.CardID).Return([]entity.Position{
+					{ID: uuid.New(), Position: 1024},
+					{ID: uuid.New(), Position: 4096},
+				}, nil)
+				ts.mockChecklistRepo.On("CreateChecklist", ts.ctx, checklist).Return(nil)
+			},
+			wantErr:      false,
+			wantPosition: 5120,
+		},
+		{
+			name:       "empty title",
+			checklist:  &entity.Checklist{CardID: uuid.New(), Title: ""},
+			mockRepoFn: func(checklist *entity.Checklist) {},
+			wantErr:    true,
+			err:        v1.ErrChecklistEmptyTitle,
+		},
+		{
+			name:      "card not found",
+			checklist: &entity.Checklist{CardID: uuid.New(), Title: "Release"},
+			mockRepoFn: func(checklist *entity.Checklist) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, checklist.CardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.checklist)
+
+			err := ts.todoUseCase.CreateChecklist(ts.ctx, tt.checklist)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockChecklistRepo.AssertNotCalled(t, "CreateChecklist", ts.ctx, tt.checklist)
+			} else {
+				assert.Nil(t, err)
+				assert.NotEqual(t, uuid.Nil, tt.checklist.ID)
+				assert.Equal(t, tt.wantPosition, tt.checklist.Position)
+				ts.mockChecklistRepo.AssertCalled(t, "CreateChecklist", ts.ctx, tt.checklist)
+			}
+		})
+	}
+}
+
+// GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error)
+func TestGetChecklistsByCard(t *testing.T) {
+	ts := setup()
+
+	cardID := uuid.New()
+	first := entity.Checklist{ID: uuid.New(), CardID: cardID, Title: "Release"}
+	second := entity.Checklist{ID: uuid.New(), CardID: cardID, Title: "Docs"}
+	items := []entity.ChecklistItem{
+		{ID: uuid.New(), ChecklistID: first.ID, Text: "Tag", Position: 1024},
+		{ID: uuid.New(), ChecklistID: first.ID, Text: "Publish", Position: 2048},
+	}
+
+	ts.mockCardAccess(cardID)
+	ts.mockChecklistRepo.On("GetChecklistsByCard", ts.ctx, cardID).Return([]entity.Checklist{first, second}, nil)
+	ts.mockChecklistRepo.On("GetItemsByCard", ts.ctx, cardID).Return(items, nil)
+
+	checklists, err := ts.todoUseCase.GetChecklistsByCard(ts.ctx, cardID)
+
+	assert.Nil(t, err)
+	if assert.Len(t, checklists, 2) {
+		assert.Equal(t, items, checklists[0].Items)
+		assert.Empty(t, checklists[1].Items)
+		assert.NotNil(t, checklists[1].Items)
+	}
+}
+
+// CreateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error
+func TestCreateChecklistItem(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		item       *entity.ChecklistItem
+		mockRepoFn func(item *entity.ChecklistItem)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			item: &entity.ChecklistItem{ChecklistID: uuid.New(), Text: "Tag the release", Done: true},
+			mockRepoFn: func(item *entity.ChecklistItem) {
+				ts.mockChecklistAccess(item.ChecklistID)
+				ts.mockChecklistRepo.On("LockChecklist", ts.ctx, item.ChecklistID).Return(nil)
+				ts.mockChecklistRepo.On("GetItemPositions", ts.ctx, item.ChecklistID).Return([]entity.Position{{ID: uuid.New(), Position: 1024}}, nil)
+				ts.mockChecklistRepo.On("CreateItem", ts.ctx, item).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "text too long",
+			item:       &entity.ChecklistItem{ChecklistID: uuid.New(), Text: string(make([]rune, 513))},
+			mockRepoFn: func(item *entity.ChecklistItem) {},
+			wantErr:    true,
+			err:        v1.ErrChecklistItemTooLong,
+		},
+		{
+			name: "checklist deleted before lock",
+			item: &entity.ChecklistItem{ChecklistID: uuid.New(), Text: "Tag the release"},
+			mockRepoFn: func(item *entity.ChecklistItem) {
+				ts.mockChecklistAccess(item.ChecklistID)
+				ts.mockChecklistRepo.On("LockChecklist", ts.ctx, item.ChecklistID).Return(repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.item)
+
+			err := ts.todoUseCase.CreateChecklistItem(ts.ctx, tt.item)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockChecklistRepo.AssertNotCalled(t, "CreateItem", ts.ctx, tt.item)
+			} else {
+				assert.Nil(t, err)
+				assert.False(t, tt.item.Done)
+				assert.Equal(t, float64(2048), tt.item.Position)
+				ts.mockChecklistRepo.AssertCalled(t, "CreateItem", ts.ctx, tt.item)
+			}
+		})
+	}
+}
+
+// SetChecklistItemDone(ctx context.Context, id uuid.UUID, done bool) (*entity.ChecklistItem, error)
+func TestSetChecklistItemDone(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		done       bool
+		mockRepoFn func(id uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "check",
+			done: true,
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockChecklistItemAccess(&entity.ChecklistItem{ID: id, ChecklistID: uuid.New(), Text: "Tag"})
+				ts.mockChecklistRepo.On("UpdateItem", ts.ctx, mock.MatchedBy(func(item *entity.ChecklistItem) bool {
+					return item.ID == id && item.Done && item.Text == "Tag"
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "uncheck",
+			done: false,
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockChecklistItemAccess(&entity.ChecklistItem{ID: id, ChecklistID: uuid.New(), Text: "Tag", Done: true})
+				ts.mockChecklistRepo.On("UpdateItem", ts.ctx, mock.MatchedBy(func(item *entity.ChecklistItem) bool {
+					return item.ID == id && !item.Done
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "item not found",
+			done: true,
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockChecklistRepo.On("GetItemByID", ts.ctx, id).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+		{
+			name: "failed to update item",
+			done: true,
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockChecklistItemAccess(&entity.ChecklistItem{ID: id, ChecklistID: uuid.New(), Text: "Tag"})
+				ts.mockChecklistRepo.On("UpdateItem", ts.ctx, mock.MatchedBy(func(item *entity.ChecklistItem) bool {
+					return item.ID == id
+				})).Return(errors.New(""))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			id := uuid.New()
+			tt.mockRepoFn(id)
+
+			item, err := ts.todoUseCase.SetChecklistItemDone(ts.ctx, id, tt.done)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, item)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.done, item.Done)
+			}
+		})
+	}
+}
+
+// UpdateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error
+func TestUpdateChecklistItemKeepsState(t *testing.T) {
+	ts := setup()
+
+	current := &entity.ChecklistItem{ID: uuid.New(), ChecklistID: uuid.New(), Text: "Tag", Done: true, Position: 2048}
+	ts.mockChecklistItemAccess(current)
+	ts.mockChecklistRepo.On("UpdateItem", ts.ctx, mock.Anything).Return(nil)
+
+	item := &entity.ChecklistItem{ID: current.ID, Text: "Tag the release"}
+	err := ts.todoUseCase.UpdateChecklistItem(ts.ctx, item)
+
+	assert.Nil(t, err)
+	assert.Equal(t, current.ChecklistID, item.ChecklistID)
+	assert.True(t, item.Done)
+	assert.Equal(t, current.Position, item.Position)
+}
+
+func TestCardsComeWithChecklistProgress(t *testing.T) {
+	ts := setup()
+
+	columnID := uuid.New()
+	cards := []entity.Card{{ID: uuid.New(), ColumnID: columnID}, {ID: uuid.New(), ColumnID: columnID}}
+
+	ts.mockColumnAccess(columnID)
+	ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, 10, 0).Return(cards, nil)
+	ts.mockLabelRepo.On("GetLabelsByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]entity.Label{}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", ts.ctx, []uuid.UUID{cards[0].ID, cards[1].ID}).Return(map[uuid.UUID]entity.ChecklistProgress{
+		cards[0].ID: {Done: 2, Total: 3},
+	}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+	got, err := ts.todoUseCase.GetCardsByColumn(ts.ctx, columnID, entity.CardFilter{}, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, entity.ChecklistProgress{Done: 2, Total: 3}, got[0].Progress)
+	assert.Equal(t, entity.ChecklistProgress{}, got[1].Progress)
+}
+
+// mockChecklistAccess puts the checklist on a card the test caller may edit
+func (ts *testSetup) mockChecklistAccess(checklistID uuid.UUID) {
+	cardID := uuid.New()
+	ts.mockChecklistRepo.On("GetChecklistByID", ts.ctx, checklistID).Return(&entity.Checklist{ID: checklistID, CardID: cardID}, nil)
+	ts.mockCardAccess(cardID)
+}
+
+func (ts *testSetup) mockChecklistItemAccess(item *entity.ChecklistItem) {
+	ts.mockChecklistRepo.On("GetItemByID", ts.ctx, item.ID).Return(item, nil)
+	ts.mockChecklistAccess(item.ChecklistID)
+}
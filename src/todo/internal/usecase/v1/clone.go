@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// CloneBoard makes a deep copy of the board: its labels, its columns that
+// are not archived and, unless excluded, their cards in the same order with
+// their labels. Checklists, comments, attachments, assignees and card dates
+// stay behind. The copy belongs to the caller and is made in one
+// transaction, so it is either complete or not there at all.
+//
+// Saving a board as a template and creating a board from a template are
+// both clones; opts.Template tells which one the copy is.
+func (uc *todoUseCase) CloneBoard(ctx context.Context, id uuid.UUID, opts entity.CloneOptions) (*entity.Board, error) {
+	header := "CloneBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id, "opts", opts)
+
+	source, err := uc.authorizeBoard(ctx, id, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	// authorizeBoard has already checked that the caller is present
+	caller, _ := callerFromContext(ctx)
+
+	now := time.Now()
+
+	board := &entity.Board{
+		ID:         uuid.New(),
+		UserID:     caller.UserID,
+		Title:      opts.Title,
+		IsTemplate: opts.Template,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if board.Title == "" {
+		board.Title = source.Title
+	}
+
+	uc.log.Info(ctx, header+"Copying board", "board", board)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		tree, err := uc.loadBoardTree(ctx, source)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.boardRepo.CreateBoard(ctx, board); err != nil {
+			return err
+		}
+
+		labels, err := uc.cloneLabels(ctx, source.ID, board.ID, now)
+		if err != nil {
+			return err
+		}
+
+		for _, columnTree := range tree.Columns {
+			if err := uc.cloneColumn(ctx, columnTree, board, labels, opts.ExcludeCards); err != nil {
+				return err
+			}
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityCreate, entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, fieldChanges(nil, boardFields(board)))
+	})
+
+	if err != nil {
+		info := "Failed to clone board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully cloned", "id", board.ID)
+
+	return board, nil
+}
+
+func (uc *todoUseCase) GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+	header := "GetTemplatesByUser: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "userID", userID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board repo (GetTemplatesByUser)", "userID", userID)
+
+	templates, err := uc.boardRepo.GetTemplatesByUser(ctx, userID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get templates"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got templates", "count", len(templates))
+
+	return templates, nil
+}
+
+// cloneLabels copies the labels of one board to another and returns the ids
+// of the copies keyed by the ids of the originals
+func (uc *todoUseCase) cloneLabels(ctx context.Context, sourceID, boardID uuid.UUID, now time.Time) (map[uuid.UUID]uuid.UUID, error) {
+	labels, err := uc.labelRepo.GetLabelsByBoard(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	copies := make(map[uuid.UUID]uuid.UUID, len(labels))
+
+	for _, label := range labels {
+		clone := label
+		clone.ID = uuid.New()
+		clone.BoardID = boardID
+		clone.CreatedAt = now
+		clone.UpdatedAt = now
+
+		if err := uc.labelRepo.CreateLabel(ctx, &clone); err != nil {
+			return nil, err
+		}
+
+		copies[label.ID] = clone.ID
+	}
+
+	return copies, nil
+}
+
+func (uc *todoUseCase) cloneColumn(ctx context.Context, source entity.ColumnTree, board *entity.Board, labels map[uuid.UUID]uuid.UUID, excludeCards bool) error {
+	column := &entity.Column{
+		ID:        uuid.New(),
+		UserID:    board.UserID,
+		BoardID:   board.ID,
+		Title:     source.Column.Title,
+		Position:  source.Column.Position,
+		WIPLimit:  source.Column.WIPLimit,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.CreatedAt,
+	}
+
+	if err := uc.columnRepo.CreateColumn(ctx, column); err != nil {
+		return err
+	}
+
+	if excludeCards {
+		return nil
+	}
+
+	for _, card := range source.Cards {
+		clone := &entity.Card{
+			ID:          uuid.New(),
+			UserID:      board.UserID,
+			ColumnID:    column.ID,
+			Title:       card.Title,
+			Description: card.Description,
+			Position:    card.Position,
+			CreatedAt:   board.CreatedAt,
+			UpdatedAt:   board.CreatedAt,
+		}
+
+		if err := uc.cardRepo.CreateCard(ctx, clone); err != nil {
+			return err
+		}
+
+		if err := uc.recordRevision(ctx, clone, nil); err != nil {
+			return err
+		}
+
+		for _, label := range card.Labels {
+			labelID, ok := labels[label.ID]
+			if !ok {
+				continue
+			}
+
+			if err := uc.labelRepo.AttachLabel(ctx, clone.ID, labelID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
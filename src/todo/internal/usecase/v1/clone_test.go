@@ -0,0 +1,156 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockBoardToClone puts a column with one labelled card on the board
+func (ts *testSetup) mockBoardToClone(board *entity.Board) (entity.Column, entity.Card, entity.Label) {
+	label := entity.Label{ID: uuid.New(), BoardID: board.ID, Name: "Bug", Color: "#d73a4a"}
+	column := entity.Column{ID: uuid.New(), BoardID: board.ID, Title: "Backlog", Position: 1024}
+	due := time.Now()
+	card := entity.Card{ID: uuid.New(), ColumnID: column.ID, Title: "Card", Description: "Text", Position: 2048, DueDate: &due}
+
+	ts.mockColumnRepo.On("GetColumnsByBoard", mock.Anything, board.ID, false, mock.Anything, 0).Return([]entity.Column{column}, nil)
+	ts.mockCardRepo.On("GetCardsByColumn", mock.Anything, column.ID, entity.CardFilter{}, mock.Anything, 0).Return([]entity.Card{card}, nil)
+	ts.mockLabelRepo.On("GetLabelsByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]entity.Label{card.ID: {label}}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil)
+	ts.mockLabelRepo.On("GetLabelsByBoard", mock.Anything, board.ID).Return([]entity.Label{label}, nil)
+
+	return column, card, label
+}
+
+// CloneBoard(ctx context.Context, id uuid.UUID, opts entity.CloneOptions) (*entity.Board, error)
+func TestCloneBoard(t *testing.T) {
+	ownerID := uuid.New()
+	viewerID := uuid.New()
+
+	t.Run("deep copy by a viewer", func(t *testing.T) {
+		ts := setup()
+		ctx := userContext(viewerID)
+		source := &entity.Board{ID: uuid.New(), UserID: ownerID, Title: "Sprint", CreatedAt: time.Now().Add(-time.Hour)}
+
+		ts.mockBoardRepo.On("GetBoardByID", ctx, source.ID).Return(source, nil)
+		ts.mockMemberRepo.On("GetMember", ctx, source.ID, viewerID).Return(&entity.BoardMember{Role: entity.RoleViewer, Accepted: true}, nil)
+		column, card, label := ts.mockBoardToClone(source)
+
+		var board *entity.Board
+		var newColumn *entity.Column
+		var newCard *entity.Card
+		var newLabel *entity.Label
+		ts.mockBoardRepo.On("CreateBoard", ctx, mock.Anything).Run(func(args mock.Arguments) { board = args.Get(1).(*entity.Board) }).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ctx, mock.Anything).Run(func(args mock.Arguments) { newLabel = args.Get(1).(*entity.Label) }).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ctx, mock.Anything).Run(func(args mock.Arguments) { newColumn = args.Get(1).(*entity.Column) }).Return(nil)
+		ts.mockCardRepo.On("CreateCard", ctx, mock.Anything).Run(func(args mock.Arguments) { newCard = args.Get(1).(*entity.Card) }).Return(nil)
+		ts.mockLabelRepo.On("AttachLabel", ctx, mock.Anything, mock.Anything).Return(nil)
+
+		got, err := ts.todoUseCase.CloneBoard(ctx, source.ID, entity.CloneOptions{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, board, got)
+		assert.NotEqual(t, source.ID, got.ID)
+		assert.Equal(t, viewerID, got.UserID)
+		assert.Equal(t, "Sprint", got.Title)
+		assert.False(t, got.IsTemplate)
+		assert.True(t, got.CreatedAt.After(source.CreatedAt))
+
+		assert.Equal(t, got.ID, newLabel.BoardID)
+		assert.Equal(t, label.Name, newLabel.Name)
+		assert.NotEqual(t, label.ID, newLabel.ID)
+
+		assert.Equal(t, got.ID, newColumn.BoardID)
+		assert.Equal(t, column.Title, newColumn.Title)
+		assert.Equal(t, column.Position, newColumn.Position)
+		assert.Equal(t, viewerID, newColumn.UserID)
+
+		assert.Equal(t, newColumn.ID, newCard.ColumnID)
+		assert.Equal(t, card.Title, newCard.Title)
+		assert.Equal(t, card.Description, newCard.Description)
+		assert.Equal(t, card.Position, newCard.Position)
+		assert.Equal(t, viewerID, newCard.UserID)
+		assert.Nil(t, newCard.DueDate)
+
+		ts.mockLabelRepo.AssertCalled(t, "AttachLabel", ctx, newCard.ID, newLabel.ID)
+	})
+
+	t.Run("template without cards", func(t *testing.T) {
+		ts := setup()
+		source := &entity.Board{ID: uuid.New(), UserID: ownerID, Title: "Sprint"}
+		ts.mockBoardRepo.On("GetBoardByID", ts.ctx, source.ID).Return(source, nil)
+		ts.mockBoardToClone(source)
+		ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ts.ctx, mock.Anything).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Return(nil)
+
+		got, err := ts.todoUseCase.CloneBoard(ts.ctx, source.ID, entity.CloneOptions{Title: "Sprint template", ExcludeCards: true, Template: true})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Sprint template", got.Title)
+		assert.True(t, got.IsTemplate)
+
+		// Admins clone into their own account as well
+		caller, _ := identity.FromContext(ts.ctx)
+		assert.Equal(t, caller.UserID, got.UserID)
+
+		ts.mockColumnRepo.AssertNumberOfCalls(t, "CreateColumn", 1)
+		ts.mockCardRepo.AssertNotCalled(t, "CreateCard", mock.Anything, mock.Anything)
+		ts.mockLabelRepo.AssertNotCalled(t, "AttachLabel", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no access to the board", func(t *testing.T) {
+		ts := setup()
+		ctx := userContext(uuid.New())
+		source := &entity.Board{ID: uuid.New(), UserID: ownerID, Title: "Sprint"}
+		ts.mockBoardRepo.On("GetBoardByID", ctx, source.ID).Return(source, nil)
+		ts.mockMemberRepo.On("GetMember", ctx, source.ID, mock.Anything).Return(nil, errors.New("no member"))
+
+		got, err := ts.todoUseCase.CloneBoard(ctx, source.ID, entity.CloneOptions{})
+
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		ts.mockBoardRepo.AssertNotCalled(t, "CreateBoard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("copy fails halfway", func(t *testing.T) {
+		ts := setup()
+		source := &entity.Board{ID: uuid.New(), UserID: ownerID, Title: "Sprint"}
+		ts.mockBoardRepo.On("GetBoardByID", ts.ctx, source.ID).Return(source, nil)
+		ts.mockBoardToClone(source)
+		ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ts.ctx, mock.Anything).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Return(errors.New("db down"))
+
+		got, err := ts.todoUseCase.CloneBoard(ts.ctx, source.ID, entity.CloneOptions{})
+
+		assert.ErrorContains(t, err, "CloneBoard: Failed to clone board")
+		assert.Nil(t, got)
+		ts.mockActivityRepo.AssertNotCalled(t, "CreateActivity", mock.Anything, mock.Anything)
+	})
+}
+
+// GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+func TestGetTemplatesByUser(t *testing.T) {
+	ts := setup()
+	userID := uuid.New()
+
+	ts.mockBoardRepo.On("GetTemplatesByUser", mock.Anything, userID, 10, 0).Return([]entity.Board{{ID: uuid.New(), IsTemplate: true}}, nil)
+
+	templates, err := ts.todoUseCase.GetTemplatesByUser(userContext(userID), userID, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+
+	_, err = ts.todoUseCase.GetTemplatesByUser(userContext(uuid.New()), userID, 10, 0)
+
+	assert.ErrorIs(t, err, v1.ErrForbidden)
+}
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const maxCommentBodyLength = 4096
+
+var (
+	ErrCommentEmptyBody = errors.New("comment should have a body")
+	ErrCommentTooLong   = fmt.Errorf("comment cannot be longer than %d characters", maxCommentBodyLength)
+	ErrNotCommentAuthor = fmt.Errorf("%w: only the author can change a comment", ErrForbidden)
+)
+
+func validateComment(comment *entity.Comment) error {
+	if strings.TrimSpace(comment.Body) == "" {
+		return ErrCommentEmptyBody
+	}
+
+	if len([]rune(comment.Body)) > maxCommentBodyLength {
+		return ErrCommentTooLong
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) CreateComment(ctx context.Context, comment *entity.Comment) error {
+	header := "CreateComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating comment", "comment", comment)
+
+	err := validateComment(comment)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	caller, err := callerFromContext(ctx)
+
+	if err == nil {
+		_, err = uc.authorizeCard(ctx, comment.CardID, entity.RoleEditor)
+	}
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	comment.ID = uuid.New()
+	comment.AuthorID = caller.UserID
+	comment.CreatedAt = time.Now()
+	comment.EditedAt = nil
+
+	uc.log.Info(ctx, header+"Making request to comment repo (CreateComment)", "comment", comment)
+
+	err = uc.commentRepo.CreateComment(ctx, comment)
+
+	if err != nil {
+		info := "Failed to create comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Comment successfully created")
+
+	return nil
+}
+
+func (uc *todoUseCase) GetCommentsByCard(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Comment, error) {
+	header := "GetCommentsByCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card", "cardID", cardID)
+
+	_, err := uc.authorizeCard(ctx, cardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to comment repo (GetCommentsByCard)", "cardID", cardID, "limit", limit, "offset", offset)
+
+	comments, err := uc.commentRepo.GetCommentsByCard(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get comments"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got comments", "count", len(comments))
+
+	return comments, nil
+}
+
+func (uc *todoUseCase) UpdateComment(ctx context.Context, comment *entity.Comment) error {
+	header := "UpdateComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating comment", "comment", comment)
+
+	err := validateComment(comment)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	current, err := uc.authorizeComment(ctx, comment.ID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	editedAt := time.Now()
+
+	comment.CardID = current.CardID
+	comment.AuthorID = current.AuthorID
+	comment.CreatedAt = current.CreatedAt
+	comment.EditedAt = &editedAt
+
+	uc.log.Info(ctx, header+"Making request to comment repo (UpdateComment)", "comment", comment)
+
+	err = uc.commentRepo.UpdateComment(ctx, comment)
+
+	if err != nil {
+		info := "Failed to update comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Comment successfully updated")
+
+	return nil
+}
+
+func (uc *todoUseCase) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteComment: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to comment", "id", id)
+
+	_, err := uc.authorizeComment(ctx, id)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to comment repo (DeleteComment)", "id", id)
+
+	err = uc.commentRepo.DeleteComment(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete comment"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Comment successfully deleted")
+
+	return nil
+}
+
+// authorizeComment checks that the caller can still see the card of the
+// comment, that they either wrote the comment or are an admin, and that the
+// card is not archived
+func (uc *todoUseCase) authorizeComment(ctx context.Context, commentID uuid.UUID) (*entity.Comment, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := uc.commentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := uc.authorizeCard(ctx, comment.CardID, entity.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !caller.IsAdmin() && caller.UserID != comment.AuthorID {
+		return nil, ErrNotCommentAuthor
+	}
+
+	if err := uc.requireWritableColumn(ctx, card.ColumnID); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
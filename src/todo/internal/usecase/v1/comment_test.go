@@ -0,0 +1,196 @@
+package v1_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateComment(ctx context.Context, comment *entity.Comment) error
+func TestCreateComment(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		comment    *entity.Comment
+		mockRepoFn func(comment *entity.Comment)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:    "success",
+			comment: &entity.Comment{CardID: uuid.New(), Body: "Looks good to me"},
+			mockRepoFn: func(comment *entity.Comment) {
+				ts.mockCardAccess(comment.CardID)
+				ts.mockCommentRepo.On("CreateComment", ts.ctx, comment).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "empty body",
+			comment:    &entity.Comment{CardID: uuid.New(), Body: " \n"},
+			mockRepoFn: func(comment *entity.Comment) {},
+			wantErr:    true,
+			err:        v1.ErrCommentEmptyBody,
+		},
+		{
+			name:       "body too long",
+			comment:    &entity.Comment{CardID: uuid.New(), Body: strings.Repeat("a", 4097)},
+			mockRepoFn: func(comment *entity.Comment) {},
+			wantErr:    true,
+			err:        v1.ErrCommentTooLong,
+		},
+		{
+			name:    "card not found",
+			comment: &entity.Comment{CardID: uuid.New(), Body: "Looks good to me"},
+			mockRepoFn: func(comment *entity.Comment) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, comment.CardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.comment)
+
+			err := ts.todoUseCase.CreateComment(ts.ctx, tt.comment)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockCommentRepo.AssertNotCalled(t, "CreateComment", ts.ctx, tt.comment)
+			} else {
+				assert.Nil(t, err)
+				assert.NotEqual(t, uuid.Nil, tt.comment.ID)
+				assert.NotEqual(t, uuid.Nil, tt.comment.AuthorID)
+				assert.Nil(t, tt.comment.EditedAt)
+				ts.mockCommentRepo.AssertCalled(t, "CreateComment", ts.ctx, tt.comment)
+			}
+		})
+	}
+}
+
+// UpdateComment(ctx context.Context, comment *entity.Comment) error
+func TestUpdateComment(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name string
+		// caller returns the context of the user changing the comment
+		caller     func(authorID uuid.UUID) context.Context
+		mockRepoFn func(ctx context.Context, comment *entity.Comment, authorID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "by the author",
+			caller: func(authorID uuid.UUID) context.Context { return userContext(authorID) },
+			mockRepoFn: func(ctx context.Context, comment *entity.Comment, authorID uuid.UUID) {
+				ts.mockCommentedCard(ctx, comment.ID, authorID, authorID)
+				ts.mockCommentRepo.On("UpdateComment", ctx, comment).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "by an admin",
+			caller: func(authorID uuid.UUID) context.Context { return ts.ctx },
+			mockRepoFn: func(ctx context.Context, comment *entity.Comment, authorID uuid.UUID) {
+				ts.mockCommentedCard(ctx, comment.ID, authorID, uuid.New())
+				ts.mockCommentRepo.On("UpdateComment", ctx, comment).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "by another member",
+			caller: func(authorID uuid.UUID) context.Context { return userContext(uuid.New()) },
+			mockRepoFn: func(ctx context.Context, comment *entity.Comment, authorID uuid.UUID) {
+				caller, _ := identity.FromContext(ctx)
+				ts.mockCommentedCard(ctx, comment.ID, authorID, caller.UserID)
+			},
+			wantErr: true,
+			err:     v1.ErrNotCommentAuthor,
+		},
+		{
+			name:   "comment not found",
+			caller: func(authorID uuid.UUID) context.Context { return userContext(authorID) },
+			mockRepoFn: func(ctx context.Context, comment *entity.Comment, authorID uuid.UUID) {
+				ts.mockCommentRepo.On("GetCommentByID", ctx, comment.ID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			authorID := uuid.New()
+			ctx := tt.caller(authorID)
+			comment := &entity.Comment{ID: uuid.New(), Body: "Edited"}
+			tt.mockRepoFn(ctx, comment, authorID)
+
+			err := ts.todoUseCase.UpdateComment(ctx, comment)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockCommentRepo.AssertNotCalled(t, "UpdateComment", ctx, comment)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, authorID, comment.AuthorID)
+				assert.NotNil(t, comment.EditedAt)
+				ts.mockCommentRepo.AssertCalled(t, "UpdateComment", ctx, comment)
+			}
+		})
+	}
+}
+
+// DeleteComment(ctx context.Context, id uuid.UUID) error
+func TestDeleteComment(t *testing.T) {
+	ts := setup()
+
+	t.Run("by the author", func(t *testing.T) {
+		t.Parallel()
+		id, authorID := uuid.New(), uuid.New()
+		ctx := userContext(authorID)
+		ts.mockCommentedCard(ctx, id, authorID, uuid.New())
+		ts.mockCommentRepo.On("DeleteComment", ctx, id).Return(nil)
+
+		err := ts.todoUseCase.DeleteComment(ctx, id)
+
+		assert.Nil(t, err)
+		ts.mockCommentRepo.AssertCalled(t, "DeleteComment", ctx, id)
+	})
+
+	t.Run("by the board owner", func(t *testing.T) {
+		t.Parallel()
+		id, ownerID := uuid.New(), uuid.New()
+		ctx := userContext(ownerID)
+		ts.mockCommentedCard(ctx, id, uuid.New(), ownerID)
+
+		err := ts.todoUseCase.DeleteComment(ctx, id)
+
+		assert.ErrorIs(t, err, v1.ErrForbidden)
+		ts.mockCommentRepo.AssertNotCalled(t, "DeleteComment", ctx, id)
+	})
+}
+
+// mockCommentedCard puts a comment of the author on a card of a board owned
+// by the owner; members of the board are not mocked
+func (ts *testSetup) mockCommentedCard(ctx context.Context, commentID, authorID, ownerID uuid.UUID) {
+	cardID, columnID, boardID := uuid.New(), uuid.New(), uuid.New()
+	ts.mockCommentRepo.On("GetCommentByID", ctx, commentID).Return(&entity.Comment{ID: commentID, CardID: cardID, AuthorID: authorID}, nil)
+	ts.mockCardRepo.On("GetCardByID", ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+	ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: ownerID}, nil)
+	ts.mockMemberRepo.On("GetMember", ctx, boardID, mock.Anything).Return(&entity.BoardMember{BoardID: boardID, Role: entity.RoleViewer, Accepted: true}, nil)
+}
@@ -0,0 +1,145 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const maxDueWithinDays = 365
+
+var (
+	ErrCardStartAfterDue = errors.New("card cannot start after it is due")
+	ErrInvalidDueWithin  = fmt.Errorf("number of days should be between 1 and %d", maxDueWithinDays)
+)
+
+func validateCardDates(dates entity.CardDates) error {
+	if dates.StartDate != nil && dates.DueDate != nil && dates.StartDate.After(*dates.DueDate) {
+		return ErrCardStartAfterDue
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) SetCardDates(ctx context.Context, id uuid.UUID, dates entity.CardDates) (*entity.Card, error) {
+	header := "SetCardDates: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating dates", "id", id, "dates", dates)
+
+	err := validateCardDates(dates)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card, err := uc.authorizeCard(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	before := cardFields(card)
+
+	card.StartDate = dates.StartDate
+	card.DueDate = dates.DueDate
+	card.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to card repo (SetCardDates)", "card", card)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cardRepo.SetCardDates(ctx, card); err != nil {
+			return err
+		}
+
+		boardID, err := uc.boardOfCard(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetCard, card.ID, []uuid.UUID{boardID}, fieldChanges(before, cardFields(card)))
+	})
+
+	if err != nil {
+		info := "Failed to set card dates"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card dates successfully set")
+
+	return card, nil
+}
+
+func (uc *todoUseCase) GetOverdueCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+	header := "GetOverdueCards: "
+
+	uc.log.Info(ctx, header+"Usecase called", "userID", userID, "limit", limit, "offset", offset)
+
+	return uc.getDueCards(ctx, header, userID, time.Time{}, time.Now(), limit, offset)
+}
+
+func (uc *todoUseCase) GetDueCards(ctx context.Context, userID uuid.UUID, days, limit, offset int) ([]entity.Card, error) {
+	header := "GetDueCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating number of days", "userID", userID, "days", days, "limit", limit, "offset", offset)
+
+	if days < 1 || days > maxDueWithinDays {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrInvalidDueWithin.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrInvalidDueWithin)
+	}
+
+	now := time.Now()
+
+	return uc.getDueCards(ctx, header, userID, now, now.AddDate(0, 0, days), limit, offset)
+}
+
+// getDueCards returns the cards due in [from, to) across all boards the user
+// owns or is a member of
+func (uc *todoUseCase) getDueCards(ctx context.Context, header string, userID uuid.UUID, from, to time.Time, limit, offset int) ([]entity.Card, error) {
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (GetDueCardsByUser)", "userID", userID, "from", from, "to", to)
+
+	cards, err := uc.cardRepo.GetDueCardsByUser(ctx, userID, from, to, limit, offset)
+
+	if err != nil {
+		info := "Failed to get due cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got cards", "cards", cards)
+
+	return cards, nil
+}
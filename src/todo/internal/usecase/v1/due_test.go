@@ -0,0 +1,190 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SetCardDates(ctx context.Context, id uuid.UUID, dates entity.CardDates) (*entity.Card, error)
+func TestSetCardDates(t *testing.T) {
+	ts := setup()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := start.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name       string
+		dates      entity.CardDates
+		mockRepoFn func(cardID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success",
+			dates: entity.CardDates{StartDate: &start, DueDate: &due},
+			mockRepoFn: func(cardID uuid.UUID) {
+				ts.mockCardAccess(cardID)
+				ts.mockCardRepo.On("SetCardDates", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+					return c.ID == cardID
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "only due date",
+			dates: entity.CardDates{DueDate: &due},
+			mockRepoFn: func(cardID uuid.UUID) {
+				ts.mockCardAccess(cardID)
+				ts.mockCardRepo.On("SetCardDates", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+					return c.ID == cardID
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "start after due",
+			dates:      entity.CardDates{StartDate: &due, DueDate: &start},
+			mockRepoFn: func(cardID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrCardStartAfterDue,
+		},
+		{
+			name:  "card not found",
+			dates: entity.CardDates{DueDate: &due},
+			mockRepoFn: func(cardID uuid.UUID) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID := uuid.New()
+			tt.mockRepoFn(cardID)
+
+			card, err := ts.todoUseCase.SetCardDates(ts.ctx, cardID, tt.dates)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, card)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.dates.StartDate, card.StartDate)
+			assert.Equal(t, tt.dates.DueDate, card.DueDate)
+			ts.mockCardRepo.AssertCalled(t, "SetCardDates", ts.ctx, card)
+		})
+	}
+}
+
+func TestCreateCardStartingAfterDue(t *testing.T) {
+	ts := setup()
+
+	start := time.Now()
+	due := start.Add(-time.Hour)
+	card := &entity.Card{UserID: uuid.New(), ColumnID: uuid.New(), Title: "Title", StartDate: &start, DueDate: &due}
+
+	err := ts.todoUseCase.CreateCard(ts.ctx, card)
+
+	assert.ErrorIs(t, err, v1.ErrCardStartAfterDue)
+	ts.mockCardRepo.AssertNotCalled(t, "CreateCard", ts.ctx, card)
+}
+
+// GetOverdueCards(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Card, error)
+func TestGetOverdueCards(t *testing.T) {
+	ts := setup()
+
+	userID := uuid.New()
+	cards := []entity.Card{{ID: uuid.New()}}
+
+	ts.mockCardRepo.On("GetDueCardsByUser", ts.ctx, userID, mock.Anything, mock.Anything, 10, 0).Return(cards, nil)
+	ts.mockNoCardDetails()
+
+	before := time.Now()
+	got, err := ts.todoUseCase.GetOverdueCards(ts.ctx, userID, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, cards, got)
+	ts.mockCardRepo.AssertCalled(t, "GetDueCardsByUser", ts.ctx, userID, time.Time{}, mock.MatchedBy(func(to time.Time) bool {
+		return !to.Before(before) && !to.After(time.Now())
+	}), 10, 0)
+}
+
+// GetDueCards(ctx context.Context, userID uuid.UUID, days, limit, offset int) ([]entity.Card, error)
+func TestGetDueCards(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		days       int
+		mockRepoFn func(userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			days: 7,
+			mockRepoFn: func(userID uuid.UUID) {
+				ts.mockCardRepo.On("GetDueCardsByUser", ts.ctx, userID, mock.Anything, mock.Anything, 10, 0).Return([]entity.Card{}, nil)
+				ts.mockNoCardDetails()
+			},
+			wantErr: false,
+		},
+		{
+			name:       "zero days",
+			days:       0,
+			mockRepoFn: func(userID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidDueWithin,
+		},
+		{
+			name:       "too many days",
+			days:       366,
+			mockRepoFn: func(userID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidDueWithin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			userID := uuid.New()
+			tt.mockRepoFn(userID)
+
+			_, err := ts.todoUseCase.GetDueCards(ts.ctx, userID, tt.days, 10, 0)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockCardRepo.AssertNotCalled(t, "GetDueCardsByUser", ts.ctx, userID, mock.Anything, mock.Anything, 10, 0)
+				return
+			}
+
+			assert.Nil(t, err)
+			ts.mockCardRepo.AssertCalled(t, "GetDueCardsByUser", ts.ctx, userID, mock.MatchedBy(func(from time.Time) bool {
+				return !from.IsZero()
+			}), mock.MatchedBy(func(to time.Time) bool {
+				return to.After(time.Now().AddDate(0, 0, tt.days-1))
+			}), 10, 0)
+		})
+	}
+}
+
+func TestGetDueCardsOfAnotherUser(t *testing.T) {
+	ts := setup()
+
+	_, err := ts.todoUseCase.GetDueCards(userContext(uuid.New()), uuid.New(), 7, 10, 0)
+
+	assert.ErrorIs(t, err, v1.ErrForbidden)
+}
@@ -0,0 +1,285 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrExportVersion = fmt.Errorf("board export version should be between 1 and %d", entity.BoardExportVersion)
+	ErrInvalidExport = errors.New("invalid board export")
+)
+
+// ExportBoard returns the board with its labels and all of its columns and
+// cards, the archived ones included
+func (uc *todoUseCase) ExportBoard(ctx context.Context, id uuid.UUID) (*entity.BoardExport, error) {
+	header := "ExportBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id)
+
+	board, err := uc.authorizeBoard(ctx, id, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	export := &entity.BoardExport{
+		Version:    entity.BoardExportVersion,
+		ExportedAt: time.Now(),
+		Board:      *board,
+	}
+
+	uc.log.Info(ctx, header+"Loading board contents", "id", id)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		labels, err := uc.labelRepo.GetLabelsByBoard(ctx, board.ID)
+		if err != nil {
+			return err
+		}
+
+		columns, err := uc.loadColumnTrees(ctx, board.ID, false)
+		if err != nil {
+			return err
+		}
+
+		archived, err := uc.loadColumnTrees(ctx, board.ID, true)
+		if err != nil {
+			return err
+		}
+
+		export.Labels = labels
+		export.Columns = append(columns, archived...)
+
+		return nil
+	})
+
+	if err != nil {
+		info := "Failed to export board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully exported", "columns", len(export.Columns))
+
+	return export, nil
+}
+
+// ImportBoard creates a new board of the caller from the export. Everything
+// gets a fresh id while the titles, positions and timestamps are kept. The
+// whole export is validated before anything is written and is then written
+// in one transaction, so a failed import leaves nothing behind.
+func (uc *todoUseCase) ImportBoard(ctx context.Context, export *entity.BoardExport) (*entity.Board, error) {
+	header := "ImportBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking the caller", "version", export.Version)
+
+	caller, err := callerFromContext(ctx)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = prepareImport(export, caller.UserID, time.Now())
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	board := &export.Board
+
+	uc.log.Info(ctx, header+"Successful validation; Writing board", "board", board)
+
+	err = uc.writeImport(ctx, export)
+
+	if err != nil {
+		info := "Failed to import board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully imported", "id", board.ID)
+
+	return board, nil
+}
+
+// writeImport writes a prepared import in one transaction
+func (uc *todoUseCase) writeImport(ctx context.Context, export *entity.BoardExport) error {
+	board := &export.Board
+
+	return uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.CreateBoard(ctx, board); err != nil {
+			return err
+		}
+
+		if board.ArchivedAt != nil {
+			if err := uc.boardRepo.UpdateBoardArchived(ctx, board); err != nil {
+				return err
+			}
+		}
+
+		for i := range export.Labels {
+			if err := uc.labelRepo.CreateLabel(ctx, &export.Labels[i]); err != nil {
+				return err
+			}
+		}
+
+		for _, tree := range export.Columns {
+			if err := uc.importColumn(ctx, tree); err != nil {
+				return err
+			}
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityCreate, entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, fieldChanges(nil, boardFields(board)))
+	})
+}
+
+func (uc *todoUseCase) importColumn(ctx context.Context, tree entity.ColumnTree) error {
+	column := tree.Column
+
+	if err := uc.columnRepo.CreateColumn(ctx, &column); err != nil {
+		return err
+	}
+
+	if column.ArchivedAt != nil {
+		if err := uc.columnRepo.UpdateColumnArchived(ctx, &column); err != nil {
+			return err
+		}
+	}
+
+	for _, card := range tree.Cards {
+		if err := uc.cardRepo.CreateCard(ctx, &card); err != nil {
+			return err
+		}
+
+		if err := uc.recordRevision(ctx, &card, nil); err != nil {
+			return err
+		}
+
+		for _, label := range card.Labels {
+			if err := uc.labelRepo.AttachLabel(ctx, card.ID, label.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// prepareImport gives everything in the export a fresh id and the user as
+// its owner, points the cards at the new ids of their labels and validates
+// the result the way the items would be validated if they were created one
+// by one. Missing timestamps are set to now.
+func prepareImport(export *entity.BoardExport, userID uuid.UUID, now time.Time) error {
+	if export.Version < 1 || export.Version > entity.BoardExportVersion {
+		return ErrExportVersion
+	}
+
+	board := &export.Board
+	board.ID = uuid.New()
+	board.UserID = userID
+	board.IsPublic = false
+	stampImported(&board.CreatedAt, &board.UpdatedAt, now)
+
+	if err := validateBoard(board); err != nil {
+		return fmt.Errorf("%w: board: %w", ErrInvalidExport, err)
+	}
+
+	labelIDs := make(map[uuid.UUID]uuid.UUID, len(export.Labels))
+	labelNames := make(map[string]bool, len(export.Labels))
+
+	for i := range export.Labels {
+		label := &export.Labels[i]
+
+		if _, ok := labelIDs[label.ID]; ok || label.ID == uuid.Nil {
+			return fmt.Errorf("%w: label %d: label id should be unique", ErrInvalidExport, i+1)
+		}
+
+		// Label names are unique on a board regardless of case
+		name := strings.ToLower(label.Name)
+		if labelNames[name] {
+			return fmt.Errorf("%w: label %d: %w", ErrInvalidExport, i+1, ErrLabelExists)
+		}
+
+		labelNames[name] = true
+
+		labelIDs[label.ID] = uuid.New()
+
+		label.ID = labelIDs[label.ID]
+		label.BoardID = board.ID
+		stampImported(&label.CreatedAt, &label.UpdatedAt, now)
+
+		if err := validateLabel(label); err != nil {
+			return fmt.Errorf("%w: label %d: %w", ErrInvalidExport, i+1, err)
+		}
+	}
+
+	for i := range export.Columns {
+		column := &export.Columns[i].Column
+		column.ID = uuid.New()
+		column.UserID = userID
+		column.BoardID = board.ID
+		stampImported(&column.CreatedAt, &column.UpdatedAt, now)
+
+		if err := validateColumn(column); err != nil {
+			return fmt.Errorf("%w: column %d: %w", ErrInvalidExport, i+1, err)
+		}
+
+		if err := validateWIPLimit(column.WIPLimit); err != nil {
+			return fmt.Errorf("%w: column %d: %w", ErrInvalidExport, i+1, err)
+		}
+
+		cards := export.Columns[i].Cards
+
+		// A strict limit could not have been reached by adding the cards
+		// one by one
+		limit := column.WIPLimit
+		if limit.Strict && limit.Max != 0 && len(cards) > limit.Max {
+			return fmt.Errorf("%w: column %d: %w of %d cards", ErrInvalidExport, i+1, ErrWIPLimitReached, limit.Max)
+		}
+
+		for j := range cards {
+			card := &cards[j]
+			card.ID = uuid.New()
+			card.UserID = userID
+			card.ColumnID = column.ID
+			stampImported(&card.CreatedAt, &card.UpdatedAt, now)
+
+			if err := validateCard(card); err != nil {
+				return fmt.Errorf("%w: column %d, card %d: %w", ErrInvalidExport, i+1, j+1, err)
+			}
+
+			for k, label := range card.Labels {
+				id, ok := labelIDs[label.ID]
+				if !ok {
+					return fmt.Errorf("%w: column %d, card %d: unknown label %s", ErrInvalidExport, i+1, j+1, label.ID)
+				}
+
+				card.Labels[k] = entity.Label{ID: id}
+			}
+		}
+	}
+
+	return nil
+}
+
+func stampImported(createdAt, updatedAt *time.Time, now time.Time) {
+	if createdAt.IsZero() {
+		*createdAt = now
+	}
+
+	if updatedAt.IsZero() {
+		*updatedAt = *createdAt
+	}
+}
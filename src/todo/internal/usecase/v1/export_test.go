@@ -0,0 +1,217 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ExportBoard(ctx context.Context, id uuid.UUID) (*entity.BoardExport, error)
+func TestExportBoard(t *testing.T) {
+	ts := setup()
+	board := &entity.Board{ID: uuid.New(), Title: "Sprint"}
+	archivedAt := time.Now()
+	active := entity.Column{ID: uuid.New(), BoardID: board.ID, Title: "Doing"}
+	archived := entity.Column{ID: uuid.New(), BoardID: board.ID, Title: "Done", ArchivedAt: &archivedAt}
+	label := entity.Label{ID: uuid.New(), BoardID: board.ID, Name: "Bug", Color: "#d73a4a"}
+
+	ts.mockBoardRepo.On("GetBoardByID", ts.ctx, board.ID).Return(board, nil)
+	ts.mockLabelRepo.On("GetLabelsByBoard", mock.Anything, board.ID).Return([]entity.Label{label}, nil)
+	ts.mockColumnRepo.On("GetColumnsByBoard", mock.Anything, board.ID, false, mock.Anything, 0).Return([]entity.Column{active}, nil)
+	ts.mockColumnRepo.On("GetColumnsByBoard", mock.Anything, board.ID, true, mock.Anything, 0).Return([]entity.Column{archived}, nil)
+	ts.mockCardRepo.On("GetCardsByColumn", mock.Anything, active.ID, entity.CardFilter{}, mock.Anything, 0).Return([]entity.Card{{ID: uuid.New(), Title: "Card"}}, nil)
+	ts.mockCardRepo.On("GetCardsByColumn", mock.Anything, archived.ID, entity.CardFilter{}, mock.Anything, 0).Return([]entity.Card{}, nil)
+	ts.mockLabelRepo.On("GetLabelsByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]entity.Label{}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", mock.Anything, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+	export, err := ts.todoUseCase.ExportBoard(ts.ctx, board.ID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, entity.BoardExportVersion, export.Version)
+	assert.Equal(t, *board, export.Board)
+	assert.Equal(t, []entity.Label{label}, export.Labels)
+	if assert.Len(t, export.Columns, 2) {
+		assert.Equal(t, active.ID, export.Columns[0].Column.ID)
+		assert.Len(t, export.Columns[0].Cards, 1)
+		assert.Equal(t, archived.ID, export.Columns[1].Column.ID)
+	}
+}
+
+func boardExport() *entity.BoardExport {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	archivedAt := createdAt.Add(time.Hour)
+	labelID := uuid.New()
+
+	return &entity.BoardExport{
+		Version: entity.BoardExportVersion,
+		Board:   entity.Board{ID: uuid.New(), UserID: uuid.New(), Title: "Sprint", IsPublic: true, CreatedAt: createdAt},
+		Labels:  []entity.Label{{ID: labelID, Name: "Bug", Color: "#d73a4a"}},
+		Columns: []entity.ColumnTree{
+			{
+				Column: entity.Column{Title: "Doing", Position: 1024, WIPLimit: entity.WIPLimit{Max: 1, Strict: true}, CreatedAt: createdAt},
+				Cards:  []entity.Card{{Title: "Card", Position: 2048, CreatedAt: createdAt, Labels: []entity.Label{{ID: labelID}}}},
+			},
+			{
+				Column: entity.Column{Title: "Done", Position: 2048, CreatedAt: createdAt, ArchivedAt: &archivedAt},
+			},
+		},
+	}
+}
+
+// ImportBoard(ctx context.Context, export *entity.BoardExport) (*entity.Board, error)
+func TestImportBoard(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ts := setup()
+		export := boardExport()
+		sourceBoardID := export.Board.ID
+		sourceLabelID := export.Labels[0].ID
+
+		var label *entity.Label
+		var columns []*entity.Column
+		var card *entity.Card
+		ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { label = args.Get(1).(*entity.Label) }).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { columns = append(columns, args.Get(1).(*entity.Column)) }).Return(nil)
+		ts.mockColumnRepo.On("UpdateColumnArchived", ts.ctx, mock.Anything).Return(nil)
+		ts.mockCardRepo.On("CreateCard", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { card = args.Get(1).(*entity.Card) }).Return(nil)
+		ts.mockLabelRepo.On("AttachLabel", ts.ctx, mock.Anything, mock.Anything).Return(nil)
+
+		board, err := ts.todoUseCase.ImportBoard(ts.ctx, export)
+
+		assert.Nil(t, err)
+
+		caller, _ := identity.FromContext(ts.ctx)
+		assert.NotEqual(t, sourceBoardID, board.ID)
+		assert.Equal(t, caller.UserID, board.UserID)
+		assert.False(t, board.IsPublic)
+		assert.Equal(t, export.Board.CreatedAt, board.CreatedAt)
+		assert.Equal(t, board.CreatedAt, board.UpdatedAt)
+
+		assert.Equal(t, board.ID, label.BoardID)
+		assert.NotEqual(t, sourceLabelID, label.ID)
+
+		if assert.Len(t, columns, 2) {
+			assert.Equal(t, board.ID, columns[0].BoardID)
+			assert.Equal(t, caller.UserID, columns[0].UserID)
+			assert.Equal(t, float64(1024), columns[0].Position)
+			assert.Equal(t, entity.WIPLimit{Max: 1, Strict: true}, columns[0].WIPLimit)
+		}
+		ts.mockColumnRepo.AssertNumberOfCalls(t, "UpdateColumnArchived", 1)
+
+		assert.Equal(t, columns[0].ID, card.ColumnID)
+		assert.Equal(t, float64(2048), card.Position)
+		ts.mockLabelRepo.AssertCalled(t, "AttachLabel", ts.ctx, card.ID, label.ID)
+		ts.mockBoardRepo.AssertNotCalled(t, "UpdateBoardArchived", mock.Anything, mock.Anything)
+	})
+
+	invalid := []struct {
+		name   string
+		change func(export *entity.BoardExport)
+		err    error
+	}{
+		{
+			name:   "no version",
+			change: func(export *entity.BoardExport) { export.Version = 0 },
+			err:    v1.ErrExportVersion,
+		},
+		{
+			name:   "newer version",
+			change: func(export *entity.BoardExport) { export.Version = entity.BoardExportVersion + 1 },
+			err:    v1.ErrExportVersion,
+		},
+		{
+			name:   "board without a title",
+			change: func(export *entity.BoardExport) { export.Board.Title = "" },
+			err:    v1.ErrBoardEmptyTitle,
+		},
+		{
+			name:   "invalid label color",
+			change: func(export *entity.BoardExport) { export.Labels[0].Color = "red" },
+			err:    v1.ErrLabelInvalidColor,
+		},
+		{
+			name:   "duplicate label id",
+			change: func(export *entity.BoardExport) { export.Labels = append(export.Labels, export.Labels[0]) },
+			err:    v1.ErrInvalidExport,
+		},
+		{
+			name: "label names differing in case",
+			change: func(export *entity.BoardExport) {
+				export.Labels = append(export.Labels, entity.Label{ID: uuid.New(), Name: "BUG", Color: "#d73a4a"})
+			},
+			err: v1.ErrLabelExists,
+		},
+		{
+			name:   "negative column position",
+			change: func(export *entity.BoardExport) { export.Columns[1].Column.Position = -1 },
+			err:    v1.ErrColumnNegativePosition,
+		},
+		{
+			name:   "negative wip limit",
+			change: func(export *entity.BoardExport) { export.Columns[1].Column.WIPLimit.Max = -1 },
+			err:    v1.ErrWIPLimitNegative,
+		},
+		{
+			name: "strict column over its wip limit",
+			change: func(export *entity.BoardExport) {
+				export.Columns[0].Cards = append(export.Columns[0].Cards, entity.Card{Title: "Another", Position: 4096})
+			},
+			err: v1.ErrWIPLimitReached,
+		},
+		{
+			name:   "card without a title",
+			change: func(export *entity.BoardExport) { export.Columns[0].Cards[0].Title = "" },
+			err:    v1.ErrCardEmptyTitle,
+		},
+		{
+			name:   "card with an unknown label",
+			change: func(export *entity.BoardExport) { export.Columns[0].Cards[0].Labels[0].ID = uuid.New() },
+			err:    v1.ErrInvalidExport,
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+			export := boardExport()
+			tt.change(export)
+
+			board, err := ts.todoUseCase.ImportBoard(ts.ctx, export)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Nil(t, board)
+			ts.mockBoardRepo.AssertNotCalled(t, "CreateBoard", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("write fails", func(t *testing.T) {
+		ts := setup()
+		ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ts.ctx, mock.Anything).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Return(nil)
+		ts.mockCardRepo.On("CreateCard", ts.ctx, mock.Anything).Return(errors.New("db down"))
+
+		board, err := ts.todoUseCase.ImportBoard(ts.ctx, boardExport())
+
+		assert.ErrorContains(t, err, "ImportBoard: Failed to import board")
+		assert.Nil(t, board)
+		ts.mockActivityRepo.AssertNotCalled(t, "CreateActivity", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no caller", func(t *testing.T) {
+		ts := setup()
+
+		_, err := ts.todoUseCase.ImportBoard(context.Background(), boardExport())
+
+		assert.ErrorIs(t, err, v1.ErrNoCaller)
+	})
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func (uc *todoUseCase) SubscribeBoard(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error) {
+	header := "SubscribeBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access", "boardID", boardID, "lastEventID", lastEventID)
+
+	_, err := uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Subscribing to board events", "boardID", boardID)
+
+	events, err := uc.broker.Subscribe(ctx, boardID, lastEventID)
+
+	if err != nil {
+		info := "Failed to subscribe to board events"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,43 @@
+package v1_test
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubscribeBoard(t *testing.T) {
+	ts := setup()
+	outsider := userContext(uuid.New())
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+		boardID := uuid.New()
+		ts.mockBoardAccess(boardID)
+
+		events := make(chan entity.Event)
+		ts.mockBroker.On("Subscribe", ts.ctx, boardID, "epoch-7").Return((<-chan entity.Event)(events), nil)
+
+		got, err := ts.todoUseCase.SubscribeBoard(ts.ctx, boardID, "epoch-7")
+
+		assert.Nil(t, err)
+		assert.Equal(t, (<-chan entity.Event)(events), got)
+	})
+
+	t.Run("outsider", func(t *testing.T) {
+		t.Parallel()
+		boardID := uuid.New()
+		ts.mockBoardRepo.On("GetBoardByID", outsider, boardID).Return(&entity.Board{ID: boardID, UserID: uuid.New()}, nil)
+		ts.mockMemberRepo.On("GetMember", outsider, boardID, mock.Anything).Return(nil, repository.ErrNotFound)
+
+		_, err := ts.todoUseCase.SubscribeBoard(outsider, boardID, "")
+
+		assert.ErrorIs(t, err, v1.ErrForbidden)
+		ts.mockBroker.AssertNotCalled(t, "Subscribe", outsider, boardID, mock.Anything)
+	})
+}
@@ -0,0 +1,333 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const maxLabelNameLength = 64
+
+var (
+	ErrLabelEmptyName    = errors.New("label should have a name")
+	ErrLabelNameTooLong  = fmt.Errorf("label name cannot be longer than %d characters", maxLabelNameLength)
+	ErrLabelInvalidColor = errors.New("label color should be a hex color like #1f6feb")
+	ErrLabelExists       = errors.New("board already has a label with this name")
+	ErrLabelNotOnBoard   = errors.New("label belongs to another board")
+)
+
+var labelColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func validateLabel(label *entity.Label) error {
+	if strings.TrimSpace(label.Name) == "" {
+		return ErrLabelEmptyName
+	}
+
+	if len([]rune(label.Name)) > maxLabelNameLength {
+		return ErrLabelNameTooLong
+	}
+
+	if !labelColorRegexp.MatchString(label.Color) {
+		return ErrLabelInvalidColor
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) CreateLabel(ctx context.Context, label *entity.Label) error {
+	header := "CreateLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating label", "label", label)
+
+	err := validateLabel(label)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeBoard(ctx, label.BoardID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	label.ID = uuid.New()
+
+	err = uc.checkLabelName(ctx, label)
+
+	if err != nil {
+		info := "Failed to check label name"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	label.CreatedAt = time.Now()
+	label.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to label repo (CreateLabel)", "label", label)
+
+	err = uc.labelRepo.CreateLabel(ctx, label)
+
+	if err != nil {
+		info := "Failed to create label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Label successfully created")
+
+	return nil
+}
+
+func (uc *todoUseCase) GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error) {
+	header := "GetLabelsByBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID)
+
+	_, err := uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to label repo (GetLabelsByBoard)", "boardID", boardID)
+
+	labels, err := uc.labelRepo.GetLabelsByBoard(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get labels"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got labels", "labels", labels)
+
+	return labels, nil
+}
+
+func (uc *todoUseCase) UpdateLabel(ctx context.Context, label *entity.Label) error {
+	header := "UpdateLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating label", "label", label)
+
+	err := validateLabel(label)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	current, err := uc.authorizeLabel(ctx, label.ID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	label.BoardID = current.BoardID
+
+	err = uc.checkLabelName(ctx, label)
+
+	if err != nil {
+		info := "Failed to check label name"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	label.CreatedAt = current.CreatedAt
+	label.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to label repo (UpdateLabel)", "label", label)
+
+	err = uc.labelRepo.UpdateLabel(ctx, label)
+
+	if err != nil {
+		info := "Failed to update label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Label successfully updated")
+
+	return nil
+}
+
+func (uc *todoUseCase) DeleteLabel(ctx context.Context, id uuid.UUID) error {
+	header := "DeleteLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to label", "id", id)
+
+	_, err := uc.authorizeLabel(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to label repo (DeleteLabel)", "id", id)
+
+	err = uc.labelRepo.DeleteLabel(ctx, id)
+
+	if err != nil {
+		info := "Failed to delete label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Label successfully deleted")
+
+	return nil
+}
+
+func (uc *todoUseCase) AttachLabel(ctx context.Context, cardID, labelID uuid.UUID) error {
+	header := "AttachLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card and label", "cardID", cardID, "labelID", labelID)
+
+	err := uc.authorizeCardLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to label repo (AttachLabel)", "cardID", cardID, "labelID", labelID)
+
+	err = uc.labelRepo.AttachLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Failed to attach label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Label successfully attached")
+
+	return nil
+}
+
+func (uc *todoUseCase) DetachLabel(ctx context.Context, cardID, labelID uuid.UUID) error {
+	header := "DetachLabel: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card and label", "cardID", cardID, "labelID", labelID)
+
+	err := uc.authorizeCardLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to label repo (DetachLabel)", "cardID", cardID, "labelID", labelID)
+
+	err = uc.labelRepo.DetachLabel(ctx, cardID, labelID)
+
+	if err != nil {
+		info := "Failed to detach label"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Label successfully detached")
+
+	return nil
+}
+
+func (uc *todoUseCase) authorizeLabel(ctx context.Context, labelID uuid.UUID, required entity.MemberRole) (*entity.Label, error) {
+	if _, err := callerFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	label, err := uc.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.authorizeBoard(ctx, label.BoardID, required); err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+// authorizeCardLabel checks that the caller may edit the card and that the
+// label comes from the palette of the board the card is on
+func (uc *todoUseCase) authorizeCardLabel(ctx context.Context, cardID, labelID uuid.UUID) error {
+	card, err := uc.authorizeCard(ctx, cardID, entity.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+	if err != nil {
+		return err
+	}
+
+	label, err := uc.labelRepo.GetLabelByID(ctx, labelID)
+	if err != nil {
+		return err
+	}
+
+	if label.BoardID != column.BoardID {
+		return ErrLabelNotOnBoard
+	}
+
+	return nil
+}
+
+// checkLabelName makes sure no other label of the board has the same name;
+// names are compared case-insensitively
+func (uc *todoUseCase) checkLabelName(ctx context.Context, label *entity.Label) error {
+	labels, err := uc.labelRepo.GetLabelsByBoard(ctx, label.BoardID)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range labels {
+		if l.ID != label.ID && strings.EqualFold(l.Name, label.Name) {
+			return ErrLabelExists
+		}
+	}
+
+	return nil
+}
+
+// withLabels fills in the labels of the cards
+func (uc *todoUseCase) withLabels(ctx context.Context, cards []entity.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(cards))
+	for i, card := range cards {
+		ids[i] = card.ID
+	}
+
+	labels, err := uc.labelRepo.GetLabelsByCards(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range cards {
+		cards[i].Labels = labels[cards[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateLabel(ctx context.Context, label *entity.Label) error
+func TestCreateLabel(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		label      *entity.Label
+		mockRepoFn func(label *entity.Label)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success",
+			label: &entity.Label{BoardID: uuid.New(), Name: "Bug", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				ts.mockBoardAccess(label.BoardID)
+				ts.mockLabelRepo.On("GetLabelsByBoard", ts.ctx, label.BoardID).Return([]entity.Label{{ID: uuid.New(), Name: "Feature"}}, nil)
+				ts.mockLabelRepo.On("CreateLabel", ts.ctx, label).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "empty name",
+			label:      &entity.Label{BoardID: uuid.New(), Name: " ", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {},
+			wantErr:    true,
+			err:        v1.ErrLabelEmptyName,
+		},
+		{
+			name:       "invalid color",
+			label:      &entity.Label{BoardID: uuid.New(), Name: "Bug", Color: "red"},
+			mockRepoFn: func(label *entity.Label) {},
+			wantErr:    true,
+			err:        v1.ErrLabelInvalidColor,
+		},
+		{
+			name:  "name taken",
+			label: &entity.Label{BoardID: uuid.New(), Name: "bug", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				ts.mockBoardAccess(label.BoardID)
+				ts.mockLabelRepo.On("GetLabelsByBoard", ts.ctx, label.BoardID).Return([]entity.Label{{ID: uuid.New(), Name: "Bug"}}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrLabelExists,
+		},
+		{
+			name:  "board not found",
+			label: &entity.Label{BoardID: uuid.New(), Name: "Bug", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, label.BoardID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.label)
+
+			err := ts.todoUseCase.CreateLabel(ts.ctx, tt.label)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockLabelRepo.AssertNotCalled(t, "CreateLabel", ts.ctx, tt.label)
+			} else {
+				assert.Nil(t, err)
+				assert.NotEqual(t, uuid.Nil, tt.label.ID)
+				ts.mockLabelRepo.AssertCalled(t, "CreateLabel", ts.ctx, tt.label)
+			}
+		})
+	}
+}
+
+// UpdateLabel(ctx context.Context, label *entity.Label) error
+func TestUpdateLabel(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		label      *entity.Label
+		mockRepoFn func(label *entity.Label)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success with the same name",
+			label: &entity.Label{ID: uuid.New(), Name: "BUG", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				boardID := uuid.New()
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, label.ID).Return(&entity.Label{ID: label.ID, BoardID: boardID, Name: "Bug"}, nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockLabelRepo.On("GetLabelsByBoard", ts.ctx, boardID).Return([]entity.Label{{ID: label.ID, Name: "Bug"}}, nil)
+				ts.mockLabelRepo.On("UpdateLabel", ts.ctx, label).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "name taken",
+			label: &entity.Label{ID: uuid.New(), Name: "Feature", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				boardID := uuid.New()
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, label.ID).Return(&entity.Label{ID: label.ID, BoardID: boardID, Name: "Bug"}, nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockLabelRepo.On("GetLabelsByBoard", ts.ctx, boardID).Return([]entity.Label{{ID: label.ID, Name: "Bug"}, {ID: uuid.New(), Name: "Feature"}}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrLabelExists,
+		},
+		{
+			name:  "label not found",
+			label: &entity.Label{ID: uuid.New(), Name: "Bug", Color: "#d73a4a"},
+			mockRepoFn: func(label *entity.Label) {
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, label.ID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.label)
+
+			err := ts.todoUseCase.UpdateLabel(ts.ctx, tt.label)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockLabelRepo.AssertNotCalled(t, "UpdateLabel", ts.ctx, tt.label)
+			} else {
+				assert.Nil(t, err)
+				assert.NotEqual(t, uuid.Nil, tt.label.BoardID)
+				ts.mockLabelRepo.AssertCalled(t, "UpdateLabel", ts.ctx, tt.label)
+			}
+		})
+	}
+}
+
+// AttachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+func TestAttachLabel(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		mockRepoFn func(cardID, labelID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			mockRepoFn: func(cardID, labelID uuid.UUID) {
+				boardID := ts.mockCardOnBoard(cardID)
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, labelID).Return(&entity.Label{ID: labelID, BoardID: boardID}, nil)
+				ts.mockLabelRepo.On("AttachLabel", ts.ctx, cardID, labelID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "label of another board",
+			mockRepoFn: func(cardID, labelID uuid.UUID) {
+				ts.mockCardOnBoard(cardID)
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, labelID).Return(&entity.Label{ID: labelID, BoardID: uuid.New()}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrLabelNotOnBoard,
+		},
+		{
+			name: "label not found",
+			mockRepoFn: func(cardID, labelID uuid.UUID) {
+				ts.mockCardOnBoard(cardID)
+				ts.mockLabelRepo.On("GetLabelByID", ts.ctx, labelID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID, labelID := uuid.New(), uuid.New()
+			tt.mockRepoFn(cardID, labelID)
+
+			err := ts.todoUseCase.AttachLabel(ts.ctx, cardID, labelID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockLabelRepo.AssertNotCalled(t, "AttachLabel", ts.ctx, cardID, labelID)
+			} else {
+				assert.Nil(t, err)
+				ts.mockLabelRepo.AssertCalled(t, "AttachLabel", ts.ctx, cardID, labelID)
+			}
+		})
+	}
+}
+
+// DetachLabel(ctx context.Context, cardID, labelID uuid.UUID) error
+func TestDetachLabel(t *testing.T) {
+	ts := setup()
+
+	cardID, labelID := uuid.New(), uuid.New()
+	boardID := ts.mockCardOnBoard(cardID)
+	ts.mockLabelRepo.On("GetLabelByID", ts.ctx, labelID).Return(&entity.Label{ID: labelID, BoardID: boardID}, nil)
+	ts.mockLabelRepo.On("DetachLabel", ts.ctx, cardID, labelID).Return(errors.New(""))
+
+	err := ts.todoUseCase.DetachLabel(ts.ctx, cardID, labelID)
+
+	assert.EqualError(t, err, "DetachLabel: Failed to detach label: ")
+}
+
+func TestCardsComeWithLabels(t *testing.T) {
+	ts := setup()
+
+	columnID := uuid.New()
+	labelID := uuid.New()
+	cards := []entity.Card{{ID: uuid.New(), ColumnID: columnID}, {ID: uuid.New(), ColumnID: columnID}}
+	filter := entity.CardFilter{LabelID: labelID}
+
+	ts.mockColumnAccess(columnID)
+	ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, filter, 10, 0).Return(cards, nil)
+	ts.mockLabelRepo.On("GetLabelsByCards", ts.ctx, []uuid.UUID{cards[0].ID, cards[1].ID}).Return(map[uuid.UUID][]entity.Label{
+		cards[1].ID: {{ID: labelID, Name: "Bug"}},
+	}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+	got, err := ts.todoUseCase.GetCardsByColumn(ts.ctx, columnID, filter, 10, 0)
+
+	assert.Nil(t, err)
+	assert.Empty(t, got[0].Labels)
+	assert.Equal(t, []entity.Label{{ID: labelID, Name: "Bug"}}, got[1].Labels)
+	ts.mockCardRepo.AssertCalled(t, "GetCardsByColumn", ts.ctx, columnID, filter, 10, mock.Anything)
+}
+
+// mockCardOnBoard puts the card into a column the test caller may edit and
+// returns the board of the column
+func (ts *testSetup) mockCardOnBoard(cardID uuid.UUID) uuid.UUID {
+	columnID := uuid.New()
+	boardID := uuid.New()
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+	ts.mockBoardAccess(boardID)
+
+	return boardID
+}
@@ -0,0 +1,344 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidMemberRole  = errors.New("member role should be owner, editor or viewer")
+	ErrMemberNoUserID     = errors.New("member should have a user id")
+	ErrAlreadyMember      = errors.New("user is already a member of the board")
+	ErrBoardCreator       = errors.New("board creator cannot be removed or demoted")
+	ErrMemberNotFound     = errors.New("board member not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+)
+
+func validateMember(userID uuid.UUID, role entity.MemberRole) error {
+	if userID == uuid.Nil {
+		return ErrMemberNoUserID
+	}
+
+	if !role.IsValid() {
+		return ErrInvalidMemberRole
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) InviteMember(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error) {
+	header := "InviteMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating member", "boardID", boardID, "userID", userID, "role", role)
+
+	err := validateMember(userID, role)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	board, err := uc.boardAccess(ctx, boardID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if board.UserID == userID {
+		info := "User created the board"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return nil, fmt.Errorf(header+info+": %w", ErrAlreadyMember)
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (GetMember)", "boardID", boardID, "userID", userID)
+
+	_, err = uc.memberRepo.GetMember(ctx, boardID, userID)
+
+	if err == nil {
+		info := "User is already invited"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return nil, fmt.Errorf(header+info+": %w", ErrAlreadyMember)
+	}
+
+	if !errors.Is(err, repository.ErrNotFound) {
+		info := "Failed to get member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	// authorizeBoard has already checked that the caller is present
+	caller, _ := callerFromContext(ctx)
+
+	member := &entity.BoardMember{
+		BoardID:   boardID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: caller.UserID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (AddMember)", "member", member)
+
+	err = uc.memberRepo.AddMember(ctx, member)
+
+	if err != nil {
+		info := "Failed to add member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Member successfully invited")
+
+	return member, nil
+}
+
+func (uc *todoUseCase) AcceptInvitation(ctx context.Context, boardID uuid.UUID) (*entity.BoardMember, error) {
+	header := "AcceptInvitation: "
+
+	uc.log.Info(ctx, header+"Usecase called; Getting caller", "boardID", boardID)
+
+	caller, err := callerFromContext(ctx)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (GetMember)", "boardID", boardID, "userID", caller.UserID)
+
+	member, err := uc.memberRepo.GetMember(ctx, boardID, caller.UserID)
+
+	if errors.Is(err, repository.ErrNotFound) {
+		err = ErrInvitationNotFound
+	}
+
+	if err != nil {
+		info := "Failed to get invitation"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if member.Accepted {
+		uc.log.Info(ctx, header+"Invitation is already accepted")
+		return member, nil
+	}
+
+	member.Accepted = true
+	member.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to member repo (UpdateMember)", "member", member)
+
+	err = uc.memberRepo.UpdateMember(ctx, member)
+
+	if err != nil {
+		info := "Failed to accept invitation"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Invitation successfully accepted")
+
+	return member, nil
+}
+
+func (uc *todoUseCase) UpdateMemberRole(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error) {
+	header := "UpdateMemberRole: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating member", "boardID", boardID, "userID", userID, "role", role)
+
+	err := validateMember(userID, role)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	board, err := uc.boardAccess(ctx, boardID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if board.UserID == userID {
+		info := "Cannot change role of the board creator"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return nil, fmt.Errorf(header+info+": %w", ErrBoardCreator)
+	}
+
+	member, err := uc.getMember(ctx, boardID, userID)
+
+	if err != nil {
+		info := "Failed to get member"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	member.Role = role
+	member.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to member repo (UpdateMember)", "member", member)
+
+	err = uc.memberRepo.UpdateMember(ctx, member)
+
+	if err != nil {
+		info := "Failed to update member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Member role successfully updated")
+
+	return member, nil
+}
+
+// RemoveMember removes a member from the board. Owners may remove anyone but
+// the board creator; any member may remove themselves, which also declines a
+// pending invitation.
+func (uc *todoUseCase) RemoveMember(ctx context.Context, boardID, userID uuid.UUID) error {
+	header := "RemoveMember: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID, "userID", userID)
+
+	board, err := uc.authorizeMemberRemoval(ctx, boardID, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if board.UserID == userID {
+		info := "Cannot remove the board creator"
+		uc.log.Info(ctx, header+info, "userID", userID)
+		return fmt.Errorf(header+info+": %w", ErrBoardCreator)
+	}
+
+	_, err = uc.getMember(ctx, boardID, userID)
+
+	if err != nil {
+		info := "Failed to get member"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (DeleteMember)", "boardID", boardID, "userID", userID)
+
+	err = uc.memberRepo.DeleteMember(ctx, boardID, userID)
+
+	if err != nil {
+		info := "Failed to delete member"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Member successfully removed")
+
+	return nil
+}
+
+func (uc *todoUseCase) authorizeMemberRemoval(ctx context.Context, boardID, userID uuid.UUID) (*entity.Board, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if caller.UserID != userID {
+		return uc.boardAccess(ctx, boardID, entity.RoleOwner)
+	}
+
+	return uc.boardRepo.GetBoardByID(ctx, boardID)
+}
+
+func (uc *todoUseCase) getMember(ctx context.Context, boardID, userID uuid.UUID) (*entity.BoardMember, error) {
+	member, err := uc.memberRepo.GetMember(ctx, boardID, userID)
+
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrMemberNotFound
+	}
+
+	return member, err
+}
+
+// GetBoardMembers lists the board creator followed by invited members,
+// pending invitations included
+func (uc *todoUseCase) GetBoardMembers(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error) {
+	header := "GetBoardMembers: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID)
+
+	board, err := uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (GetMembersByBoard)", "boardID", boardID)
+
+	members, err := uc.memberRepo.GetMembersByBoard(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get members by board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	creator := entity.BoardMember{
+		BoardID:   board.ID,
+		UserID:    board.UserID,
+		Role:      entity.RoleOwner,
+		Accepted:  true,
+		InvitedBy: board.UserID,
+		CreatedAt: board.CreatedAt,
+		UpdatedAt: board.CreatedAt,
+	}
+
+	members = append([]entity.BoardMember{creator}, members...)
+
+	uc.log.Info(ctx, header+"Got members", "count", len(members))
+
+	return members, nil
+}
+
+func (uc *todoUseCase) GetInvitations(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error) {
+	header := "GetInvitations: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access", "userID", userID)
+
+	err := authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to member repo (GetInvitationsByUser)", "userID", userID)
+
+	invitations, err := uc.memberRepo.GetInvitationsByUser(ctx, userID)
+
+	if err != nil {
+		info := "Failed to get invitations by user"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got invitations", "count", len(invitations))
+
+	return invitations, nil
+}
@@ -0,0 +1,411 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func userContext(userID uuid.UUID) context.Context {
+	return identity.WithCaller(context.TODO(), identity.Caller{UserID: userID})
+}
+
+// InviteMember(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error)
+func TestInviteMember(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		role       entity.MemberRole
+		mockRepoFn func(boardID, userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			userID: uuid.New(),
+			role:   entity.RoleEditor,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+				ts.mockMemberRepo.On("AddMember", ts.ctx, mock.MatchedBy(func(m *entity.BoardMember) bool {
+					return m.BoardID == boardID && m.UserID == userID && !m.Accepted
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "invalid role",
+			userID:     uuid.New(),
+			role:       entity.MemberRole("superuser"),
+			mockRepoFn: func(boardID, userID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidMemberRole,
+		},
+		{
+			name:       "no user id",
+			userID:     uuid.Nil,
+			role:       entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrMemberNoUserID,
+		},
+		{
+			name:   "already member",
+			userID: uuid.New(),
+			role:   entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrAlreadyMember,
+		},
+		{
+			name:   "board creator",
+			userID: uuid.New(),
+			role:   entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, UserID: userID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrAlreadyMember,
+		},
+		{
+			name:   "get member error",
+			userID: uuid.New(),
+			role:   entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(nil, errors.New(""))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			tt.mockRepoFn(boardID, tt.userID)
+
+			member, err := ts.todoUseCase.InviteMember(ts.ctx, boardID, tt.userID, tt.role)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, member)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.role, member.Role)
+				assert.False(t, member.Accepted)
+			}
+		})
+	}
+}
+
+// AcceptInvitation(ctx context.Context, boardID uuid.UUID) (*entity.BoardMember, error)
+func TestAcceptInvitation(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		mockRepoFn func(ctx context.Context, boardID, userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID, Role: entity.RoleViewer}, nil)
+				ts.mockMemberRepo.On("UpdateMember", ctx, mock.MatchedBy(func(m *entity.BoardMember) bool {
+					return m.BoardID == boardID && m.Accepted
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "already accepted",
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID, Accepted: true}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "no invitation",
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrInvitationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			userID := uuid.New()
+			ctx := userContext(userID)
+			tt.mockRepoFn(ctx, boardID, userID)
+
+			member, err := ts.todoUseCase.AcceptInvitation(ctx, boardID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, member)
+			} else {
+				assert.Nil(t, err)
+				assert.True(t, member.Accepted)
+			}
+		})
+	}
+}
+
+// UpdateMemberRole(ctx context.Context, boardID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error)
+func TestUpdateMemberRole(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		role       entity.MemberRole
+		mockRepoFn func(boardID, userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "success",
+			role: entity.RoleOwner,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID, Role: entity.RoleViewer}, nil)
+				ts.mockMemberRepo.On("UpdateMember", ts.ctx, mock.MatchedBy(func(m *entity.BoardMember) bool {
+					return m.UserID == userID && m.Role == entity.RoleOwner
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "board creator",
+			role: entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, UserID: userID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrBoardCreator,
+		},
+		{
+			name: "not a member",
+			role: entity.RoleViewer,
+			mockRepoFn: func(boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ts.ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrMemberNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			userID := uuid.New()
+			tt.mockRepoFn(boardID, userID)
+
+			member, err := ts.todoUseCase.UpdateMemberRole(ts.ctx, boardID, userID, tt.role)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, member)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.role, member.Role)
+			}
+		})
+	}
+}
+
+// RemoveMember(ctx context.Context, boardID, userID uuid.UUID) error
+func TestRemoveMember(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		self       bool
+		mockRepoFn func(ctx context.Context, boardID, userID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "owner removes member",
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID}, nil)
+				ts.mockMemberRepo.On("DeleteMember", ctx, boardID, userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "member leaves board",
+			self: true,
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: uuid.New()}, nil)
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(&entity.BoardMember{BoardID: boardID, UserID: userID}, nil)
+				ts.mockMemberRepo.On("DeleteMember", ctx, boardID, userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "creator cannot leave",
+			self: true,
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: userID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrBoardCreator,
+		},
+		{
+			name: "not a member",
+			mockRepoFn: func(ctx context.Context, boardID, userID uuid.UUID) {
+				ts.mockBoardAccess(boardID)
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrMemberNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			userID := uuid.New()
+			ctx := ts.ctx
+			if tt.self {
+				ctx = userContext(userID)
+			}
+			tt.mockRepoFn(ctx, boardID, userID)
+
+			err := ts.todoUseCase.RemoveMember(ctx, boardID, userID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockMemberRepo.AssertNotCalled(t, "DeleteMember", ctx, boardID, userID)
+			} else {
+				assert.Nil(t, err)
+				ts.mockMemberRepo.AssertCalled(t, "DeleteMember", ctx, boardID, userID)
+			}
+		})
+	}
+}
+
+// GetBoardMembers(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error)
+func TestGetBoardMembers(t *testing.T) {
+	ts := setup()
+
+	boardID := uuid.New()
+	creatorID := uuid.New()
+	memberID := uuid.New()
+
+	ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, UserID: creatorID}, nil)
+	ts.mockMemberRepo.On("GetMembersByBoard", ts.ctx, boardID).Return([]entity.BoardMember{{BoardID: boardID, UserID: memberID, Role: entity.RoleViewer}}, nil)
+
+	members, err := ts.todoUseCase.GetBoardMembers(ts.ctx, boardID)
+
+	assert.Nil(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, creatorID, members[0].UserID)
+	assert.Equal(t, entity.RoleOwner, members[0].Role)
+	assert.Equal(t, memberID, members[1].UserID)
+}
+
+func TestBoardRoleAccess(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name    string
+		member  *entity.BoardMember
+		call    func(ctx context.Context, boardID uuid.UUID) error
+		wantErr error
+	}{
+		{
+			name:   "viewer reads board",
+			member: &entity.BoardMember{Role: entity.RoleViewer, Accepted: true},
+			call: func(ctx context.Context, boardID uuid.UUID) error {
+				_, err := ts.todoUseCase.GetBoardByID(ctx, boardID)
+				return err
+			},
+		},
+		{
+			name:   "viewer cannot rename board",
+			member: &entity.BoardMember{Role: entity.RoleViewer, Accepted: true},
+			call: func(ctx context.Context, boardID uuid.UUID) error {
+				return ts.todoUseCase.UpdateBoard(ctx, &entity.Board{ID: boardID, Title: "Title"})
+			},
+			wantErr: v1.ErrForbidden,
+		},
+		{
+			name:   "editor cannot delete board",
+			member: &entity.BoardMember{Role: entity.RoleEditor, Accepted: true},
+			call: func(ctx context.Context, boardID uuid.UUID) error {
+				return ts.todoUseCase.DeleteBoard(ctx, boardID)
+			},
+			wantErr: v1.ErrForbidden,
+		},
+		{
+			name:   "pending invitee cannot read board",
+			member: &entity.BoardMember{Role: entity.RoleOwner},
+			call: func(ctx context.Context, boardID uuid.UUID) error {
+				_, err := ts.todoUseCase.GetBoardByID(ctx, boardID)
+				return err
+			},
+			wantErr: v1.ErrForbidden,
+		},
+		{
+			name: "stranger cannot read board",
+			call: func(ctx context.Context, boardID uuid.UUID) error {
+				_, err := ts.todoUseCase.GetBoardByID(ctx, boardID)
+				return err
+			},
+			wantErr: v1.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			userID := uuid.New()
+			ctx := userContext(userID)
+
+			ts.mockBoardRepo.On("GetBoardByID", ctx, boardID).Return(&entity.Board{ID: boardID, UserID: uuid.New()}, nil)
+			if tt.member != nil {
+				tt.member.BoardID = boardID
+				tt.member.UserID = userID
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(tt.member, nil)
+			} else {
+				ts.mockMemberRepo.On("GetMember", ctx, boardID, userID).Return(nil, repository.ErrNotFound)
+			}
+
+			err := tt.call(ctx, boardID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
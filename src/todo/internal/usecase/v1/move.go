@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMoveNoColumnID       = errors.New("move should have a target column id")
+	ErrTargetColumnNotFound = fmt.Errorf("target column %w", repository.ErrNotFound)
+	ErrCardMoved            = errors.New("card was moved to another column in the meantime")
+	ErrCardColumnChange     = errors.New("card can only change its column with the move operation")
+)
+
+func validateMove(id uuid.UUID, move entity.CardMove) error {
+	if move.ColumnID == uuid.Nil {
+		return ErrMoveNoColumnID
+	}
+
+	if move.Placement == (entity.Placement{}) {
+		return nil
+	}
+
+	return validatePlacement(id, move.Placement)
+}
+
+func (uc *todoUseCase) MoveCard(ctx context.Context, id uuid.UUID, move entity.CardMove) (*entity.Card, error) {
+	header := "MoveCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating move", "id", id, "move", move)
+
+	err := validateMove(id, move)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	var target *entity.Column
+
+	card, err := uc.authorizeCard(ctx, id, entity.RoleEditor)
+
+	if err == nil {
+		target, err = uc.authorizeColumn(ctx, move.ColumnID, entity.RoleEditor)
+
+		if errors.Is(err, repository.ErrNotFound) {
+			err = ErrTargetColumnNotFound
+		}
+	}
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	anchorID, before := move.Placement.Anchor()
+
+	if anchorID != uuid.Nil {
+		uc.log.Info(ctx, header+"Making request to card repo (GetCardByID)", "anchorID", anchorID)
+
+		anchor, err := uc.cardRepo.GetCardByID(ctx, anchorID)
+
+		if errors.Is(err, repository.ErrNotFound) {
+			err = ErrAnchorNotFound
+		}
+
+		if err != nil {
+			info := "Failed to get anchor card"
+			uc.log.Info(ctx, header+info, "err", err.Error())
+			return nil, fmt.Errorf(header+info+": %w", err)
+		}
+
+		if anchor.ColumnID != move.ColumnID {
+			info := "Validation failed"
+			uc.log.Info(ctx, header+info, "err", ErrNotSiblings.Error())
+			return nil, fmt.Errorf(header+info+": %w", ErrNotSiblings)
+		}
+	}
+
+	uc.log.Info(ctx, header+"Moving card within locked columns", "from", card.ColumnID, "to", move.ColumnID)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.lockColumns(ctx, card.ColumnID, move.ColumnID); err != nil {
+			return err
+		}
+
+		// The card may have been moved between the access check and the lock
+		current, err := uc.cardRepo.GetCardByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if current.ColumnID != card.ColumnID {
+			return ErrCardMoved
+		}
+
+		// Moving a card within its column leaves the count as it is
+		over := false
+		if current.ColumnID != move.ColumnID {
+			over, err = uc.checkWIPLimit(ctx, target)
+			if err != nil {
+				return err
+			}
+		}
+
+		siblings, err := uc.cardRepo.GetCardPositions(ctx, move.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		siblings, _ = withoutItem(siblings, id)
+
+		position := placeLast(siblings)
+
+		if anchorID != uuid.Nil {
+			position, err = placeAmong(ctx, siblings, anchorID, before, uc.cardRepo.UpdateCardPosition)
+			if err != nil {
+				return err
+			}
+		}
+
+		source, err := uc.boardOfCard(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		before := cardFields(current)
+
+		card = current
+		card.ColumnID = move.ColumnID
+		card.Position = position
+		card.UpdatedAt = time.Now()
+
+		if err := uc.cardRepo.MoveCard(ctx, card); err != nil {
+			return err
+		}
+
+		card.OverWIPLimit = over
+
+		// Labels come from the palette of a board and do not travel with
+		// the card to another one
+		if err := uc.labelRepo.DetachForeignLabels(ctx, id, target.BoardID); err != nil {
+			return err
+		}
+
+		boardIDs := []uuid.UUID{target.BoardID}
+		if source != target.BoardID {
+			boardIDs = append(boardIDs, source)
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityMove, entity.ActivityTargetCard, id, boardIDs, fieldChanges(before, cardFields(card)))
+	})
+
+	if err != nil {
+		info := "Failed to move card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully moved", "columnID", card.ColumnID, "position", card.Position)
+
+	return card, nil
+}
+
+// lockColumns locks the source and the target column of a move. The locks
+// are always taken in the same order so that two opposite moves cannot
+// deadlock.
+func (uc *todoUseCase) lockColumns(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	if sourceID == targetID {
+		return uc.columnRepo.LockColumn(ctx, sourceID)
+	}
+
+	ids := []uuid.UUID{sourceID, targetID}
+	if bytes.Compare(sourceID[:], targetID[:]) > 0 {
+		ids[0], ids[1] = targetID, sourceID
+	}
+
+	for _, id := range ids {
+		err := uc.columnRepo.LockColumn(ctx, id)
+
+		if id == targetID && errors.Is(err, repository.ErrNotFound) {
+			return ErrTargetColumnNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MoveCard(ctx context.Context, id uuid.UUID, move entity.CardMove) (*entity.Card, error)
+func TestMoveCard(t *testing.T) {
+	ts := setup()
+
+	type ids struct {
+		card, prev, next, source, target uuid.UUID
+	}
+
+	tests := []struct {
+		name         string
+		move         func(ids ids) entity.CardMove
+		mockRepoFn   func(ids ids)
+		wantErr      bool
+		err          error
+		wantPosition float64
+		// rebalanced is the number of cards in the target column that got
+		// new positions
+		rebalanced int
+	}{
+		{
+			name: "to the end of the same column",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.source} },
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.source)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, ids.source).Return([]entity.Position{
+					{ID: ids.card, Position: 1024},
+					{ID: ids.prev, Position: 2048},
+				}, nil)
+			},
+			wantPosition: 3072,
+		},
+		{
+			name: "between two cards of another column",
+			move: func(ids ids) entity.CardMove {
+				return entity.CardMove{ColumnID: ids.target, Placement: entity.Placement{AfterID: ids.prev}}
+			},
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.target)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(&entity.Card{ID: ids.prev, ColumnID: ids.target}, nil)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, ids.target).Return([]entity.Position{
+					{ID: ids.prev, Position: 1024},
+					{ID: ids.next, Position: 2048},
+				}, nil)
+			},
+			wantPosition: 1536,
+		},
+		{
+			name: "to an empty column",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.target} },
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.target)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, ids.target).Return([]entity.Position{}, nil)
+			},
+			wantPosition: 1024,
+		},
+		{
+			name: "rebalance the target column",
+			move: func(ids ids) entity.CardMove {
+				return entity.CardMove{ColumnID: ids.target, Placement: entity.Placement{BeforeID: ids.next}}
+			},
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.target)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.next).Return(&entity.Card{ID: ids.next, ColumnID: ids.target}, nil)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, ids.target).Return([]entity.Position{
+					{ID: ids.prev, Position: 1},
+					{ID: ids.next, Position: 1 + 1e-12},
+				}, nil)
+				ts.mockCardRepo.On("UpdateCardPosition", ts.ctx, ids.prev, mock.Anything).Return(nil)
+				ts.mockCardRepo.On("UpdateCardPosition", ts.ctx, ids.next, mock.Anything).Return(nil)
+			},
+			wantPosition: 1536,
+			rebalanced:   2,
+		},
+		{
+			name:       "no target column",
+			move:       func(ids ids) entity.CardMove { return entity.CardMove{} },
+			mockRepoFn: func(ids ids) {},
+			wantErr:    true,
+			err:        v1.ErrMoveNoColumnID,
+		},
+		{
+			name: "both anchors",
+			move: func(ids ids) entity.CardMove {
+				return entity.CardMove{ColumnID: ids.target, Placement: entity.Placement{BeforeID: ids.next, AfterID: ids.prev}}
+			},
+			mockRepoFn: func(ids ids) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidPlacement,
+		},
+		{
+			name: "target column not found",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.target} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: ids.source}, nil)
+				ts.mockColumnAccess(ids.source)
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, ids.target).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrTargetColumnNotFound,
+		},
+		{
+			name: "anchor not found",
+			move: func(ids ids) entity.CardMove {
+				return entity.CardMove{ColumnID: ids.target, Placement: entity.Placement{AfterID: ids.prev}}
+			},
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.target)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrAnchorNotFound,
+		},
+		{
+			name: "anchor outside the target column",
+			move: func(ids ids) entity.CardMove {
+				return entity.CardMove{ColumnID: ids.target, Placement: entity.Placement{AfterID: ids.prev}}
+			},
+			mockRepoFn: func(ids ids) {
+				ts.mockMovableCard(ids.card, ids.source, ids.target)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(&entity.Card{ID: ids.prev, ColumnID: ids.source}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrNotSiblings,
+		},
+		{
+			name: "card moved concurrently",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.target} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: ids.source}, nil).Once()
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: uuid.New()}, nil)
+				ts.mockColumnAccess(ids.source)
+				ts.mockColumnAccess(ids.target)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.source).Return(nil)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.target).Return(nil)
+			},
+			wantErr: true,
+			err:     v1.ErrCardMoved,
+		},
+		{
+			name: "target column deleted before lock",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.target} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: ids.source}, nil)
+				ts.mockColumnAccess(ids.source)
+				ts.mockColumnAccess(ids.target)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.source).Return(nil)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.target).Return(repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrTargetColumnNotFound,
+		},
+		{
+			name: "failed to move card",
+			move: func(ids ids) entity.CardMove { return entity.CardMove{ColumnID: ids.target} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: ids.source}, nil)
+				ts.mockColumnAccess(ids.source)
+				ts.mockColumnAccess(ids.target)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.source).Return(nil)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, ids.target).Return(nil)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, ids.target).Return([]entity.Position{}, nil)
+				ts.mockCardRepo.On("MoveCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+					return c.ID == ids.card
+				})).Return(errors.New(""))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ids := ids{card: uuid.New(), prev: uuid.New(), next: uuid.New(), source: uuid.New(), target: uuid.New()}
+			tt.mockRepoFn(ids)
+
+			move := tt.move(ids)
+			card, err := ts.todoUseCase.MoveCard(ts.ctx, ids.card, move)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, card)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, move.ColumnID, card.ColumnID)
+			assert.Equal(t, tt.wantPosition, card.Position)
+			ts.mockCardRepo.AssertCalled(t, "MoveCard", ts.ctx, card)
+			ts.mockCardRepo.AssertNotCalled(t, "UpdateCardPosition", ts.ctx, ids.card, mock.Anything)
+
+			if tt.rebalanced > 0 {
+				ts.mockCardRepo.AssertCalled(t, "UpdateCardPosition", ts.ctx, ids.prev, float64(1024))
+				ts.mockCardRepo.AssertCalled(t, "UpdateCardPosition", ts.ctx, ids.next, float64(2048))
+			}
+		})
+	}
+}
+
+// mockMovableCard puts the card into the source column and lets the caller
+// move it to the target one
+func (ts *testSetup) mockMovableCard(cardID, sourceID, targetID uuid.UUID) {
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: sourceID}, nil)
+	ts.mockColumnAccess(sourceID)
+	ts.mockColumnRepo.On("LockColumn", ts.ctx, sourceID).Return(nil)
+
+	if targetID != sourceID {
+		ts.mockColumnAccess(targetID)
+		ts.mockColumnRepo.On("LockColumn", ts.ctx, targetID).Return(nil)
+	}
+
+	ts.mockCardRepo.On("MoveCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+		return c.ID == cardID
+	})).Return(nil)
+	ts.mockLabelRepo.On("DetachForeignLabels", ts.ctx, cardID, mock.Anything).Return(nil)
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func validatePageRequest(page entity.PageRequest) error {
+	return validateLimitAndOffset(page.Limit, 0)
+}
+
+// pageRequest asks the repo for one item more than the page holds, so a next
+// page is only reported when there is something on it
+func pageRequest(page entity.PageRequest) entity.PageRequest {
+	return entity.PageRequest{Limit: page.Limit + 1, After: page.After}
+}
+
+func (uc *todoUseCase) GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, *entity.Cursor, error) {
+	header := "GetBoardsPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "userID", userID, "page", page)
+
+	err := validatePageRequest(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board repo (GetBoardsPage)", "userID", userID, "archived", archived, "page", page)
+
+	boards, err := uc.boardRepo.GetBoardsPage(ctx, userID, archived, pageRequest(page))
+
+	if err != nil {
+		info := "Failed to get boards by user"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	var next *entity.Cursor
+	if len(boards) > page.Limit {
+		boards = boards[:page.Limit]
+		last := boards[len(boards)-1]
+		next = &entity.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	uc.log.Info(ctx, header+"Got boards", "boards", boards, "next", next)
+
+	return boards, next, nil
+}
+
+func (uc *todoUseCase) GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, *entity.Cursor, error) {
+	header := "GetColumnsPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "boardID", boardID, "page", page)
+
+	err := validatePageRequest(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (GetColumnsPage)", "boardID", boardID, "archived", archived, "page", page)
+
+	columns, err := uc.columnRepo.GetColumnsPage(ctx, boardID, archived, pageRequest(page))
+
+	if err != nil {
+		info := "Failed to get columns by board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	var next *entity.Cursor
+	if len(columns) > page.Limit {
+		columns = columns[:page.Limit]
+		last := columns[len(columns)-1]
+		next = &entity.Cursor{Position: last.Position, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	err = uc.withCardCounts(ctx, columns)
+
+	if err != nil {
+		info := "Failed to count cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got columns", "columns", columns, "next", next)
+
+	return columns, next, nil
+}
+
+func (uc *todoUseCase) GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, *entity.Cursor, error) {
+	header := "GetCardsPage: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating page", "columnID", columnID, "filter", filter, "page", page)
+
+	err := validatePageRequest(page)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeColumn(ctx, columnID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetCardsPage)", "columnID", columnID, "page", page)
+
+	cards, err := uc.cardRepo.GetCardsPage(ctx, columnID, filter, pageRequest(page))
+
+	if err != nil {
+		info := "Failed to get cards by column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	var next *entity.Cursor
+	if len(cards) > page.Limit {
+		cards = cards[:page.Limit]
+		last := cards[len(cards)-1]
+		next = &entity.Cursor{Position: last.Position, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	uc.log.Info(ctx, header+"Filling in card labels and checklist progress", "count", len(cards))
+
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got cards", "cards", cards, "next", next)
+
+	return cards, next, nil
+}
@@ -0,0 +1,117 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBoardsPage(t *testing.T) {
+	userID := uuid.New()
+	created := time.Now()
+	boards := []entity.Board{
+		{ID: uuid.New(), UserID: userID, Title: "Board 1", CreatedAt: created},
+		{ID: uuid.New(), UserID: userID, Title: "Board 2", CreatedAt: created.Add(time.Second)},
+		{ID: uuid.New(), UserID: userID, Title: "Board 3", CreatedAt: created.Add(2 * time.Second)},
+	}
+
+	tests := []struct {
+		name     string
+		page     entity.PageRequest
+		found    []entity.Board
+		want     []entity.Board
+		wantNext *entity.Cursor
+		wantErr  error
+	}{
+		{
+			name:     "more boards than the page holds",
+			page:     entity.PageRequest{Limit: 2},
+			found:    boards,
+			want:     boards[:2],
+			wantNext: &entity.Cursor{CreatedAt: boards[1].CreatedAt, ID: boards[1].ID},
+		},
+		{
+			name:  "last page",
+			page:  entity.PageRequest{Limit: 3, After: &entity.Cursor{CreatedAt: created, ID: uuid.New()}},
+			found: boards,
+			want:  boards,
+		},
+		{
+			name:    "zero limit",
+			page:    entity.PageRequest{Limit: 0},
+			wantErr: v1.ErrZeroLimit,
+		},
+		{
+			name:    "negative limit",
+			page:    entity.PageRequest{Limit: -1},
+			wantErr: v1.ErrNegativeLimitOrOffset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+
+			// One board more is asked for to tell whether there is a next page
+			ts.mockBoardRepo.On("GetBoardsPage", ts.ctx, userID, false, entity.PageRequest{Limit: tt.page.Limit + 1, After: tt.page.After}).Return(tt.found, nil)
+
+			got, next, err := ts.todoUseCase.GetBoardsPage(ts.ctx, userID, false, tt.page)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				ts.mockBoardRepo.AssertNotCalled(t, "GetBoardsPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func TestGetColumnsPage(t *testing.T) {
+	ts := setup()
+
+	boardID := uuid.New()
+	columns := []entity.Column{
+		{ID: uuid.New(), BoardID: boardID, Title: "Column 1", Position: 1},
+		{ID: uuid.New(), BoardID: boardID, Title: "Column 2", Position: 2},
+	}
+	page := entity.PageRequest{Limit: 1}
+
+	ts.mockBoardAccess(boardID)
+	ts.mockColumnRepo.On("GetColumnsPage", ts.ctx, boardID, false, entity.PageRequest{Limit: 2}).Return(columns, nil)
+	ts.mockNoCardCounts()
+
+	got, next, err := ts.todoUseCase.GetColumnsPage(ts.ctx, boardID, false, page)
+
+	assert.Nil(t, err)
+	assert.Equal(t, columns[:1], got)
+	assert.Equal(t, &entity.Cursor{Position: 1, ID: columns[0].ID}, next)
+}
+
+func TestGetCardsPage(t *testing.T) {
+	ts := setup()
+
+	columnID := uuid.New()
+	after := &entity.Cursor{Position: 1, ID: uuid.New()}
+	cards := []entity.Card{
+		{ID: uuid.New(), ColumnID: columnID, Title: "Card 2", Position: 2},
+	}
+
+	ts.mockColumnAccess(columnID)
+	ts.mockNoCardDetails()
+	ts.mockCardRepo.On("GetCardsPage", ts.ctx, columnID, entity.CardFilter{}, entity.PageRequest{Limit: 3, After: after}).Return(cards, nil)
+
+	got, next, err := ts.todoUseCase.GetCardsPage(ts.ctx, columnID, entity.CardFilter{}, entity.PageRequest{Limit: 2, After: after})
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Nil(t, next)
+}
@@ -0,0 +1,341 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// positionStep is the gap between neighbours after a rebalance and the
+	// distance kept after the last item
+	positionStep = 1024
+	// minPositionGap is the smallest gap between neighbours that still leaves
+	// room for a midpoint; below it the siblings are rebalanced
+	minPositionGap = 1e-9
+)
+
+var (
+	ErrInvalidPlacement     = errors.New("item should be placed either before or after another item")
+	ErrNotSiblings          = errors.New("item can only be placed next to an item with the same parent")
+	ErrAnchorNotFound       = fmt.Errorf("anchor card %w", repository.ErrNotFound)
+	ErrAnchorColumnNotFound = fmt.Errorf("anchor column %w", repository.ErrNotFound)
+)
+
+func validatePlacement(id uuid.UUID, placement entity.Placement) error {
+	if (placement.BeforeID == uuid.Nil) == (placement.AfterID == uuid.Nil) {
+		return ErrInvalidPlacement
+	}
+
+	if anchorID, _ := placement.Anchor(); anchorID == id {
+		return ErrInvalidPlacement
+	}
+
+	return nil
+}
+
+func (uc *todoUseCase) RepositionCard(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Card, error) {
+	header := "RepositionCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating placement", "id", id, "placement", placement)
+
+	err := validatePlacement(id, placement)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card, err := uc.authorizeCard(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	anchorID, before := placement.Anchor()
+
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardByID)", "anchorID", anchorID)
+
+	anchor, err := uc.cardRepo.GetCardByID(ctx, anchorID)
+
+	if errors.Is(err, repository.ErrNotFound) {
+		err = ErrAnchorNotFound
+	}
+
+	if err != nil {
+		info := "Failed to get anchor card"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if anchor.ColumnID != card.ColumnID {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrNotSiblings.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrNotSiblings)
+	}
+
+	uc.log.Info(ctx, header+"Repositioning card within locked column", "columnID", card.ColumnID)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.columnRepo.LockColumn(ctx, card.ColumnID); err != nil {
+			return err
+		}
+
+		siblings, err := uc.cardRepo.GetCardPositions(ctx, card.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		previous := cardFields(card)
+
+		card.Position, err = reposition(ctx, siblings, card.ID, anchorID, before, uc.cardRepo.UpdateCardPosition)
+		if err != nil {
+			return err
+		}
+
+		boardID, err := uc.boardOfCard(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityMove, entity.ActivityTargetCard, card.ID, []uuid.UUID{boardID}, fieldChanges(previous, cardFields(card)))
+	})
+
+	if err != nil {
+		info := "Failed to reposition card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card.UpdatedAt = time.Now()
+	// The item itself is saved once however many siblings are moved
+	card.Version++
+
+	uc.log.Info(ctx, header+"Card successfully repositioned", "position", card.Position)
+
+	return card, nil
+}
+
+func (uc *todoUseCase) RepositionColumn(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Column, error) {
+	header := "RepositionColumn: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating placement", "id", id, "placement", placement)
+
+	err := validatePlacement(id, placement)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	column, err := uc.authorizeColumn(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	anchorID, before := placement.Anchor()
+
+	uc.log.Info(ctx, header+"Making request to column repo (GetColumnByID)", "anchorID", anchorID)
+
+	anchor, err := uc.columnRepo.GetColumnByID(ctx, anchorID)
+
+	if errors.Is(err, repository.ErrNotFound) {
+		err = ErrAnchorColumnNotFound
+	}
+
+	if err != nil {
+		info := "Failed to get anchor column"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if anchor.BoardID != column.BoardID {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrNotSiblings.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrNotSiblings)
+	}
+
+	uc.log.Info(ctx, header+"Repositioning column within locked board", "boardID", column.BoardID)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.LockBoard(ctx, column.BoardID); err != nil {
+			return err
+		}
+
+		siblings, err := uc.columnRepo.GetColumnPositions(ctx, column.BoardID)
+		if err != nil {
+			return err
+		}
+
+		previous := columnFields(column)
+
+		column.Position, err = reposition(ctx, siblings, column.ID, anchorID, before, uc.columnRepo.UpdateColumnPosition)
+		if err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityMove, entity.ActivityTargetColumn, column.ID, []uuid.UUID{column.BoardID}, fieldChanges(previous, columnFields(column)))
+	})
+
+	if err != nil {
+		info := "Failed to reposition column"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	column.UpdatedAt = time.Now()
+	// The item itself is saved once however many siblings are moved
+	column.Version++
+
+	uc.log.Info(ctx, header+"Column successfully repositioned", "position", column.Position)
+
+	return column, nil
+}
+
+// reposition places the item next to the anchor and saves its new position.
+// The caller must hold a lock on the parent of the siblings so that
+// concurrent moves within it are serialized.
+func reposition(
+	ctx context.Context,
+	siblings []entity.Position,
+	itemID, anchorID uuid.UUID,
+	before bool,
+	save func(ctx context.Context, id uuid.UUID, position float64) error,
+) (float64, error) {
+	others, found := withoutItem(siblings, itemID)
+
+	// The item may have left the parent since it was checked
+	if !found {
+		return 0, ErrNotSiblings
+	}
+
+	position, err := placeAmong(ctx, others, anchorID, before, save)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := save(ctx, itemID, position); err != nil {
+		return 0, err
+	}
+
+	return position, nil
+}
+
+// placeAmong returns the position right next to the anchor. When there is no
+// room left between the neighbours all siblings are spread evenly first and
+// their new positions are saved.
+func placeAmong(
+	ctx context.Context,
+	siblings []entity.Position,
+	anchorID uuid.UUID,
+	before bool,
+	save func(ctx context.Context, id uuid.UUID, position float64) error,
+) (float64, error) {
+	anchor := positionIndex(siblings, anchorID)
+
+	// The anchor may have left the parent since it was checked
+	if anchor < 0 {
+		return 0, ErrNotSiblings
+	}
+
+	position, ok := placeNextTo(siblings, anchor, before)
+
+	if !ok {
+		siblings = rebalance(siblings)
+
+		for _, s := range siblings {
+			if err := save(ctx, s.ID, s.Position); err != nil {
+				return 0, err
+			}
+		}
+
+		position, _ = placeNextTo(siblings, anchor, before)
+	}
+
+	return position, nil
+}
+
+// placeLast returns the position after the last sibling
+func placeLast(siblings []entity.Position) float64 {
+	if len(siblings) == 0 {
+		return positionStep
+	}
+
+	return siblings[len(siblings)-1].Position + positionStep
+}
+
+func withoutItem(siblings []entity.Position, itemID uuid.UUID) ([]entity.Position, bool) {
+	others := make([]entity.Position, 0, len(siblings))
+	found := false
+
+	for _, s := range siblings {
+		if s.ID == itemID {
+			found = true
+			continue
+		}
+		others = append(others, s)
+	}
+
+	return others, found
+}
+
+func positionIndex(positions []entity.Position, id uuid.UUID) int {
+	for i, p := range positions {
+		if p.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// placeNextTo returns the midpoint between the anchor and its neighbour on
+// the requested side. Positions never go below zero; an item placed after
+// the last one gets a full step of room. It reports false when the midpoint
+// cannot be told apart from its bounds.
+func placeNextTo(siblings []entity.Position, anchor int, before bool) (float64, bool) {
+	var lo, hi float64
+
+	if before {
+		hi = siblings[anchor].Position
+		if anchor > 0 {
+			lo = siblings[anchor-1].Position
+		}
+	} else {
+		lo = siblings[anchor].Position
+		if anchor == len(siblings)-1 {
+			return lo + positionStep, true
+		}
+		hi = siblings[anchor+1].Position
+	}
+
+	mid := lo + (hi-lo)/2
+
+	if hi-lo < minPositionGap || mid <= lo || mid >= hi {
+		return 0, false
+	}
+
+	return mid, true
+}
+
+func rebalance(siblings []entity.Position) []entity.Position {
+	balanced := make([]entity.Position, len(siblings))
+
+	for i, s := range siblings {
+		balanced[i] = entity.Position{ID: s.ID, Position: float64(i+1) * positionStep}
+	}
+
+	return balanced
+}
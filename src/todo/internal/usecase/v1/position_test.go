@@ -0,0 +1,286 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// RepositionCard(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Card, error)
+func TestRepositionCard(t *testing.T) {
+	ts := setup()
+
+	type ids struct {
+		card, prev, next uuid.UUID
+	}
+
+	tests := []struct {
+		name         string
+		placement    func(ids ids) entity.Placement
+		mockRepoFn   func(ids ids)
+		wantErr      bool
+		err          error
+		wantPosition float64
+		// rebalanced is the number of siblings that got new positions
+		rebalanced int
+	}{
+		{
+			name:      "between two cards",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.prev, Position: 1024},
+					{ID: ids.card, Position: 1500},
+					{ID: ids.next, Position: 2048},
+				}, nil)
+			},
+			wantPosition: 1536,
+		},
+		{
+			name:      "after last card",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.next} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.card, Position: 0},
+					{ID: ids.prev, Position: 1024},
+					{ID: ids.next, Position: 2048},
+				}, nil)
+			},
+			wantPosition: 3072,
+		},
+		{
+			name:      "before first card",
+			placement: func(ids ids) entity.Placement { return entity.Placement{BeforeID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.prev, Position: 1024},
+					{ID: ids.next, Position: 2048},
+					{ID: ids.card, Position: 4096},
+				}, nil)
+			},
+			wantPosition: 512,
+		},
+		{
+			name:      "rebalance when positions collide",
+			placement: func(ids ids) entity.Placement { return entity.Placement{BeforeID: ids.next} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.prev, Position: 0},
+					{ID: ids.next, Position: 0},
+					{ID: ids.card, Position: 0},
+				}, nil)
+			},
+			wantPosition: 1536,
+			rebalanced:   2,
+		},
+		{
+			name:      "rebalance when precision runs out",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.prev, Position: 1},
+					{ID: ids.next, Position: 1 + 1e-12},
+					{ID: ids.card, Position: 5},
+				}, nil)
+			},
+			wantPosition: 1536,
+			rebalanced:   2,
+		},
+		{
+			name:       "no anchor",
+			placement:  func(ids ids) entity.Placement { return entity.Placement{} },
+			mockRepoFn: func(ids ids) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidPlacement,
+		},
+		{
+			name: "both anchors",
+			placement: func(ids ids) entity.Placement {
+				return entity.Placement{BeforeID: ids.next, AfterID: ids.prev}
+			},
+			mockRepoFn: func(ids ids) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidPlacement,
+		},
+		{
+			name:       "anchor is the card itself",
+			placement:  func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.card} },
+			mockRepoFn: func(ids ids) {},
+			wantErr:    true,
+			err:        v1.ErrInvalidPlacement,
+		},
+		{
+			name:      "anchor in another column",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardAccess(ids.card)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(&entity.Card{ID: ids.prev, ColumnID: uuid.New()}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrNotSiblings,
+		},
+		{
+			name:      "anchor not found",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				ts.mockCardAccess(ids.card)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrAnchorNotFound,
+		},
+		{
+			name:      "anchor left the column concurrently",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				columnID := ts.mockSiblingCards(ids.card, ids.prev, ids.next)
+				ts.mockCardRepo.On("GetCardPositions", ts.ctx, columnID).Return([]entity.Position{
+					{ID: ids.card, Position: 1024},
+					{ID: ids.next, Position: 2048},
+				}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrNotSiblings,
+		},
+		{
+			name:      "lock failed",
+			placement: func(ids ids) entity.Placement { return entity.Placement{AfterID: ids.prev} },
+			mockRepoFn: func(ids ids) {
+				columnID := uuid.New()
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.card).Return(&entity.Card{ID: ids.card, ColumnID: columnID}, nil)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, ids.prev).Return(&entity.Card{ID: ids.prev, ColumnID: columnID}, nil)
+				ts.mockColumnAccess(columnID)
+				ts.mockColumnRepo.On("LockColumn", ts.ctx, columnID).Return(errors.New(""))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ids := ids{card: uuid.New(), prev: uuid.New(), next: uuid.New()}
+			tt.mockRepoFn(ids)
+
+			card, err := ts.todoUseCase.RepositionCard(ts.ctx, ids.card, tt.placement(ids))
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, card)
+				ts.mockCardRepo.AssertNotCalled(t, "UpdateCardPosition", ts.ctx, ids.card, mock.Anything)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantPosition, card.Position)
+			ts.mockCardRepo.AssertCalled(t, "UpdateCardPosition", ts.ctx, ids.card, tt.wantPosition)
+
+			if tt.rebalanced > 0 {
+				ts.mockCardRepo.AssertCalled(t, "UpdateCardPosition", ts.ctx, ids.prev, float64(1024))
+				ts.mockCardRepo.AssertCalled(t, "UpdateCardPosition", ts.ctx, ids.next, float64(2048))
+			} else {
+				ts.mockCardRepo.AssertNotCalled(t, "UpdateCardPosition", ts.ctx, ids.prev, mock.Anything)
+				ts.mockCardRepo.AssertNotCalled(t, "UpdateCardPosition", ts.ctx, ids.next, mock.Anything)
+			}
+		})
+	}
+}
+
+// mockSiblingCards puts the cards into one column the test caller may edit,
+// lets the column be locked and positions be saved. It returns the column id.
+func (ts *testSetup) mockSiblingCards(cardIDs ...uuid.UUID) uuid.UUID {
+	columnID := uuid.New()
+	for _, id := range cardIDs {
+		ts.mockCardRepo.On("GetCardByID", ts.ctx, id).Return(&entity.Card{ID: id, ColumnID: columnID}, nil)
+		ts.mockCardRepo.On("UpdateCardPosition", ts.ctx, id, mock.Anything).Return(nil)
+	}
+	ts.mockColumnAccess(columnID)
+	ts.mockColumnRepo.On("LockColumn", ts.ctx, columnID).Return(nil)
+
+	return columnID
+}
+
+// RepositionColumn(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Column, error)
+func TestRepositionColumn(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name          string
+		sameBoard     bool
+		anchorMissing bool
+		wantErr       bool
+		err           error
+		wantPosition  float64
+	}{
+		{
+			name:         "success",
+			sameBoard:    true,
+			wantPosition: 512,
+		},
+		{
+			name:    "anchor on another board",
+			wantErr: true,
+			err:     v1.ErrNotSiblings,
+		},
+		{
+			name:          "anchor not found",
+			anchorMissing: true,
+			wantErr:       true,
+			err:           v1.ErrAnchorColumnNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			boardID := uuid.New()
+			columnID := uuid.New()
+			anchorID := uuid.New()
+
+			anchorBoardID := uuid.New()
+			if tt.sameBoard {
+				anchorBoardID = boardID
+			}
+
+			ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+			if tt.anchorMissing {
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, anchorID).Return(nil, repository.ErrNotFound)
+			} else {
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, anchorID).Return(&entity.Column{ID: anchorID, BoardID: anchorBoardID}, nil)
+			}
+			ts.mockBoardAccess(boardID)
+			ts.mockBoardRepo.On("LockBoard", ts.ctx, boardID).Return(nil)
+			ts.mockColumnRepo.On("GetColumnPositions", ts.ctx, boardID).Return([]entity.Position{
+				{ID: anchorID, Position: 1024},
+				{ID: columnID, Position: 2048},
+			}, nil)
+			ts.mockColumnRepo.On("UpdateColumnPosition", ts.ctx, columnID, mock.Anything).Return(nil)
+
+			column, err := ts.todoUseCase.RepositionColumn(ts.ctx, columnID, entity.Placement{BeforeID: anchorID})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, column)
+				ts.mockBoardRepo.AssertNotCalled(t, "LockBoard", ts.ctx, boardID)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantPosition, column.Position)
+				ts.mockColumnRepo.AssertCalled(t, "UpdateColumnPosition", ts.ctx, columnID, tt.wantPosition)
+			}
+		})
+	}
+}
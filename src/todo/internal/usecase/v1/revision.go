@@ -0,0 +1,304 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var ErrRevisionInvalidNumber = errors.New("revision number should be positive")
+
+func (uc *todoUseCase) GetCardRevisions(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.CardRevision, error) {
+	header := "GetCardRevisions: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "cardID", cardID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	_, err = uc.authorizeCard(ctx, cardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to revision repo (GetRevisionsByCard)", "cardID", cardID)
+
+	revisions, err := uc.revisionRepo.GetRevisionsByCard(ctx, cardID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get revisions"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got revisions", "count", len(revisions))
+
+	return revisions, nil
+}
+
+func (uc *todoUseCase) DiffCardRevisions(ctx context.Context, cardID uuid.UUID, from, to int) (*entity.RevisionDiff, error) {
+	header := "DiffCardRevisions: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating revision numbers", "cardID", cardID, "from", from, "to", to)
+
+	if from < 1 || to < 1 {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrRevisionInvalidNumber.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrRevisionInvalidNumber)
+	}
+
+	_, err := uc.authorizeCard(ctx, cardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making requests to revision repo (GetRevision)", "cardID", cardID)
+
+	before, err := uc.revisionRepo.GetRevision(ctx, cardID, from)
+
+	if err != nil {
+		info := "Failed to get revision"
+		uc.log.Info(ctx, header+info, "number", from, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	after, err := uc.revisionRepo.GetRevision(ctx, cardID, to)
+
+	if err != nil {
+		info := "Failed to get revision"
+		uc.log.Info(ctx, header+info, "number", to, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	diff := &entity.RevisionDiff{
+		From:        *before,
+		To:          *after,
+		Title:       diffLines(before.Title, after.Title),
+		Description: diffLines(before.Description, after.Description),
+	}
+
+	uc.log.Info(ctx, header+"Revisions compared")
+
+	return diff, nil
+}
+
+// RevertCard puts the title and description of the revision back on the
+// card. The history is kept as it is: the revert becomes a revision of its
+// own.
+func (uc *todoUseCase) RevertCard(ctx context.Context, cardID uuid.UUID, number int) (*entity.Card, error) {
+	header := "RevertCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating revision number", "cardID", cardID, "number", number)
+
+	if number < 1 {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrRevisionInvalidNumber.Error())
+		return nil, fmt.Errorf(header+info+": %w", ErrRevisionInvalidNumber)
+	}
+
+	current, err := uc.authorizeCard(ctx, cardID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	revision, err := uc.revisionRepo.GetRevision(ctx, cardID, number)
+
+	if err != nil {
+		info := "Failed to get revision"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card := *current
+	card.Title = revision.Title
+	card.Description = revision.Description
+	card.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to card repo (UpdateCard)", "card", card)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cardRepo.UpdateCard(ctx, &card); err != nil {
+			return err
+		}
+
+		boardID, err := uc.boardOfCard(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetCard, card.ID, []uuid.UUID{boardID}, fieldChanges(cardFields(current), cardFields(&card))); err != nil {
+			return err
+		}
+
+		return uc.recordRevision(ctx, &card, &number)
+	})
+
+	err = versionConflict(err)
+
+	if err != nil {
+		info := "Failed to revert card"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Card successfully reverted", "number", number)
+
+	return &card, nil
+}
+
+// recordRevision adds the title and description of the card to its history
+// on behalf of the caller. Like recordActivity, it is called with the context
+// of the transaction that makes the change.
+func (uc *todoUseCase) recordRevision(ctx context.Context, card *entity.Card, revertedFrom *int) error {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.revisionRepo.CreateRevision(ctx, &entity.CardRevision{
+		ID:           uuid.New(),
+		CardID:       card.ID,
+		Title:        card.Title,
+		Description:  card.Description,
+		AuthorID:     caller.UserID,
+		RevertedFrom: revertedFrom,
+		CreatedAt:    time.Now(),
+	})
+}
+
+// maxDiffEdits bounds the work and memory of diffLines: texts that differ in
+// more lines than that are shown as replaced as a whole
+const maxDiffEdits = 1000
+
+// diffLines returns the shortest line-based diff that turns before into
+// after, found with the Myers algorithm. When the shortest diff takes more
+// than maxDiffEdits insertions and deletions, every line of before is
+// deleted and every line of after inserted instead.
+func diffLines(before, after string) []entity.DiffLine {
+	a, b := splitLines(before), splitLines(after)
+	n, m := len(a), len(b)
+	max := n + m
+
+	// v[max+k] is the furthest x reached on diagonal k = x - y; trace keeps
+	// the part of v that round d reads, diagonals -d to d, to walk the path
+	// back, so it grows with the square of the edit distance only
+	v := make([]int, 2*max+2)
+	var trace [][]int
+	found := false
+
+search:
+	for d := 0; d <= max && d <= maxDiffEdits; d++ {
+		trace = append(trace, append([]int(nil), v[max-d:max+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[max+k-1] < v[max+k+1]) {
+				x = v[max+k+1]
+			} else {
+				x = v[max+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[max+k] = x
+
+			if x >= n && y >= m {
+				found = true
+				break search
+			}
+		}
+	}
+
+	if !found {
+		return replaceLines(a, b)
+	}
+
+	var lines []entity.DiffLine
+	x, y := n, m
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		// at round d, trace[d][d+k] holds v[max+k]
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[d+k-1] < v[d+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		var prevX int
+		if d > 0 {
+			prevX = v[d+prevK]
+		}
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			lines = append(lines, entity.DiffLine{Op: entity.DiffEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+
+		if d > 0 {
+			if x == prevX {
+				lines = append(lines, entity.DiffLine{Op: entity.DiffInsert, Text: b[y-1]})
+			} else {
+				lines = append(lines, entity.DiffLine{Op: entity.DiffDelete, Text: a[x-1]})
+			}
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+
+	return lines
+}
+
+// replaceLines is the diff that deletes all of a and inserts all of b
+func replaceLines(a, b []string) []entity.DiffLine {
+	lines := make([]entity.DiffLine, 0, len(a)+len(b))
+	for _, text := range a {
+		lines = append(lines, entity.DiffLine{Op: entity.DiffDelete, Text: text})
+	}
+	for _, text := range b {
+		lines = append(lines, entity.DiffLine{Op: entity.DiffInsert, Text: text})
+	}
+
+	return lines
+}
+
+// splitLines splits the text on line breaks; an empty text has no lines
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
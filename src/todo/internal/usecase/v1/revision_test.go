@@ -0,0 +1,265 @@
+package v1_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCardUpdateIsRevised(t *testing.T) {
+	ts := setup()
+	caller, _ := identity.FromContext(ts.ctx)
+
+	t.Run("content changed", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		ts.mockCardOnBoard(cardID)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.Anything).Return(nil)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, &entity.Card{ID: cardID, Title: "Renamed", Description: "Steps"})
+
+		assert.Nil(t, err)
+		ts.mockRevisionRepo.AssertCalled(t, "CreateRevision", ts.ctx, mock.MatchedBy(func(r *entity.CardRevision) bool {
+			return r.CardID == cardID &&
+				r.Title == "Renamed" &&
+				r.Description == "Steps" &&
+				r.AuthorID == caller.UserID &&
+				r.RevertedFrom == nil
+		}))
+	})
+
+	t.Run("only position changed", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		ts.mockCardOnBoard(cardID)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.Anything).Return(nil)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, &entity.Card{ID: cardID, Position: 2048})
+
+		assert.Nil(t, err)
+		ts.mockRevisionRepo.AssertNotCalled(t, "CreateRevision", ts.ctx, mock.MatchedBy(func(r *entity.CardRevision) bool {
+			return r.CardID == cardID
+		}))
+	})
+}
+
+// DiffCardRevisions(ctx context.Context, cardID uuid.UUID, from, to int) (*entity.RevisionDiff, error)
+func TestDiffCardRevisions(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name        string
+		from        int
+		to          int
+		before      string
+		after       string
+		description []entity.DiffLine
+		err         error
+	}{
+		{
+			name:   "changed line",
+			from:   1,
+			to:     2,
+			before: "Steps:\nopen the board\nclose it",
+			after:  "Steps:\nopen the card\nclose it\nreport",
+			description: []entity.DiffLine{
+				{Op: entity.DiffEqual, Text: "Steps:"},
+				{Op: entity.DiffDelete, Text: "open the board"},
+				{Op: entity.DiffInsert, Text: "open the card"},
+				{Op: entity.DiffEqual, Text: "close it"},
+				{Op: entity.DiffInsert, Text: "report"},
+			},
+		},
+		{
+			name:  "description added",
+			from:  1,
+			to:    2,
+			after: "First\nSecond",
+			description: []entity.DiffLine{
+				{Op: entity.DiffInsert, Text: "First"},
+				{Op: entity.DiffInsert, Text: "Second"},
+			},
+		},
+		{
+			name:   "newer revision first",
+			from:   2,
+			to:     1,
+			before: "Same",
+			after:  "Same",
+			description: []entity.DiffLine{
+				{Op: entity.DiffEqual, Text: "Same"},
+			},
+		},
+		{
+			name: "missing revision",
+			from: 1,
+			to:   3,
+			err:  repository.ErrNotFound,
+		},
+		{
+			name: "invalid number",
+			from: 0,
+			to:   2,
+			err:  v1.ErrRevisionInvalidNumber,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID := uuid.New()
+			ts.mockCardOnBoard(cardID)
+			ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, tt.from).Return(&entity.CardRevision{CardID: cardID, Number: tt.from, Title: "Card", Description: tt.before}, nil)
+			ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, 3).Return(nil, repository.ErrNotFound)
+			ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, tt.to).Return(&entity.CardRevision{CardID: cardID, Number: tt.to, Title: "Card", Description: tt.after}, nil)
+
+			diff, err := ts.todoUseCase.DiffCardRevisions(ts.ctx, cardID, tt.from, tt.to)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.from, diff.From.Number)
+			assert.Equal(t, tt.to, diff.To.Number)
+			assert.Equal(t, []entity.DiffLine{{Op: entity.DiffEqual, Text: "Card"}}, diff.Title)
+			assert.Equal(t, tt.description, diff.Description)
+		})
+	}
+}
+
+// numberedLines is a text of count lines made of the prefix and the number
+// of the line
+func numberedLines(prefix string, count int) []string {
+	lines := make([]string, count)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s %d", prefix, i)
+	}
+
+	return lines
+}
+
+func TestDiffCardRevisionsOfLargeDescriptions(t *testing.T) {
+	ts := setup()
+
+	before := numberedLines("before", 4000)
+	after := numberedLines("after", 4000)
+
+	edited := append([]string(nil), before...)
+	edited[2000] = "edited"
+
+	tests := []struct {
+		name   string
+		before []string
+		after  []string
+		want   func(diff []entity.DiffLine)
+	}{
+		{
+			// too many edits to look for the shortest diff
+			name:   "different",
+			before: before,
+			after:  after,
+			want: func(diff []entity.DiffLine) {
+				if assert.Len(t, diff, 8000) {
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffDelete, Text: "before 0"}, diff[0])
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffDelete, Text: "before 3999"}, diff[3999])
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffInsert, Text: "after 0"}, diff[4000])
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffInsert, Text: "after 3999"}, diff[7999])
+				}
+			},
+		},
+		{
+			name:   "one line edited",
+			before: before,
+			after:  edited,
+			want: func(diff []entity.DiffLine) {
+				if assert.Len(t, diff, 4001) {
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffDelete, Text: "before 2000"}, diff[2000])
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffInsert, Text: "edited"}, diff[2001])
+					assert.Equal(t, entity.DiffLine{Op: entity.DiffEqual, Text: "before 3999"}, diff[4000])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardID := uuid.New()
+			ts.mockCardOnBoard(cardID)
+			ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, 1).Return(&entity.CardRevision{CardID: cardID, Number: 1, Title: "Card", Description: strings.Join(tt.before, "\n")}, nil)
+			ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, 2).Return(&entity.CardRevision{CardID: cardID, Number: 2, Title: "Card", Description: strings.Join(tt.after, "\n")}, nil)
+
+			var start, end runtime.MemStats
+			runtime.ReadMemStats(&start)
+
+			diff, err := ts.todoUseCase.DiffCardRevisions(ts.ctx, cardID, 1, 2)
+
+			runtime.ReadMemStats(&end)
+
+			assert.Nil(t, err)
+			assert.Less(t, end.TotalAlloc-start.TotalAlloc, uint64(64<<20))
+			tt.want(diff.Description)
+		})
+	}
+}
+
+// RevertCard(ctx context.Context, cardID uuid.UUID, number int) (*entity.Card, error)
+func TestRevertCard(t *testing.T) {
+	ts := setup()
+
+	t.Run("revision restored", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		ts.mockCardOnBoard(cardID)
+		ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, 2).Return(&entity.CardRevision{CardID: cardID, Number: 2, Title: "Old title", Description: "Old text"}, nil)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+			return c.ID == cardID
+		})).Return(nil)
+
+		card, err := ts.todoUseCase.RevertCard(ts.ctx, cardID, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Old title", card.Title)
+		assert.Equal(t, "Old text", card.Description)
+		ts.mockCardRepo.AssertCalled(t, "UpdateCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+			return c.ID == cardID && c.Title == "Old title" && c.Description == "Old text"
+		}))
+		ts.mockRevisionRepo.AssertCalled(t, "CreateRevision", ts.ctx, mock.MatchedBy(func(r *entity.CardRevision) bool {
+			return r.CardID == cardID &&
+				r.Title == "Old title" &&
+				r.RevertedFrom != nil && *r.RevertedFrom == 2
+		}))
+	})
+
+	t.Run("missing revision", func(t *testing.T) {
+		t.Parallel()
+		cardID := uuid.New()
+		ts.mockCardOnBoard(cardID)
+		ts.mockRevisionRepo.On("GetRevision", ts.ctx, cardID, 5).Return(nil, repository.ErrNotFound)
+
+		_, err := ts.todoUseCase.RevertCard(ts.ctx, cardID, 5)
+
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		ts.mockCardRepo.AssertNotCalled(t, "UpdateCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+			return c.ID == cardID
+		}))
+	})
+
+	t.Run("invalid number", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ts.todoUseCase.RevertCard(ts.ctx, uuid.New(), -1)
+
+		assert.ErrorIs(t, err, v1.ErrRevisionInvalidNumber)
+	})
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+const maxSearchQueryLength = 256
+
+var (
+	ErrSearchEmptyQuery    = errors.New("search query cannot be empty")
+	ErrSearchQueryTooLong  = fmt.Errorf("search query cannot be longer than %d characters", maxSearchQueryLength)
+	ErrSearchInvalidPeriod = errors.New("search period cannot end before it starts")
+)
+
+func validateCardSearch(search entity.CardSearch) error {
+	if strings.TrimSpace(search.Query) == "" {
+		return ErrSearchEmptyQuery
+	}
+
+	if len([]rune(search.Query)) > maxSearchQueryLength {
+		return ErrSearchQueryTooLong
+	}
+
+	if !search.From.IsZero() && !search.To.IsZero() && search.To.Before(search.From) {
+		return ErrSearchInvalidPeriod
+	}
+
+	return nil
+}
+
+// SearchCards looks for cards across all boards the user owns or is a member
+// of. Filtering by a board the user has no access to finds nothing.
+func (uc *todoUseCase) SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit, offset int) ([]entity.CardMatch, error) {
+	header := "SearchCards: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating search", "userID", userID, "search", search, "limit", limit, "offset", offset)
+
+	err := validateCardSearch(search)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (SearchCards)", "userID", userID)
+
+	matches, err := uc.cardRepo.SearchCards(ctx, userID, search, limit, offset)
+
+	if err != nil {
+		info := "Failed to search cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Found cards", "count", len(matches))
+
+	return matches, nil
+}
@@ -0,0 +1,102 @@
+package v1_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit, offset int) ([]entity.CardMatch, error)
+func TestSearchCards(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		caller     uuid.UUID
+		search     entity.CardSearch
+		mockRepoFn func(ts *testSetup)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			caller: userID,
+			search: entity.CardSearch{Query: "release notes", BoardID: uuid.New(), From: now.AddDate(0, -1, 0), To: now},
+			mockRepoFn: func(ts *testSetup) {
+				ts.mockCardRepo.On("SearchCards", mock.Anything, userID, mock.Anything, 10, 0).Return([]entity.CardMatch{{Rank: 0.5}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "blank query",
+			caller:     userID,
+			search:     entity.CardSearch{Query: " \t"},
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    true,
+			err:        v1.ErrSearchEmptyQuery,
+		},
+		{
+			name:       "query too long",
+			caller:     userID,
+			search:     entity.CardSearch{Query: strings.Repeat("ы", 257)},
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    true,
+			err:        v1.ErrSearchQueryTooLong,
+		},
+		{
+			name:       "period ends before it starts",
+			caller:     userID,
+			search:     entity.CardSearch{Query: "notes", From: now, To: now.AddDate(0, 0, -1)},
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    true,
+			err:        v1.ErrSearchInvalidPeriod,
+		},
+		{
+			name:       "someone else's cards",
+			caller:     uuid.New(),
+			search:     entity.CardSearch{Query: "notes"},
+			mockRepoFn: func(ts *testSetup) {},
+			wantErr:    true,
+			err:        v1.ErrForbidden,
+		},
+		{
+			name:   "repo error",
+			caller: userID,
+			search: entity.CardSearch{Query: "notes"},
+			mockRepoFn: func(ts *testSetup) {
+				ts.mockCardRepo.On("SearchCards", mock.Anything, userID, mock.Anything, 10, 0).Return(nil, errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+			tt.mockRepoFn(ts)
+
+			matches, err := ts.todoUseCase.SearchCards(userContext(tt.caller), userID, tt.search, 10, 0)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Nil(t, matches)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, matches, 1)
+			ts.mockCardRepo.AssertCalled(t, "SearchCards", mock.Anything, userID, tt.search, 10, 0)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	shareTokenBytes   = 24
+	boardTreePageSize = 100
+)
+
+var (
+	ErrShareTokenNotFound   = errors.New("share token not found or revoked")
+	ErrBoardNotPublic       = errors.New("board is not public")
+	ErrCardNotOnSharedBoard = errors.New("card does not belong to the shared board")
+	ErrEmptyShareToken      = errors.New("share token cannot be empty")
+)
+
+func (uc *todoUseCase) SetBoardPublic(ctx context.Context, id uuid.UUID, isPublic bool) error {
+	header := "SetBoardPublic: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id, "isPublic", isPublic)
+
+	board, err := uc.boardAccess(ctx, id, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	before := boardFields(board)
+
+	board.IsPublic = isPublic
+	board.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to board repo (UpdateBoardVisibility)", "board", board)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.UpdateBoardVisibility(ctx, board); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, fieldChanges(before, boardFields(board)))
+	})
+
+	if err != nil {
+		info := "Failed to update board visibility"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board visibility successfully updated")
+
+	return nil
+}
+
+func (uc *todoUseCase) CreateShareToken(ctx context.Context, boardID uuid.UUID) (*entity.ShareToken, error) {
+	header := "CreateShareToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID)
+
+	_, err := uc.boardAccess(ctx, boardID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	token, err := generateShareToken()
+
+	if err != nil {
+		info := "Failed to generate share token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	shareToken := &entity.ShareToken{
+		Token:     token,
+		BoardID:   boardID,
+		CreatedAt: time.Now(),
+	}
+
+	uc.log.Info(ctx, header+"Making request to share token repo (CreateShareToken)", "boardID", boardID)
+
+	err = uc.shareTokenRepo.CreateShareToken(ctx, shareToken)
+
+	if err != nil {
+		info := "Failed to create share token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Share token successfully created")
+
+	return shareToken, nil
+}
+
+func generateShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (uc *todoUseCase) GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error) {
+	header := "GetShareTokensByBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID)
+
+	_, err := uc.boardAccess(ctx, boardID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to share token repo (GetShareTokensByBoard)", "boardID", boardID)
+
+	tokens, err := uc.shareTokenRepo.GetShareTokensByBoard(ctx, boardID)
+
+	if err != nil {
+		info := "Failed to get share tokens by board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got share tokens", "count", len(tokens))
+
+	return tokens, nil
+}
+
+func (uc *todoUseCase) RevokeShareToken(ctx context.Context, boardID uuid.UUID, token string) error {
+	header := "RevokeShareToken: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "boardID", boardID)
+
+	_, err := uc.boardAccess(ctx, boardID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to share token repo (GetShareToken)", "boardID", boardID)
+
+	shareToken, err := uc.getShareToken(ctx, token)
+
+	if err != nil {
+		info := "Failed to get share token"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	// A token of another board is reported as missing so that it cannot be probed
+	if shareToken.BoardID != boardID {
+		info := "Share token belongs to another board"
+		uc.log.Info(ctx, header+info, "boardID", boardID)
+		return fmt.Errorf(header+info+": %w", ErrShareTokenNotFound)
+	}
+
+	uc.log.Info(ctx, header+"Making request to share token repo (DeleteShareToken)", "boardID", boardID)
+
+	err = uc.shareTokenRepo.DeleteShareToken(ctx, token)
+
+	if err != nil {
+		info := "Failed to delete share token"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Share token successfully revoked")
+
+	return nil
+}
+
+func (uc *todoUseCase) GetSharedBoard(ctx context.Context, token string) (*entity.BoardTree, error) {
+	header := "GetSharedBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Resolving share token")
+
+	board, err := uc.getSharedBoard(ctx, token)
+
+	if err != nil {
+		info := "Failed to resolve share token"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Loading board tree", "boardID", board.ID)
+
+	tree, err := uc.loadBoardTree(ctx, board)
+
+	if err != nil {
+		info := "Failed to load board tree"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got board tree", "boardID", board.ID, "columns", len(tree.Columns))
+
+	return tree, nil
+}
+
+func (uc *todoUseCase) GetSharedCard(ctx context.Context, token string, cardID uuid.UUID) (*entity.Card, error) {
+	header := "GetSharedCard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Resolving share token", "cardID", cardID)
+
+	board, err := uc.getSharedBoard(ctx, token)
+
+	if err != nil {
+		info := "Failed to resolve share token"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (GetCardByID)", "cardID", cardID)
+
+	card, err := uc.cardRepo.GetCardByID(ctx, cardID)
+
+	if err != nil {
+		info := "Failed to get card by id"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to column repo (GetColumnByID)", "columnID", card.ColumnID)
+
+	column, err := uc.columnRepo.GetColumnByID(ctx, card.ColumnID)
+
+	if err != nil {
+		info := "Failed to get column by id"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	if column.BoardID != board.ID {
+		info := "Card is not on the shared board"
+		uc.log.Info(ctx, header+info, "cardID", cardID, "boardID", board.ID)
+		return nil, fmt.Errorf(header+info+": %w", ErrCardNotOnSharedBoard)
+	}
+
+	uc.log.Info(ctx, header+"Filling in card labels and checklist progress", "cardID", cardID)
+
+	cards := []entity.Card{*card}
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card = &cards[0]
+
+	uc.log.Info(ctx, header+"Got card", "card", card)
+
+	return card, nil
+}
+
+func (uc *todoUseCase) getShareToken(ctx context.Context, token string) (*entity.ShareToken, error) {
+	if token == "" {
+		return nil, ErrEmptyShareToken
+	}
+
+	shareToken, err := uc.shareTokenRepo.GetShareToken(ctx, token)
+
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrShareTokenNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return shareToken, nil
+}
+
+// getSharedBoard returns the board behind a share token. The link only works
+// while the board is public, so unpublishing a board disables all its links.
+func (uc *todoUseCase) getSharedBoard(ctx context.Context, token string) (*entity.Board, error) {
+	shareToken, err := uc.getShareToken(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := uc.boardRepo.GetBoardByID(ctx, shareToken.BoardID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !board.IsPublic {
+		return nil, ErrBoardNotPublic
+	}
+
+	return board, nil
+}
+
+func (uc *todoUseCase) loadBoardTree(ctx context.Context, board *entity.Board) (*entity.BoardTree, error) {
+	columns, err := uc.loadColumnTrees(ctx, board.ID, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.BoardTree{
+		Board:   *board,
+		Columns: columns,
+	}, nil
+}
+
+func (uc *todoUseCase) loadColumnTrees(ctx context.Context, boardID uuid.UUID, archived bool) ([]entity.ColumnTree, error) {
+	trees := []entity.ColumnTree{}
+
+	for offset := 0; ; offset += boardTreePageSize {
+		columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, archived, boardTreePageSize, offset)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, column := range columns {
+			cards, err := uc.loadColumnCards(ctx, column.ID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			trees = append(trees, entity.ColumnTree{
+				Column: column,
+				Cards:  cards,
+			})
+		}
+
+		if len(columns) < boardTreePageSize {
+			break
+		}
+	}
+
+	return trees, nil
+}
+
+func (uc *todoUseCase) loadColumnCards(ctx context.Context, columnID uuid.UUID) ([]entity.Card, error) {
+	cards := []entity.Card{}
+
+	for offset := 0; ; offset += boardTreePageSize {
+		page, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, entity.CardFilter{}, boardTreePageSize, offset)
+
+		if err != nil {
+			return nil, err
+		}
+
+		cards = append(cards, page...)
+
+		if len(page) < boardTreePageSize {
+			break
+		}
+	}
+
+	if err := uc.withCardDetails(ctx, cards); err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
@@ -0,0 +1,268 @@
+package v1_test
+
+import (
+	"errors"
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateShareToken(ctx context.Context, boardID uuid.UUID) (*entity.ShareToken, error)
+func TestCreateShareToken(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		boardID    uuid.UUID
+		mockRepoFn func(boardID uuid.UUID)
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:    "success",
+			boardID: uuid.New(),
+			mockRepoFn: func(boardID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID}, nil)
+				ts.mockShareTokenRepo.On("CreateShareToken", ts.ctx, mock.MatchedBy(func(s *entity.ShareToken) bool {
+					return s.BoardID == boardID
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "board not found",
+			boardID: uuid.New(),
+			mockRepoFn: func(boardID uuid.UUID) {
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(nil, errors.New(""))
+			},
+			wantErr: true,
+			errMsg:  "CreateShareToken: Access check failed: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.boardID)
+
+			shareToken, err := ts.todoUseCase.CreateShareToken(ts.ctx, tt.boardID)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, shareToken)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.boardID, shareToken.BoardID)
+				assert.NotEmpty(t, shareToken.Token)
+			}
+		})
+	}
+}
+
+// RevokeShareToken(ctx context.Context, boardID uuid.UUID, token string) error
+func TestRevokeShareToken(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		boardID    uuid.UUID
+		token      string
+		mockRepoFn func(boardID uuid.UUID, token string)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:    "success",
+			boardID: uuid.New(),
+			token:   "revoke-success",
+			mockRepoFn: func(boardID uuid.UUID, token string) {
+				ts.mockBoardAccess(boardID)
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: boardID}, nil)
+				ts.mockShareTokenRepo.On("DeleteShareToken", ts.ctx, token).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "token of another board",
+			boardID: uuid.New(),
+			token:   "revoke-another-board",
+			mockRepoFn: func(boardID uuid.UUID, token string) {
+				ts.mockBoardAccess(boardID)
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: uuid.New()}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrShareTokenNotFound,
+		},
+		{
+			name:    "token not found",
+			boardID: uuid.New(),
+			token:   "revoke-not-found",
+			mockRepoFn: func(boardID uuid.UUID, token string) {
+				ts.mockBoardAccess(boardID)
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrShareTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.boardID, tt.token)
+
+			err := ts.todoUseCase.RevokeShareToken(ts.ctx, tt.boardID, tt.token)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				ts.mockShareTokenRepo.AssertNotCalled(t, "DeleteShareToken", ts.ctx, tt.token)
+			} else {
+				assert.Nil(t, err)
+				ts.mockShareTokenRepo.AssertCalled(t, "DeleteShareToken", ts.ctx, tt.token)
+			}
+		})
+	}
+}
+
+// GetSharedBoard(ctx context.Context, token string) (*entity.BoardTree, error)
+func TestGetSharedBoard(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		token      string
+		mockRepoFn func(token string)
+		wantErr    bool
+		err        error
+		wantCards  int
+	}{
+		{
+			name:  "success",
+			token: "board-success",
+			mockRepoFn: func(token string) {
+				boardID := uuid.New()
+				columnID := uuid.New()
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: boardID}, nil)
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, IsPublic: true}, nil)
+				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, false, mock.Anything, 0).Return([]entity.Column{{ID: columnID, BoardID: boardID}}, nil)
+				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, mock.Anything, 0).Return([]entity.Card{{ColumnID: columnID}, {ColumnID: columnID}}, nil)
+				ts.mockNoCardDetails()
+			},
+			wantErr:   false,
+			wantCards: 2,
+		},
+		{
+			name:  "board is not public",
+			token: "board-private",
+			mockRepoFn: func(token string) {
+				boardID := uuid.New()
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: boardID}, nil)
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrBoardNotPublic,
+		},
+		{
+			name:  "revoked token",
+			token: "board-revoked",
+			mockRepoFn: func(token string) {
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrShareTokenNotFound,
+		},
+		{
+			name:       "empty token",
+			token:      "",
+			mockRepoFn: func(token string) {},
+			wantErr:    true,
+			err:        v1.ErrEmptyShareToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.token)
+
+			tree, err := ts.todoUseCase.GetSharedBoard(ts.ctx, tt.token)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, tree)
+			} else {
+				assert.Nil(t, err)
+				assert.Len(t, tree.Columns, 1)
+				assert.Len(t, tree.Columns[0].Cards, tt.wantCards)
+			}
+		})
+	}
+}
+
+// GetSharedCard(ctx context.Context, token string, cardID uuid.UUID) (*entity.Card, error)
+func TestGetSharedCard(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		token      string
+		cardID     uuid.UUID
+		mockRepoFn func(token string, cardID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			token:  "card-success",
+			cardID: uuid.New(),
+			mockRepoFn: func(token string, cardID uuid.UUID) {
+				boardID := uuid.New()
+				columnID := uuid.New()
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: boardID}, nil)
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, IsPublic: true}, nil)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+				ts.mockNoCardDetails()
+			},
+			wantErr: false,
+		},
+		{
+			name:   "card on another board",
+			token:  "card-another-board",
+			cardID: uuid.New(),
+			mockRepoFn: func(token string, cardID uuid.UUID) {
+				boardID := uuid.New()
+				columnID := uuid.New()
+				ts.mockShareTokenRepo.On("GetShareToken", ts.ctx, token).Return(&entity.ShareToken{Token: token, BoardID: boardID}, nil)
+				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID, IsPublic: true}, nil)
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: uuid.New()}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrCardNotOnSharedBoard,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.mockRepoFn(tt.token, tt.cardID)
+
+			card, err := ts.todoUseCase.GetSharedCard(ts.ctx, tt.token, tt.cardID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, card)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.cardID, card.ID)
+			}
+		})
+	}
+}
@@ -7,12 +7,15 @@ import (
 	"time"
 	"todo/internal/common/logger"
 	"todo/internal/entity"
+	"todo/internal/feed"
 	"todo/internal/repository"
 	"todo/internal/usecase"
 
 	"github.com/google/uuid"
 )
 
+const maxCardDescriptionLength = 16384
+
 var (
 	ErrBoardEmptyTitle        = errors.New("board should have a title")
 	ErrBoardNoUserID          = errors.New("board should have a user id")
@@ -26,6 +29,7 @@ var (
 	ErrCardNoColumnID         = errors.New("card should have a column id")
 	ErrCardNegativePosition   = errors.New("card cannot have a negative position")
 	ErrCardEmptyTitle         = errors.New("card should have a title")
+	ErrCardDescriptionTooLong = fmt.Errorf("card description cannot be longer than %d characters", maxCardDescriptionLength)
 	ErrGetBoardByID           = errors.New("failed to get board by id")
 	ErrGetBoardsByUser        = errors.New("failed to get boards by user")
 	ErrUpdateBoard            = errors.New("failed to update board")
@@ -43,23 +47,65 @@ var (
 )
 
 type todoUseCase struct {
-	boardRepo  repository.BoardRepository
-	columnRepo repository.ColumnRepository
-	cardRepo   repository.CardRepository
-	log        logger.Logger
+	boardRepo      repository.BoardRepository
+	columnRepo     repository.ColumnRepository
+	cardRepo       repository.CardRepository
+	shareTokenRepo repository.ShareTokenRepository
+	memberRepo     repository.BoardMemberRepository
+	labelRepo      repository.LabelRepository
+	checklistRepo  repository.ChecklistRepository
+	commentRepo    repository.CommentRepository
+	assigneeRepo   repository.AssigneeRepository
+	attachmentRepo repository.AttachmentRepository
+	blobStore      repository.BlobStore
+	activityRepo   repository.ActivityRepository
+	trashRepo      repository.TrashRepository
+	revisionRepo   repository.RevisionRepository
+	outboxRepo     repository.OutboxRepository
+	broker         feed.Broker
+	tx             repository.Transactor
+	log            logger.Logger
 }
 
 func NewTodoUseCase(
 	boardRepo repository.BoardRepository,
 	columnRepo repository.ColumnRepository,
 	cardRepo repository.CardRepository,
+	shareTokenRepo repository.ShareTokenRepository,
+	memberRepo repository.BoardMemberRepository,
+	labelRepo repository.LabelRepository,
+	checklistRepo repository.ChecklistRepository,
+	commentRepo repository.CommentRepository,
+	assigneeRepo repository.AssigneeRepository,
+	attachmentRepo repository.AttachmentRepository,
+	blobStore repository.BlobStore,
+	activityRepo repository.ActivityRepository,
+	trashRepo repository.TrashRepository,
+	revisionRepo repository.RevisionRepository,
+	outboxRepo repository.OutboxRepository,
+	broker feed.Broker,
+	tx repository.Transactor,
 	log logger.Logger,
 ) usecase.TodoUseCase {
 	return &todoUseCase{
-		boardRepo:  boardRepo,
-		columnRepo: columnRepo,
-		cardRepo:   cardRepo,
-		log:        log,
+		boardRepo:      boardRepo,
+		columnRepo:     columnRepo,
+		cardRepo:       cardRepo,
+		shareTokenRepo: shareTokenRepo,
+		memberRepo:     memberRepo,
+		labelRepo:      labelRepo,
+		checklistRepo:  checklistRepo,
+		commentRepo:    commentRepo,
+		assigneeRepo:   assigneeRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		activityRepo:   activityRepo,
+		trashRepo:      trashRepo,
+		revisionRepo:   revisionRepo,
+		outboxRepo:     outboxRepo,
+		broker:         broker,
+		tx:             tx,
+		log:            log,
 	}
 }
 
@@ -76,6 +122,14 @@ func (uc *todoUseCase) CreateBoard(ctx context.Context, board *entity.Board) err
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	err = authorizeUser(ctx, board.UserID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	board.ID = uuid.New()
 	board.CreatedAt = time.Now()
 	board.UpdatedAt = time.Now()
@@ -84,7 +138,13 @@ func (uc *todoUseCase) CreateBoard(ctx context.Context, board *entity.Board) err
 
 	uc.log.Info(ctx, header+"Making request to board repo (CreateBoard)", "board", board)
 
-	err = uc.boardRepo.CreateBoard(ctx, board)
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.CreateBoard(ctx, board); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityCreate, entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, fieldChanges(nil, boardFields(board)))
+	})
 
 	if err != nil {
 		info := "Failed to create board"
@@ -112,13 +172,13 @@ func validateBoard(board *entity.Board) error {
 func (uc *todoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
 	header := "GetBoardByID: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to board repo (GetBoardByID)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id)
 
-	board, err := uc.boardRepo.GetBoardByID(ctx, id)
+	board, err := uc.authorizeBoard(ctx, id, entity.RoleViewer)
 
 	if err != nil {
 		info := "Failed to get board by id"
-		uc.log.Error(ctx, header+info, "err", err.Error())
+		uc.log.Info(ctx, header+info, "err", err.Error())
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
@@ -127,7 +187,7 @@ func (uc *todoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.
 	return board, nil
 }
 
-func (uc *todoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error) {
+func (uc *todoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit, offset int) ([]entity.Board, error) {
 	header := "GetBoardsByUser: "
 
 	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "userID", userID, "limit", limit, "offset", offset)
@@ -140,9 +200,17 @@ func (uc *todoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, li
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Making request to board repo (GetBoardsByUser)", "userID", userID, "limit", limit, "offset", offset)
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board repo (GetBoardsByUser)", "userID", userID, "archived", archived, "limit", limit, "offset", offset)
 
-	boards, err := uc.boardRepo.GetBoardsByUser(ctx, userID, limit, offset)
+	boards, err := uc.boardRepo.GetBoardsByUser(ctx, userID, archived, limit, offset)
 
 	if err != nil {
 		info := "Failed to get boards by user"
@@ -171,11 +239,39 @@ func (uc *todoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) err
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	current, err := uc.authorizeBoard(ctx, board.ID, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = checkVersion(board.Version, current.Version)
+
+	if err != nil {
+		info := "Version check failed"
+		uc.log.Info(ctx, header+info, "version", board.Version, "current", current.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	board.Version = current.Version
 	board.UpdatedAt = time.Now()
 
+	updated := *current
+	updated.Title = board.Title
+
 	uc.log.Info(ctx, header+"Making request to board repo (UpdateBoard)", "board", board)
 
-	err = uc.boardRepo.UpdateBoard(ctx, board)
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.boardRepo.UpdateBoard(ctx, board); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetBoard, board.ID, []uuid.UUID{board.ID}, fieldChanges(boardFields(current), boardFields(&updated)))
+	})
+
+	err = versionConflict(err)
 
 	if err != nil {
 		info := "Failed to update board"
@@ -191,9 +287,30 @@ func (uc *todoUseCase) UpdateBoard(ctx context.Context, board *entity.Board) err
 func (uc *todoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
 	header := "DeleteBoard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to board repo (DeleteBoard)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to board", "id", id)
+
+	board, err := uc.boardAccess(ctx, id, entity.RoleOwner)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to board repo (DeleteBoard)", "id", id)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		caller, err := callerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.boardRepo.DeleteBoard(ctx, id, caller.UserID); err != nil {
+			return err
+		}
 
-	err := uc.boardRepo.DeleteBoard(ctx, id)
+		return uc.recordActivity(ctx, entity.ActivityDelete, entity.ActivityTargetBoard, id, []uuid.UUID{id}, fieldChanges(boardFields(board), nil))
+	})
 
 	if err != nil {
 		info := "Failed to delete board"
@@ -219,6 +336,14 @@ func (uc *todoUseCase) CreateColumn(ctx context.Context, column *entity.Column)
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	_, err = uc.authorizeBoard(ctx, column.BoardID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	column.ID = uuid.New()
 	column.CreatedAt = time.Now()
 	column.UpdatedAt = time.Now()
@@ -227,7 +352,13 @@ func (uc *todoUseCase) CreateColumn(ctx context.Context, column *entity.Column)
 
 	uc.log.Info(ctx, header+"Making request to column repo (CreateColumn)", "column", column)
 
-	err = uc.columnRepo.CreateColumn(ctx, column)
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.columnRepo.CreateColumn(ctx, column); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityCreate, entity.ActivityTargetColumn, column.ID, []uuid.UUID{column.BoardID}, fieldChanges(nil, columnFields(column)))
+	})
 
 	if err != nil {
 		info := "Failed to make request to repo"
@@ -257,28 +388,39 @@ func validateColumn(column *entity.Column) error {
 		return ErrColumnNegativePosition
 	}
 
-	return nil
+	return validateWIPLimit(column.WIPLimit)
 }
 
 func (uc *todoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
 	header := "GetColumnByID: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to column repo (GetColumnByID)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to column", "id", id)
 
-	column, err := uc.columnRepo.GetColumnByID(ctx, id)
+	column, err := uc.authorizeColumn(ctx, id, entity.RoleViewer)
 
 	if err != nil {
 		info := "Failed to get column by id"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	columns := []entity.Column{*column}
+	err = uc.withCardCounts(ctx, columns)
+
+	if err != nil {
+		info := "Failed to count cards"
 		uc.log.Error(ctx, header+info, "err", err.Error())
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	column = &columns[0]
+
 	uc.log.Info(ctx, header+"Got column", "column", column)
 
 	return column, nil
 }
 
-func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error) {
+func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit, offset int) ([]entity.Column, error) {
 	header := "GetColumnsByBoard: "
 
 	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "boardID", boardID, "limit", limit, "offset", offset)
@@ -291,9 +433,17 @@ func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID,
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
-	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (GetColumnsByBoard)", "boardID", boardID, "limit", limit, "offset", offset)
+	_, err = uc.authorizeBoard(ctx, boardID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (GetColumnsByBoard)", "boardID", boardID, "archived", archived, "limit", limit, "offset", offset)
 
-	columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, limit, offset)
+	columns, err := uc.columnRepo.GetColumnsByBoard(ctx, boardID, archived, limit, offset)
 
 	if err != nil {
 		info := "Failed to get columns by board"
@@ -301,6 +451,14 @@ func (uc *todoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID,
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	err = uc.withCardCounts(ctx, columns)
+
+	if err != nil {
+		info := "Failed to count cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
 	uc.log.Info(ctx, header+"Got columns", "columns", columns)
 
 	return columns, nil
@@ -334,11 +492,40 @@ func (uc *todoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	current, err := uc.authorizeColumn(ctx, column.ID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = checkVersion(column.Version, current.Version)
+
+	if err != nil {
+		info := "Version check failed"
+		uc.log.Info(ctx, header+info, "version", column.Version, "current", current.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	column.Version = current.Version
 	column.UpdatedAt = time.Now()
 
+	updated := *current
+	updated.Title = column.Title
+	updated.Position = column.Position
+
 	uc.log.Info(ctx, header+"Validation successful; Making request to column repo (UpdateColumn)", "column", column)
 
-	err = uc.columnRepo.UpdateColumn(ctx, column)
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.columnRepo.UpdateColumn(ctx, column); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetColumn, column.ID, []uuid.UUID{current.BoardID}, fieldChanges(columnFields(current), columnFields(&updated)))
+	})
+
+	err = versionConflict(err)
 
 	if err != nil {
 		info := "Failed to update column"
@@ -354,9 +541,30 @@ func (uc *todoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 func (uc *todoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
 	header := "DeleteColumn: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to column repo (DeleteColumn)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to column", "id", id)
 
-	err := uc.columnRepo.DeleteColumn(ctx, id)
+	column, err := uc.columnAccess(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to column repo (DeleteColumn)", "id", id)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		caller, err := callerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.columnRepo.DeleteColumn(ctx, id, caller.UserID); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityDelete, entity.ActivityTargetColumn, id, []uuid.UUID{column.BoardID}, fieldChanges(columnFields(column), nil))
+	})
 
 	if err != nil {
 		info := "Failed to delete column"
@@ -382,6 +590,14 @@ func (uc *todoUseCase) CreateCard(ctx context.Context, card *entity.Card) error
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	column, err := uc.authorizeColumn(ctx, card.ColumnID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
 	card.ID = uuid.New()
 	card.CreatedAt = time.Now()
 	card.UpdatedAt = time.Now()
@@ -390,7 +606,30 @@ func (uc *todoUseCase) CreateCard(ctx context.Context, card *entity.Card) error
 
 	uc.log.Info(ctx, header+"Making request to card repo (CreateCard)", "card", card)
 
-	err = uc.cardRepo.CreateCard(ctx, card)
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if column.WIPLimit.Max != 0 {
+			if err := uc.columnRepo.LockColumn(ctx, column.ID); err != nil {
+				return err
+			}
+		}
+
+		over, err := uc.checkWIPLimit(ctx, column)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.cardRepo.CreateCard(ctx, card); err != nil {
+			return err
+		}
+
+		card.OverWIPLimit = over
+
+		if err := uc.recordActivity(ctx, entity.ActivityCreate, entity.ActivityTargetCard, card.ID, []uuid.UUID{column.BoardID}, fieldChanges(nil, cardFields(card))); err != nil {
+			return err
+		}
+
+		return uc.recordRevision(ctx, card, nil)
+	})
 
 	if err != nil {
 		info := "Failed to create card"
@@ -418,18 +657,45 @@ func validateCard(card *entity.Card) error {
 		return ErrCardEmptyTitle
 	}
 
-	return nil
+	if len([]rune(card.Description)) > maxCardDescriptionLength {
+		return ErrCardDescriptionTooLong
+	}
+
+	return validateCardDates(entity.CardDates{StartDate: card.StartDate, DueDate: card.DueDate})
 }
 
 func (uc *todoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
 	header := "GetCardByID: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to card repo (GetCardByID)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card", "id", id)
 
-	card, err := uc.cardRepo.GetCardByID(ctx, id)
+	card, err := uc.authorizeCard(ctx, id, entity.RoleViewer)
 
 	if err != nil {
 		info := "Failed to get card by id"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Filling in card labels and checklist progress", "id", id)
+
+	cards := []entity.Card{*card}
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	card = &cards[0]
+
+	uc.log.Info(ctx, header+"Making request to attachment repo (GetAttachmentsByCard)", "id", id)
+
+	card.Attachments, err = uc.attachmentRepo.GetAttachmentsByCard(ctx, id)
+
+	if err != nil {
+		info := "Failed to get card attachments"
 		uc.log.Error(ctx, header+info, "err", err.Error())
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
@@ -439,10 +705,10 @@ func (uc *todoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.C
 	return card, nil
 }
 
-func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error) {
+func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit, offset int) ([]entity.Card, error) {
 	header := "GetCardsByColumn: "
 
-	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "columnID", columnID, "limit", limit, "offset", offset)
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "columnID", columnID, "filter", filter, "limit", limit, "offset", offset)
 
 	err := validateLimitAndOffset(limit, offset)
 
@@ -452,9 +718,17 @@ func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID,
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	_, err = uc.authorizeColumn(ctx, columnID, entity.RoleViewer)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
 	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetCardsByColumn)", "columnID", columnID, "limit", limit, "offset", offset)
 
-	cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, limit, offset)
+	cards, err := uc.cardRepo.GetCardsByColumn(ctx, columnID, filter, limit, offset)
 
 	if err != nil {
 		info := "Failed to get cards by column"
@@ -462,11 +736,35 @@ func (uc *todoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID,
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	uc.log.Info(ctx, header+"Filling in card labels and checklist progress", "count", len(cards))
+
+	err = uc.withCardDetails(ctx, cards)
+
+	if err != nil {
+		info := "Failed to get card details"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
 	uc.log.Info(ctx, header+"Got cards", "cards", cards)
 
 	return cards, nil
 }
 
+// withCardDetails fills in what list views show next to a card: its labels
+// and the progress of its checklists
+func (uc *todoUseCase) withCardDetails(ctx context.Context, cards []entity.Card) error {
+	if err := uc.withLabels(ctx, cards); err != nil {
+		return err
+	}
+
+	if err := uc.withProgress(ctx, cards); err != nil {
+		return err
+	}
+
+	return uc.withAssignees(ctx, cards)
+}
+
 func (uc *todoUseCase) GetNewCards(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
 	header := "GetNewCards: "
 
@@ -480,6 +778,14 @@ func (uc *todoUseCase) GetNewCards(ctx context.Context, from, to time.Time) ([]e
 		return nil, fmt.Errorf(header+info+": %w", err)
 	}
 
+	err = requireAdmin(ctx)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
 	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (GetNewCards)", "from", from, "to", to)
 
 	cards, err := uc.cardRepo.GetNewCards(ctx, from, to)
@@ -519,15 +825,61 @@ func (uc *todoUseCase) UpdateCard(ctx context.Context, card *entity.Card) error
 		return fmt.Errorf(header+info+": %w", err)
 	}
 
+	current, err := uc.authorizeCard(ctx, card.ID, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	if card.ColumnID != uuid.Nil && card.ColumnID != current.ColumnID {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", ErrCardColumnChange.Error())
+		return fmt.Errorf(header+info+": %w", ErrCardColumnChange)
+	}
+
+	err = checkVersion(card.Version, current.Version)
+
+	if err != nil {
+		info := "Version check failed"
+		uc.log.Info(ctx, header+info, "version", card.Version, "current", current.Version)
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	card.Version = current.Version
 	card.UpdatedAt = time.Now()
 
+	updated := *current
+	updated.Title = card.Title
+	updated.Description = card.Description
+	updated.Position = card.Position
+
 	uc.log.Info(ctx, header+"Successful validation; Making request to card repo (UpdateCard)", "card", card)
 
-	if card.ColumnID == uuid.Nil {
-		err = uc.cardRepo.UpdateCard(ctx, card)
-	} else {
-		err = uc.cardRepo.MoveCard(ctx, card)
-	}
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cardRepo.UpdateCard(ctx, card); err != nil {
+			return err
+		}
+
+		boardID, err := uc.boardOfCard(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetCard, card.ID, []uuid.UUID{boardID}, fieldChanges(cardFields(current), cardFields(&updated))); err != nil {
+			return err
+		}
+
+		// Moving the card around its column leaves its content as it was
+		if updated.Title == current.Title && updated.Description == current.Description {
+			return nil
+		}
+
+		return uc.recordRevision(ctx, &updated, nil)
+	})
+
+	err = versionConflict(err)
 
 	if err != nil {
 		info := "Failed to update card"
@@ -543,9 +895,35 @@ func (uc *todoUseCase) UpdateCard(ctx context.Context, card *entity.Card) error
 func (uc *todoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
 	header := "DeleteCard: "
 
-	uc.log.Info(ctx, header+"Usecase called; Making request to card repo (DeleteCard)", "id", id)
+	uc.log.Info(ctx, header+"Usecase called; Checking access to card", "id", id)
+
+	card, err := uc.authorizeCard(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to card repo (DeleteCard)", "id", id)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		caller, err := callerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		boardID, err := uc.boardOfCard(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.cardRepo.DeleteCard(ctx, id, caller.UserID); err != nil {
+			return err
+		}
 
-	err := uc.cardRepo.DeleteCard(ctx, id)
+		return uc.recordActivity(ctx, entity.ActivityDelete, entity.ActivityTargetCard, id, []uuid.UUID{boardID}, fieldChanges(cardFields(card), nil))
+	})
 
 	if err != nil {
 		info := "Failed to delete card"
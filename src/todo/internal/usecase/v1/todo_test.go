@@ -3,8 +3,11 @@ package v1_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
+	"todo/internal/common/identity"
+	"todo/internal/common/logger"
 	"todo/internal/entity"
 	"todo/internal/usecase"
 	v1 "todo/internal/usecase/v1"
@@ -15,30 +18,124 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+type nopLogger struct{}
+
+func (l nopLogger) Debug(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Info(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Warn(ctx context.Context, msg string, fields ...interface{})  {}
+func (l nopLogger) Error(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) Fatal(ctx context.Context, msg string, fields ...interface{}) {}
+func (l nopLogger) WithFields(fields map[string]interface{}) logger.Logger       { return l }
+
+// nopTransactor runs the function without a transaction
+type nopTransactor struct{}
+
+func (nopTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 type testSetup struct {
-	ctx            context.Context
-	mockBoardRepo  *mocks.BoardRepository
-	mockColumnRepo *mocks.ColumnRepository
-	mockCardRepo   *mocks.CardRepository
-	todoUseCase    usecase.TodoUseCase
+	ctx                context.Context
+	mockBoardRepo      *mocks.BoardRepository
+	mockColumnRepo     *mocks.ColumnRepository
+	mockCardRepo       *mocks.CardRepository
+	mockShareTokenRepo *mocks.ShareTokenRepository
+	mockMemberRepo     *mocks.BoardMemberRepository
+	mockLabelRepo      *mocks.LabelRepository
+	mockChecklistRepo  *mocks.ChecklistRepository
+	mockCommentRepo    *mocks.CommentRepository
+	mockAssigneeRepo   *mocks.AssigneeRepository
+	mockAttachmentRepo *mocks.AttachmentRepository
+	mockBlobStore      *mocks.BlobStore
+	mockActivityRepo   *mocks.ActivityRepository
+	mockTrashRepo      *mocks.TrashRepository
+	mockRevisionRepo   *mocks.RevisionRepository
+	mockOutboxRepo     *mocks.OutboxRepository
+	mockBroker         *mocks.Broker
+	todoUseCase        usecase.TodoUseCase
 }
 
 func setup() *testSetup {
-	ctx := context.TODO()
+	ctx := identity.WithCaller(context.TODO(), identity.Caller{
+		UserID: uuid.New(),
+		Role:   identity.RoleAdmin,
+	})
 	mockBoardRepo := new(mocks.BoardRepository)
 	mockColumnRepo := new(mocks.ColumnRepository)
 	mockCardRepo := new(mocks.CardRepository)
-	todoUseCase := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo)
+	mockShareTokenRepo := new(mocks.ShareTokenRepository)
+	mockMemberRepo := new(mocks.BoardMemberRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockChecklistRepo := new(mocks.ChecklistRepository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockAssigneeRepo := new(mocks.AssigneeRepository)
+	mockAttachmentRepo := new(mocks.AttachmentRepository)
+	mockBlobStore := new(mocks.BlobStore)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockTrashRepo := new(mocks.TrashRepository)
+	mockRevisionRepo := new(mocks.RevisionRepository)
+	mockOutboxRepo := new(mocks.OutboxRepository)
+	mockBroker := new(mocks.Broker)
+	todoUseCase := v1.NewTodoUseCase(mockBoardRepo, mockColumnRepo, mockCardRepo, mockShareTokenRepo, mockMemberRepo, mockLabelRepo, mockChecklistRepo, mockCommentRepo, mockAssigneeRepo, mockAttachmentRepo, mockBlobStore, mockActivityRepo, mockTrashRepo, mockRevisionRepo, mockOutboxRepo, mockBroker, nopTransactor{}, nopLogger{})
+
+	// Every change is recorded; the activity, revision and outbox tests look
+	// at the entries
+	mockActivityRepo.On("CreateActivity", mock.Anything, mock.Anything).Return(nil)
+	mockRevisionRepo.On("CreateRevision", mock.Anything, mock.Anything).Return(nil)
+	mockOutboxRepo.On("CreateOutboxEvents", mock.Anything, mock.Anything).Return(nil)
 
 	return &testSetup{
-		ctx:            ctx,
-		mockBoardRepo:  mockBoardRepo,
-		mockColumnRepo: mockColumnRepo,
-		mockCardRepo:   mockCardRepo,
-		todoUseCase:    todoUseCase,
+		ctx:                ctx,
+		mockBoardRepo:      mockBoardRepo,
+		mockColumnRepo:     mockColumnRepo,
+		mockCardRepo:       mockCardRepo,
+		mockShareTokenRepo: mockShareTokenRepo,
+		mockMemberRepo:     mockMemberRepo,
+		mockLabelRepo:      mockLabelRepo,
+		mockChecklistRepo:  mockChecklistRepo,
+		mockCommentRepo:    mockCommentRepo,
+		mockAssigneeRepo:   mockAssigneeRepo,
+		mockAttachmentRepo: mockAttachmentRepo,
+		mockBlobStore:      mockBlobStore,
+		mockActivityRepo:   mockActivityRepo,
+		mockTrashRepo:      mockTrashRepo,
+		mockRevisionRepo:   mockRevisionRepo,
+		mockOutboxRepo:     mockOutboxRepo,
+		mockBroker:         mockBroker,
+		todoUseCase:        todoUseCase,
 	}
 }
 
+// mockBoardAccess lets the access check of the test caller pass for the board
+func (ts *testSetup) mockBoardAccess(boardID uuid.UUID) {
+	ts.mockBoardRepo.On("GetBoardByID", ts.ctx, boardID).Return(&entity.Board{ID: boardID}, nil)
+}
+
+func (ts *testSetup) mockColumnAccess(columnID uuid.UUID) {
+	boardID := uuid.New()
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+	ts.mockBoardAccess(boardID)
+}
+
+// mockNoCardDetails reports that none of the cards have labels, checklists
+// or assignees
+func (ts *testSetup) mockNoCardDetails() {
+	ts.mockLabelRepo.On("GetLabelsByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]entity.Label{}, nil)
+	ts.mockChecklistRepo.On("GetProgressByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID]entity.ChecklistProgress{}, nil)
+	ts.mockAssigneeRepo.On("GetAssigneesByCards", ts.ctx, mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil)
+}
+
+// mockNoCardCounts reports that none of the columns have cards
+func (ts *testSetup) mockNoCardCounts() {
+	ts.mockCardRepo.On("CountCardsByColumns", ts.ctx, mock.Anything).Return(map[uuid.UUID]int{}, nil)
+}
+
+func (ts *testSetup) mockCardAccess(cardID uuid.UUID) {
+	columnID := uuid.New()
+	ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: columnID}, nil)
+	ts.mockColumnAccess(columnID)
+}
+
 // CreateBoard(ctx context.Context, board *entity.Board) error
 func TestCreateBoard(t *testing.T) {
 	ts := setup()
@@ -68,7 +165,7 @@ func TestCreateBoard(t *testing.T) {
 			},
 			mockRepoFn: func(board *entity.Board) {},
 			wantErr:    true,
-			errMsg:     v1.ErrBoardEmptyTitle.Error(),
+			errMsg:     "CreateBoard: Validation failed: " + v1.ErrBoardEmptyTitle.Error(),
 		},
 		{
 			name: "board no user id",
@@ -77,7 +174,7 @@ func TestCreateBoard(t *testing.T) {
 			},
 			mockRepoFn: func(board *entity.Board) {},
 			wantErr:    true,
-			errMsg:     v1.ErrBoardNoUserID.Error(),
+			errMsg:     "CreateBoard: Validation failed: " + v1.ErrBoardNoUserID.Error(),
 		},
 	}
 
@@ -132,7 +229,7 @@ func TestGetBoardByID(t *testing.T) {
 				ts.mockBoardRepo.On("GetBoardByID", ts.ctx, mock.Anything).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetBoardByID.Error(),
+			errMsg:  "GetBoardByID: Failed to get board by id: ",
 		},
 	}
 
@@ -155,7 +252,7 @@ func TestGetBoardByID(t *testing.T) {
 	}
 }
 
-// GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Board, error)
+// GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit, offset int) ([]entity.Board, error)
 func TestGetBoardsByUser(t *testing.T) {
 	ts := setup()
 
@@ -204,7 +301,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			offset: 0,
 			boards: boards[0:5],
 			mockRepoFn: func(userID uuid.UUID, limit, offset int, boards []entity.Board) {
-				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, limit, offset).Return(boards, nil)
+				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, false, limit, offset).Return(boards, nil)
 			},
 			wantErr: false,
 		},
@@ -215,7 +312,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			offset: 1,
 			boards: boards[1:5],
 			mockRepoFn: func(userID uuid.UUID, limit, offset int, boards []entity.Board) {
-				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, limit, offset).Return(boards, nil)
+				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, false, limit, offset).Return(boards, nil)
 			},
 			wantErr: false,
 		},
@@ -226,7 +323,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			offset: 1,
 			boards: boards[1:3],
 			mockRepoFn: func(userID uuid.UUID, limit, offset int, boards []entity.Board) {
-				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, limit, offset).Return(boards, nil)
+				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, false, limit, offset).Return(boards, nil)
 			},
 			wantErr: false,
 		},
@@ -238,7 +335,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			boards:     boards,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, boards []entity.Board) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetBoardsByUser: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
 			name:       "negative offset",
@@ -248,7 +345,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			boards:     boards,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, boards []entity.Board) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetBoardsByUser: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
 			name:       "zero limit",
@@ -258,7 +355,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			boards:     boards,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, boards []entity.Board) {},
 			wantErr:    true,
-			errMsg:     v1.ErrZeroLimit.Error(),
+			errMsg:     "GetBoardsByUser: Validation failed: " + v1.ErrZeroLimit.Error(),
 		},
 		{
 			name:   "failed to get boards by user (not found for example)",
@@ -267,10 +364,10 @@ func TestGetBoardsByUser(t *testing.T) {
 			offset: 0,
 			boards: boards,
 			mockRepoFn: func(userID uuid.UUID, limit, offset int, boards []entity.Board) {
-				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, limit, offset).Return(nil, errors.New(""))
+				ts.mockBoardRepo.On("GetBoardsByUser", ts.ctx, userID, false, limit, offset).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetBoardsByUser.Error(),
+			errMsg:  "GetBoardsByUser: Failed to get boards by user: ",
 		},
 	}
 
@@ -280,7 +377,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			tt.mockRepoFn(tt.userID, tt.limit, tt.offset, tt.boards)
 
 			var err error
-			boards, err := ts.todoUseCase.GetBoardsByUser(ts.ctx, tt.userID, tt.limit, tt.offset)
+			boards, err := ts.todoUseCase.GetBoardsByUser(ts.ctx, tt.userID, false, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.NotNil(t, err)
@@ -288,7 +385,7 @@ func TestGetBoardsByUser(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.Equal(t, boards, tt.boards)
-				ts.mockBoardRepo.AssertCalled(t, "GetBoardsByUser", ts.ctx, mock.Anything, mock.Anything, mock.Anything)
+				ts.mockBoardRepo.AssertCalled(t, "GetBoardsByUser", ts.ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -312,6 +409,7 @@ func TestUpdateBoard(t *testing.T) {
 				Title:  "Title",
 			},
 			mockRepoFn: func(board *entity.Board) {
+				ts.mockBoardAccess(board.ID)
 				ts.mockBoardRepo.On("UpdateBoard", ts.ctx, board).Return(nil)
 			},
 			wantErr: false,
@@ -322,10 +420,11 @@ func TestUpdateBoard(t *testing.T) {
 				UserID: uuid.New(),
 			},
 			mockRepoFn: func(board *entity.Board) {
+				ts.mockBoardAccess(board.ID)
 				ts.mockBoardRepo.On("UpdateBoard", ts.ctx, board).Return(v1.ErrBoardEmptyTitle)
 			},
 			wantErr: true,
-			errMsg:  v1.ErrBoardEmptyTitle.Error(),
+			errMsg:  "UpdateBoard: Validation failed: " + v1.ErrBoardEmptyTitle.Error(),
 		},
 		{
 			name: "board no user id",
@@ -333,10 +432,11 @@ func TestUpdateBoard(t *testing.T) {
 				Title: "Title",
 			},
 			mockRepoFn: func(board *entity.Board) {
+				ts.mockBoardAccess(board.ID)
 				ts.mockBoardRepo.On("UpdateBoard", ts.ctx, board).Return(v1.ErrBoardNoUserID)
 			},
 			wantErr: true,
-			errMsg:  v1.ErrBoardNoUserID.Error(),
+			errMsg:  "UpdateBoard: Failed to update board: " + v1.ErrBoardNoUserID.Error(),
 		},
 		{
 			name: "failed to update board (not found for example)",
@@ -345,10 +445,11 @@ func TestUpdateBoard(t *testing.T) {
 				Title:  "Title",
 			},
 			mockRepoFn: func(board *entity.Board) {
+				ts.mockBoardAccess(board.ID)
 				ts.mockBoardRepo.On("UpdateBoard", ts.ctx, board).Return(v1.ErrUpdateBoard)
 			},
 			wantErr: true,
-			errMsg:  v1.ErrUpdateBoard.Error(),
+			errMsg:  "UpdateBoard: Failed to update board: " + v1.ErrUpdateBoard.Error(),
 		},
 	}
 
@@ -385,7 +486,8 @@ func TestDeleteBoard(t *testing.T) {
 			name:    "success",
 			boardID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockBoardRepo.On("DeleteBoard", ts.ctx, id).Return(nil)
+				ts.mockBoardAccess(id)
+				ts.mockBoardRepo.On("DeleteBoard", ts.ctx, id, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -393,10 +495,11 @@ func TestDeleteBoard(t *testing.T) {
 			name:    "failed to delete board (not found for example)",
 			boardID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockBoardRepo.On("DeleteBoard", ts.ctx, mock.Anything).Return(errors.New(""))
+				ts.mockBoardAccess(id)
+				ts.mockBoardRepo.On("DeleteBoard", ts.ctx, mock.Anything, mock.Anything).Return(errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrDeleteBoard.Error(),
+			errMsg:  "DeleteBoard: Failed to delete board: ",
 		},
 	}
 
@@ -412,7 +515,7 @@ func TestDeleteBoard(t *testing.T) {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
 				assert.Nil(t, err)
-				ts.mockBoardRepo.AssertCalled(t, "DeleteBoard", ts.ctx, mock.Anything)
+				ts.mockBoardRepo.AssertCalled(t, "DeleteBoard", ts.ctx, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -438,6 +541,7 @@ func TestCreateColumn(t *testing.T) {
 				Position: 0,
 			},
 			mockRepoFn: func(column *entity.Column) {
+				ts.mockBoardAccess(column.BoardID)
 				ts.mockColumnRepo.On("CreateColumn", ts.ctx, column).Return(nil)
 			},
 			wantErr: false,
@@ -452,7 +556,7 @@ func TestCreateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnEmptyTitle.Error(),
+			errMsg:     "CreateColumn: Validation failed: " + v1.ErrColumnEmptyTitle.Error(),
 		},
 		{
 			name: "column no user id",
@@ -463,7 +567,7 @@ func TestCreateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnNoUserID.Error(),
+			errMsg:     "CreateColumn: Validation failed: " + v1.ErrColumnNoUserID.Error(),
 		},
 		{
 			name: "column no board id",
@@ -474,7 +578,7 @@ func TestCreateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnNoBoardID.Error(),
+			errMsg:     "CreateColumn: Validation failed: " + v1.ErrColumnNoBoardID.Error(),
 		},
 		{
 			name: "column negative position",
@@ -486,7 +590,7 @@ func TestCreateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnNegativePosition.Error(),
+			errMsg:     "CreateColumn: Validation failed: " + v1.ErrColumnNegativePosition.Error(),
 		},
 	}
 
@@ -530,7 +634,9 @@ func TestGetColumnByID(t *testing.T) {
 				Position: 1,
 			},
 			mockRepoFn: func(column *entity.Column) {
+				ts.mockBoardAccess(column.BoardID)
 				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, column.ID).Return(column, nil)
+				ts.mockNoCardCounts()
 			},
 			wantErr: false,
 		},
@@ -547,7 +653,7 @@ func TestGetColumnByID(t *testing.T) {
 				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, mock.Anything).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetColumnByID.Error(),
+			errMsg:  "GetColumnByID: Failed to get column by id: ",
 		},
 	}
 
@@ -570,7 +676,7 @@ func TestGetColumnByID(t *testing.T) {
 	}
 }
 
-// GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit, offset int) ([]entity.Column, error)
+// GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit, offset int) ([]entity.Column, error)
 func TestGetColumnsByBoard(t *testing.T) {
 	ts := setup()
 
@@ -629,7 +735,9 @@ func TestGetColumnsByBoard(t *testing.T) {
 			offset:  0,
 			columns: columns[0:5],
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {
-				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, limit, offset).Return(columns, nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, false, limit, offset).Return(columns, nil)
+				ts.mockNoCardCounts()
 			},
 			wantErr: false,
 		},
@@ -640,7 +748,9 @@ func TestGetColumnsByBoard(t *testing.T) {
 			offset:  1,
 			columns: columns[1:5],
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {
-				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, limit, offset).Return(columns, nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, false, limit, offset).Return(columns, nil)
+				ts.mockNoCardCounts()
 			},
 			wantErr: false,
 		},
@@ -651,7 +761,9 @@ func TestGetColumnsByBoard(t *testing.T) {
 			offset:  1,
 			columns: columns[1:3],
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {
-				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, limit, offset).Return(columns, nil)
+				ts.mockBoardAccess(boardID)
+				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, false, limit, offset).Return(columns, nil)
+				ts.mockNoCardCounts()
 			},
 			wantErr: false,
 		},
@@ -663,7 +775,7 @@ func TestGetColumnsByBoard(t *testing.T) {
 			columns:    columns,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetColumnsByBoard: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
 			name:       "negative offset",
@@ -673,7 +785,7 @@ func TestGetColumnsByBoard(t *testing.T) {
 			columns:    columns,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetColumnsByBoard: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
 			name:       "zero limit",
@@ -683,7 +795,7 @@ func TestGetColumnsByBoard(t *testing.T) {
 			columns:    columns,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrZeroLimit.Error(),
+			errMsg:     "GetColumnsByBoard: Validation failed: " + v1.ErrZeroLimit.Error(),
 		},
 		{
 			name:    "failed to get columns by board (not found for example)",
@@ -692,10 +804,11 @@ func TestGetColumnsByBoard(t *testing.T) {
 			offset:  0,
 			columns: columns,
 			mockRepoFn: func(boardID uuid.UUID, limit, offset int, columns []entity.Column) {
-				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, limit, offset).Return(nil, errors.New(""))
+				ts.mockBoardAccess(boardID)
+				ts.mockColumnRepo.On("GetColumnsByBoard", ts.ctx, boardID, false, limit, offset).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetColumnsByBoard.Error(),
+			errMsg:  "GetColumnsByBoard: Failed to get columns by board: ",
 		},
 	}
 
@@ -705,7 +818,7 @@ func TestGetColumnsByBoard(t *testing.T) {
 			tt.mockRepoFn(tt.boardID, tt.limit, tt.offset, tt.columns)
 
 			var err error
-			columns, err := ts.todoUseCase.GetColumnsByBoard(ts.ctx, tt.boardID, tt.limit, tt.offset)
+			columns, err := ts.todoUseCase.GetColumnsByBoard(ts.ctx, tt.boardID, false, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.NotNil(t, err)
@@ -713,7 +826,7 @@ func TestGetColumnsByBoard(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.Equal(t, columns, tt.columns)
-				ts.mockColumnRepo.AssertCalled(t, "GetColumnsByBoard", ts.ctx, mock.Anything, mock.Anything, mock.Anything)
+				ts.mockColumnRepo.AssertCalled(t, "GetColumnsByBoard", ts.ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -740,6 +853,7 @@ func TestUpdateColumn(t *testing.T) {
 				Position: 0,
 			},
 			mockRepoFn: func(column *entity.Column) {
+				ts.mockColumnAccess(column.ID)
 				ts.mockColumnRepo.On("UpdateColumn", ts.ctx, column).Return(nil)
 			},
 			wantErr: false,
@@ -755,7 +869,7 @@ func TestUpdateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnEmptyTitle.Error(),
+			errMsg:     "UpdateColumn: Validation failed: " + v1.ErrColumnEmptyTitle.Error(),
 		},
 		{
 			name: "no user id",
@@ -765,9 +879,11 @@ func TestUpdateColumn(t *testing.T) {
 				Title:    "Title",
 				Position: 0,
 			},
-			mockRepoFn: func(column *entity.Column) {},
-			wantErr:    true,
-			errMsg:     v1.ErrColumnNoUserID.Error(),
+			mockRepoFn: func(column *entity.Column) {
+				ts.mockColumnAccess(column.ID)
+				ts.mockColumnRepo.On("UpdateColumn", ts.ctx, column).Return(nil)
+			},
+			wantErr: false,
 		},
 		{
 			name: "no board id",
@@ -777,9 +893,11 @@ func TestUpdateColumn(t *testing.T) {
 				Title:    "Title",
 				Position: 0,
 			},
-			mockRepoFn: func(column *entity.Column) {},
-			wantErr:    true,
-			errMsg:     v1.ErrColumnNoBoardID.Error(),
+			mockRepoFn: func(column *entity.Column) {
+				ts.mockColumnAccess(column.ID)
+				ts.mockColumnRepo.On("UpdateColumn", ts.ctx, column).Return(nil)
+			},
+			wantErr: false,
 		},
 		{
 			name: "negative position",
@@ -792,7 +910,7 @@ func TestUpdateColumn(t *testing.T) {
 			},
 			mockRepoFn: func(column *entity.Column) {},
 			wantErr:    true,
-			errMsg:     v1.ErrColumnNegativePosition.Error(),
+			errMsg:     "UpdateColumn: Validation failed: " + v1.ErrColumnNegativePosition.Error(),
 		},
 		{
 			name: "failed to update column (not found for example)",
@@ -804,10 +922,11 @@ func TestUpdateColumn(t *testing.T) {
 				Position: 0,
 			},
 			mockRepoFn: func(column *entity.Column) {
+				ts.mockColumnAccess(column.ID)
 				ts.mockColumnRepo.On("UpdateColumn", ts.ctx, column).Return(errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrUpdateColumn.Error(),
+			errMsg:  "UpdateColumn: Failed to update column: ",
 		},
 	}
 
@@ -844,7 +963,8 @@ func TestDeleteColumn(t *testing.T) {
 			name:     "success",
 			columnID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockColumnRepo.On("DeleteColumn", ts.ctx, id).Return(nil)
+				ts.mockColumnAccess(id)
+				ts.mockColumnRepo.On("DeleteColumn", ts.ctx, id, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -852,10 +972,11 @@ func TestDeleteColumn(t *testing.T) {
 			name:     "failed to delete column (not found for example)",
 			columnID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockColumnRepo.On("DeleteColumn", ts.ctx, id).Return(errors.New(""))
+				ts.mockColumnAccess(id)
+				ts.mockColumnRepo.On("DeleteColumn", ts.ctx, id, mock.Anything).Return(errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrDeleteColumn.Error(),
+			errMsg:  "DeleteColumn: Failed to delete column: ",
 		},
 	}
 
@@ -871,7 +992,7 @@ func TestDeleteColumn(t *testing.T) {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
 				assert.Nil(t, err)
-				ts.mockColumnRepo.AssertCalled(t, "DeleteColumn", ts.ctx, mock.Anything)
+				ts.mockColumnRepo.AssertCalled(t, "DeleteColumn", ts.ctx, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -898,6 +1019,7 @@ func TestCreateCard(t *testing.T) {
 				Position:    0,
 			},
 			mockRepoFn: func(card *entity.Card) {
+				ts.mockColumnAccess(card.ColumnID)
 				ts.mockCardRepo.On("CreateCard", ts.ctx, card).Return(nil)
 			},
 			wantErr: false,
@@ -911,7 +1033,7 @@ func TestCreateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardNoUserID.Error(),
+			errMsg:     "CreateCard: Validation failed: " + v1.ErrCardNoUserID.Error(),
 		},
 		{
 			name: "no column id",
@@ -922,7 +1044,7 @@ func TestCreateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardNoColumnID.Error(),
+			errMsg:     "CreateCard: Validation failed: " + v1.ErrCardNoColumnID.Error(),
 		},
 		{
 			name: "negative position",
@@ -934,7 +1056,7 @@ func TestCreateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardNegativePosition.Error(),
+			errMsg:     "CreateCard: Validation failed: " + v1.ErrCardNegativePosition.Error(),
 		},
 		{
 			name: "empty title",
@@ -946,7 +1068,19 @@ func TestCreateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardEmptyTitle.Error(),
+			errMsg:     "CreateCard: Validation failed: " + v1.ErrCardEmptyTitle.Error(),
+		},
+		{
+			name: "description too long",
+			card: &entity.Card{
+				UserID:      uuid.New(),
+				ColumnID:    uuid.New(),
+				Title:       "Title",
+				Description: strings.Repeat("a", 16385),
+			},
+			mockRepoFn: func(card *entity.Card) {},
+			wantErr:    true,
+			errMsg:     "CreateCard: Validation failed: " + v1.ErrCardDescriptionTooLong.Error(),
 		},
 	}
 
@@ -990,7 +1124,10 @@ func TestGetCardByID(t *testing.T) {
 				Position: 0,
 			},
 			mockRepoFn: func(card *entity.Card) {
+				ts.mockColumnAccess(card.ColumnID)
 				ts.mockCardRepo.On("GetCardByID", ts.ctx, card.ID).Return(card, nil)
+				ts.mockNoCardDetails()
+				ts.mockAttachmentRepo.On("GetAttachmentsByCard", ts.ctx, card.ID).Return([]entity.Attachment(nil), nil)
 			},
 			wantErr: false,
 		},
@@ -1007,7 +1144,7 @@ func TestGetCardByID(t *testing.T) {
 				ts.mockCardRepo.On("GetCardByID", ts.ctx, card.ID).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetCardByID.Error(),
+			errMsg:  "GetCardByID: Failed to get card by id: ",
 		},
 	}
 
@@ -1030,7 +1167,7 @@ func TestGetCardByID(t *testing.T) {
 	}
 }
 
-// GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit, offset int) ([]entity.Card, error)
+// GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit, offset int) ([]entity.Card, error)
 func TestGetCardsByColumn(t *testing.T) {
 	ts := setup()
 
@@ -1088,7 +1225,9 @@ func TestGetCardsByColumn(t *testing.T) {
 			offset:   0,
 			cards:    cards,
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {
-				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, limit, offset).Return(cards, nil)
+				ts.mockColumnAccess(columnID)
+				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, limit, offset).Return(cards, nil)
+				ts.mockNoCardDetails()
 			},
 			wantErr: false,
 		},
@@ -1099,7 +1238,9 @@ func TestGetCardsByColumn(t *testing.T) {
 			offset:   1,
 			cards:    cards[1:5],
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {
-				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, limit, offset).Return(cards, nil)
+				ts.mockColumnAccess(columnID)
+				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, limit, offset).Return(cards, nil)
+				ts.mockNoCardDetails()
 			},
 			wantErr: false,
 		},
@@ -1110,7 +1251,9 @@ func TestGetCardsByColumn(t *testing.T) {
 			offset:   1,
 			cards:    cards[1:3],
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {
-				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, limit, offset).Return(cards, nil)
+				ts.mockColumnAccess(columnID)
+				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, limit, offset).Return(cards, nil)
+				ts.mockNoCardDetails()
 			},
 			wantErr: false,
 		},
@@ -1122,7 +1265,7 @@ func TestGetCardsByColumn(t *testing.T) {
 			cards:      cards,
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetCardsByColumn: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
 			name:       "negative offset",
@@ -1132,17 +1275,17 @@ func TestGetCardsByColumn(t *testing.T) {
 			cards:      cards,
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrNegativeLimitOrOffset.Error(),
+			errMsg:     "GetCardsByColumn: Validation failed: " + v1.ErrNegativeLimitOrOffset.Error(),
 		},
 		{
-			name:       "negative offset",
+			name:       "zero limit",
 			columnID:   uuid.New(),
 			limit:      0,
 			offset:     0,
 			cards:      cards,
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrZeroLimit.Error(),
+			errMsg:     "GetCardsByColumn: Validation failed: " + v1.ErrZeroLimit.Error(),
 		},
 		{
 			name:     "failed to get cards by column (for example not found)",
@@ -1151,10 +1294,11 @@ func TestGetCardsByColumn(t *testing.T) {
 			offset:   0,
 			cards:    cards,
 			mockRepoFn: func(columnID uuid.UUID, limit, offset int, cards []entity.Card) {
-				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, limit, offset).Return(nil, errors.New(""))
+				ts.mockColumnAccess(columnID)
+				ts.mockCardRepo.On("GetCardsByColumn", ts.ctx, columnID, entity.CardFilter{}, limit, offset).Return(nil, errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrGetCardsByColumn.Error(),
+			errMsg:  "GetCardsByColumn: Failed to get cards by column: ",
 		},
 	}
 
@@ -1163,7 +1307,7 @@ func TestGetCardsByColumn(t *testing.T) {
 			t.Parallel()
 			tt.mockRepoFn(tt.columnID, tt.limit, tt.offset, tt.cards)
 
-			cards, err := ts.todoUseCase.GetCardsByColumn(ts.ctx, tt.columnID, tt.limit, tt.offset)
+			cards, err := ts.todoUseCase.GetCardsByColumn(ts.ctx, tt.columnID, entity.CardFilter{}, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.NotNil(t, err)
@@ -1171,7 +1315,7 @@ func TestGetCardsByColumn(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.Equal(t, cards, tt.cards)
-				ts.mockCardRepo.AssertCalled(t, "GetCardsByColumn", ts.ctx, mock.Anything, mock.Anything, mock.Anything)
+				ts.mockCardRepo.AssertCalled(t, "GetCardsByColumn", ts.ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -1225,7 +1369,7 @@ func TestGetNewCards(t *testing.T) {
 			cards:      cards,
 			mockRepoFn: func(from, to time.Time, cards []entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrInvalidTimeRange.Error(),
+			errMsg:     "GetNewCards: Validation failed: " + v1.ErrInvalidTimeRange.Error(),
 		},
 		{
 			name:       "success, but no cards found",
@@ -1270,44 +1414,68 @@ func TestUpdateCard(t *testing.T) {
 		mockRepoFn func(card *entity.Card)
 		wantErr    bool
 		errMsg     string
+		repoMethod string
 	}{
 		{
 			name: "success",
 			card: &entity.Card{
 				ID:       uuid.New(),
 				UserID:   uuid.New(),
-				ColumnID: uuid.New(),
 				Title:    "Title",
 				Position: 0,
 			},
 			mockRepoFn: func(card *entity.Card) {
+				ts.mockCardAccess(card.ID)
 				ts.mockCardRepo.On("UpdateCard", ts.ctx, card).Return(nil)
 			},
-			wantErr: false,
+			wantErr:    false,
+			repoMethod: "UpdateCard",
 		},
 		{
-			name: "no user id",
+			name: "success with the current column id",
 			card: &entity.Card{
 				ID:       uuid.New(),
+				UserID:   uuid.New(),
 				ColumnID: uuid.New(),
 				Title:    "Title",
 				Position: 0,
 			},
-			mockRepoFn: func(card *entity.Card) {},
-			wantErr:    true,
-			errMsg:     v1.ErrCardNoUserID.Error(),
+			mockRepoFn: func(card *entity.Card) {
+				ts.mockCardRepo.On("GetCardByID", ts.ctx, card.ID).Return(&entity.Card{ID: card.ID, ColumnID: card.ColumnID}, nil)
+				ts.mockColumnAccess(card.ColumnID)
+				ts.mockCardRepo.On("UpdateCard", ts.ctx, card).Return(nil)
+			},
+			wantErr:    false,
+			repoMethod: "UpdateCard",
 		},
 		{
-			name: "no column id",
+			name: "another column id",
 			card: &entity.Card{
 				ID:       uuid.New(),
 				UserID:   uuid.New(),
+				ColumnID: uuid.New(),
 				Title:    "Title",
 				Position: 0,
 			},
-			mockRepoFn: func(card *entity.Card) {},
-			wantErr:    true,
-			errMsg:     v1.ErrCardNoColumnID.Error(),
+			mockRepoFn: func(card *entity.Card) {
+				ts.mockCardAccess(card.ID)
+			},
+			wantErr: true,
+			errMsg:  "UpdateCard: Validation failed: " + v1.ErrCardColumnChange.Error(),
+		},
+		{
+			name: "no user id",
+			card: &entity.Card{
+				ID:       uuid.New(),
+				Title:    "Title",
+				Position: 0,
+			},
+			mockRepoFn: func(card *entity.Card) {
+				ts.mockCardAccess(card.ID)
+				ts.mockCardRepo.On("UpdateCard", ts.ctx, card).Return(nil)
+			},
+			wantErr:    false,
+			repoMethod: "UpdateCard",
 		},
 		{
 			name: "negative position",
@@ -1320,7 +1488,7 @@ func TestUpdateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardNegativePosition.Error(),
+			errMsg:     "UpdateCard: Validation failed: " + v1.ErrCardNegativePosition.Error(),
 		},
 		{
 			name: "empty title",
@@ -1333,22 +1501,35 @@ func TestUpdateCard(t *testing.T) {
 			},
 			mockRepoFn: func(card *entity.Card) {},
 			wantErr:    true,
-			errMsg:     v1.ErrCardEmptyTitle.Error(),
+			errMsg:     "UpdateCard: Validation failed: " + v1.ErrCardEmptyTitle.Error(),
+		},
+		{
+			name: "description too long",
+			card: &entity.Card{
+				ID:          uuid.New(),
+				UserID:      uuid.New(),
+				ColumnID:    uuid.New(),
+				Title:       "Title",
+				Description: strings.Repeat("a", 16385),
+			},
+			mockRepoFn: func(card *entity.Card) {},
+			wantErr:    true,
+			errMsg:     "UpdateCard: Validation failed: " + v1.ErrCardDescriptionTooLong.Error(),
 		},
 		{
 			name: "failed to update card (not found for example)",
 			card: &entity.Card{
 				ID:       uuid.New(),
 				UserID:   uuid.New(),
-				ColumnID: uuid.New(),
 				Title:    "Title",
 				Position: 0,
 			},
 			mockRepoFn: func(card *entity.Card) {
+				ts.mockCardAccess(card.ID)
 				ts.mockCardRepo.On("UpdateCard", ts.ctx, card).Return(errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrUpdateCard.Error(),
+			errMsg:  "UpdateCard: Failed to update card: ",
 		},
 	}
 
@@ -1364,7 +1545,7 @@ func TestUpdateCard(t *testing.T) {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
 				assert.Nil(t, err)
-				ts.mockCardRepo.AssertCalled(t, "UpdateCard", ts.ctx, mock.Anything)
+				ts.mockCardRepo.AssertCalled(t, tt.repoMethod, ts.ctx, tt.card)
 			}
 		})
 	}
@@ -1385,7 +1566,8 @@ func TestDeleteCard(t *testing.T) {
 			name:   "success",
 			cardID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockCardRepo.On("DeleteCard", ts.ctx, id).Return(nil)
+				ts.mockCardAccess(id)
+				ts.mockCardRepo.On("DeleteCard", ts.ctx, id, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -1393,10 +1575,11 @@ func TestDeleteCard(t *testing.T) {
 			name:   "failed to delete card (not found for example)",
 			cardID: uuid.New(),
 			mockRepoFn: func(id uuid.UUID) {
-				ts.mockCardRepo.On("DeleteCard", ts.ctx, id).Return(errors.New(""))
+				ts.mockCardAccess(id)
+				ts.mockCardRepo.On("DeleteCard", ts.ctx, id, mock.Anything).Return(errors.New(""))
 			},
 			wantErr: true,
-			errMsg:  v1.ErrDeleteCard.Error(),
+			errMsg:  "DeleteCard: Failed to delete card: ",
 		},
 	}
 
@@ -1412,7 +1595,7 @@ func TestDeleteCard(t *testing.T) {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
 				assert.Nil(t, err)
-				ts.mockCardRepo.AssertCalled(t, "DeleteCard", ts.ctx, mock.Anything)
+				ts.mockCardRepo.AssertCalled(t, "DeleteCard", ts.ctx, mock.Anything, mock.Anything)
 			}
 		})
 	}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo/internal/entity"
+	"todo/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTrashParentDeleted = errors.New("item was on a board or column that is deleted too, restore that first")
+)
+
+func (uc *todoUseCase) GetTrash(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.TrashItem, error) {
+	header := "GetTrash: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit and offset", "userID", userID, "limit", limit, "offset", offset)
+
+	err := validateLimitAndOffset(limit, offset)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = authorizeUser(ctx, userID)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Making request to trash repo (GetTrashByUser)", "userID", userID)
+
+	items, err := uc.trashRepo.GetTrashByUser(ctx, userID, limit, offset)
+
+	if err != nil {
+		info := "Failed to get trash"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Got trash", "count", len(items))
+
+	return items, nil
+}
+
+func (uc *todoUseCase) RestoreItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error) {
+	header := "RestoreItem: "
+
+	uc.log.Info(ctx, header+"Usecase called; Making request to trash repo (GetTrashItem)", "id", id)
+
+	item, err := uc.trashRepo.GetTrashItem(ctx, id)
+
+	if err != nil {
+		info := "Failed to get trash item"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = uc.authorizeRestore(ctx, item)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Restoring item", "type", item.Type, "id", id)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The parent may have been deleted since the access check
+		if err := uc.lockParent(ctx, item); err != nil {
+			return err
+		}
+
+		// A restored card counts towards the WIP limit of its column
+		over := false
+		if item.Type == entity.ActivityTargetCard {
+			column, err := uc.columnRepo.GetColumnByID(ctx, item.ParentID)
+			if err != nil {
+				return err
+			}
+
+			over, err = uc.checkWIPLimit(ctx, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := uc.trashRepo.RestoreItem(ctx, id); err != nil {
+			return err
+		}
+
+		item.OverWIPLimit = over
+
+		return uc.recordActivity(ctx, entity.ActivityRestore, item.Type, id, []uuid.UUID{item.BoardID}, nil)
+	})
+
+	if err != nil {
+		info := "Failed to restore item"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Item successfully restored")
+
+	return item, nil
+}
+
+// authorizeRestore lets the owner restore a board and the editors of the
+// board restore what was deleted from it. An item can only come back to a
+// board or column that is not in the trash itself.
+func (uc *todoUseCase) authorizeRestore(ctx context.Context, item *entity.TrashItem) error {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch item.Type {
+	case entity.ActivityTargetBoard:
+		if !caller.IsAdmin() && caller.UserID != item.UserID {
+			return ErrForbidden
+		}
+
+		return nil
+	case entity.ActivityTargetColumn:
+		_, err = uc.authorizeBoard(ctx, item.ParentID, entity.RoleEditor)
+	default:
+		_, err = uc.authorizeColumn(ctx, item.ParentID, entity.RoleEditor)
+	}
+
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrTrashParentDeleted
+	}
+
+	return err
+}
+
+func (uc *todoUseCase) lockParent(ctx context.Context, item *entity.TrashItem) error {
+	var err error
+
+	switch item.Type {
+	case entity.ActivityTargetBoard:
+		return nil
+	case entity.ActivityTargetColumn:
+		err = uc.boardRepo.LockBoard(ctx, item.ParentID)
+	default:
+		err = uc.columnRepo.LockColumn(ctx, item.ParentID)
+	}
+
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrTrashParentDeleted
+	}
+
+	return err
+}
@@ -0,0 +1,190 @@
+package v1_test
+
+import (
+	"testing"
+	"todo/internal/common/identity"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteRecordsTheCaller(t *testing.T) {
+	ts := setup()
+	caller, _ := identity.FromContext(ts.ctx)
+
+	cardID := uuid.New()
+	ts.mockCardOnBoard(cardID)
+	ts.mockCardRepo.On("DeleteCard", ts.ctx, cardID, caller.UserID).Return(nil)
+
+	err := ts.todoUseCase.DeleteCard(ts.ctx, cardID)
+
+	assert.Nil(t, err)
+	ts.mockCardRepo.AssertCalled(t, "DeleteCard", ts.ctx, cardID, caller.UserID)
+}
+
+// GetTrash(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.TrashItem, error)
+func TestGetTrash(t *testing.T) {
+	ts := setup()
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		caller     uuid.UUID
+		limit      int
+		mockRepoFn func()
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:   "success",
+			caller: userID,
+			limit:  10,
+			mockRepoFn: func() {
+				ts.mockTrashRepo.On("GetTrashByUser", mock.Anything, userID, 10, 0).Return([]entity.TrashItem{{ID: uuid.New()}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "zero limit",
+			caller:     userID,
+			limit:      0,
+			mockRepoFn: func() {},
+			wantErr:    true,
+			err:        v1.ErrZeroLimit,
+		},
+		{
+			name:       "trash of another user",
+			caller:     uuid.New(),
+			limit:      10,
+			mockRepoFn: func() {},
+			wantErr:    true,
+			err:        v1.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoFn()
+
+			items, err := ts.todoUseCase.GetTrash(userContext(tt.caller), userID, tt.limit, 0)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, items)
+			} else {
+				assert.Nil(t, err)
+				assert.Len(t, items, 1)
+			}
+		})
+	}
+}
+
+// RestoreItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error)
+func TestRestoreItem(t *testing.T) {
+	ts := setup()
+	ownerID := uuid.New()
+	owner := userContext(ownerID)
+	stranger := userContext(uuid.New())
+
+	tests := []struct {
+		name       string
+		mockRepoFn func(id uuid.UUID)
+		asStranger bool
+		wantErr    bool
+		err        error
+	}{
+		{
+			name: "board by its owner",
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockTrashRepo.On("GetTrashItem", owner, id).Return(&entity.TrashItem{ID: id, Type: entity.ActivityTargetBoard, BoardID: id, UserID: ownerID}, nil)
+				ts.mockTrashRepo.On("RestoreItem", owner, id).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "board by someone else",
+			asStranger: true,
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockTrashRepo.On("GetTrashItem", stranger, id).Return(&entity.TrashItem{ID: id, Type: entity.ActivityTargetBoard, BoardID: id, UserID: ownerID}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrForbidden,
+		},
+		{
+			name: "card of a deleted column",
+			mockRepoFn: func(id uuid.UUID) {
+				columnID := uuid.New()
+				ts.mockTrashRepo.On("GetTrashItem", owner, id).Return(&entity.TrashItem{ID: id, Type: entity.ActivityTargetCard, ParentID: columnID, UserID: ownerID}, nil)
+				ts.mockColumnRepo.On("GetColumnByID", owner, columnID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrTrashParentDeleted,
+		},
+		{
+			name: "column deleted while restoring its card",
+			mockRepoFn: func(id uuid.UUID) {
+				columnID, boardID := uuid.New(), uuid.New()
+				ts.mockTrashRepo.On("GetTrashItem", owner, id).Return(&entity.TrashItem{ID: id, Type: entity.ActivityTargetCard, ParentID: columnID, BoardID: boardID}, nil)
+				ts.mockColumnRepo.On("GetColumnByID", owner, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID}, nil)
+				ts.mockBoardRepo.On("GetBoardByID", owner, boardID).Return(&entity.Board{ID: boardID, UserID: ownerID}, nil)
+				ts.mockColumnRepo.On("LockColumn", owner, columnID).Return(repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     v1.ErrTrashParentDeleted,
+		},
+		{
+			name: "card to a column at its strict wip limit",
+			mockRepoFn: func(id uuid.UUID) {
+				columnID, boardID := uuid.New(), uuid.New()
+				column := &entity.Column{ID: columnID, BoardID: boardID, WIPLimit: entity.WIPLimit{Max: 2, Strict: true}}
+				ts.mockTrashRepo.On("GetTrashItem", owner, id).Return(&entity.TrashItem{ID: id, Type: entity.ActivityTargetCard, ParentID: columnID, BoardID: boardID}, nil)
+				ts.mockColumnRepo.On("GetColumnByID", owner, columnID).Return(column, nil)
+				ts.mockBoardRepo.On("GetBoardByID", owner, boardID).Return(&entity.Board{ID: boardID, UserID: ownerID}, nil)
+				ts.mockColumnRepo.On("LockColumn", owner, columnID).Return(nil)
+				ts.mockCardRepo.On("CountCardsByColumns", owner, []uuid.UUID{columnID}).Return(map[uuid.UUID]int{columnID: 2}, nil)
+			},
+			wantErr: true,
+			err:     v1.ErrWIPLimitReached,
+		},
+		{
+			name: "item not in the trash",
+			mockRepoFn: func(id uuid.UUID) {
+				ts.mockTrashRepo.On("GetTrashItem", owner, id).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			tt.mockRepoFn(id)
+
+			ctx := owner
+			if tt.asStranger {
+				ctx = stranger
+			}
+
+			item, err := ts.todoUseCase.RestoreItem(ctx, id)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, item)
+				ts.mockTrashRepo.AssertNotCalled(t, "RestoreItem", ctx, id)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, id, item.ID)
+				ts.mockActivityRepo.AssertCalled(t, "CreateActivity", ctx, mock.MatchedBy(func(a *entity.Activity) bool {
+					return a.TargetID == id &&
+						a.Action == entity.ActivityRestore &&
+						a.ActorID == ownerID
+				}))
+			}
+		})
+	}
+}
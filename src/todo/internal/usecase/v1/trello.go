@@ -0,0 +1,235 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+// trelloNoColor is the gray Trello shows for labels without a color
+const trelloNoColor = "#b3bac5"
+
+var ErrInvalidTrelloExport = errors.New("invalid trello board export")
+
+// trelloColors are the hex values of the Trello label colors; the _dark and
+// _light shades are imported as the plain color
+var trelloColors = map[string]string{
+	"green":  "#61bd4f",
+	"yellow": "#f2d600",
+	"orange": "#ff9f1a",
+	"red":    "#eb5a46",
+	"purple": "#c377e0",
+	"blue":   "#0079bf",
+	"sky":    "#00c2e0",
+	"lime":   "#51e898",
+	"pink":   "#ff78cb",
+	"black":  "#344563",
+}
+
+// ImportTrelloBoard creates a new board of the caller from a Trello board
+// export: lists become columns and cards keep their descriptions, dates,
+// labels and order. Archived cards, attachments, members, checklists and
+// comments are left out and counted in the summary. A dry run validates the
+// board the same way and only returns the summary.
+func (uc *todoUseCase) ImportTrelloBoard(ctx context.Context, trello *entity.TrelloBoard, dryRun bool) (*entity.ImportSummary, error) {
+	header := "ImportTrelloBoard: "
+
+	uc.log.Info(ctx, header+"Usecase called; Checking the caller", "name", trello.Name, "dryRun", dryRun)
+
+	caller, err := callerFromContext(ctx)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	now := time.Now()
+
+	export, skipped, err := fromTrello(trello, now)
+
+	if err != nil {
+		info := "Failed to read trello board"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	err = prepareImport(export, caller.UserID, now)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	summary := &entity.ImportSummary{
+		Board:   export.Board,
+		DryRun:  dryRun,
+		Labels:  len(export.Labels),
+		Columns: len(export.Columns),
+		Skipped: skipped,
+	}
+
+	for _, tree := range export.Columns {
+		summary.Cards += len(tree.Cards)
+	}
+
+	if dryRun {
+		uc.log.Info(ctx, header+"Dry run; Nothing written", "summary", summary)
+		return summary, nil
+	}
+
+	uc.log.Info(ctx, header+"Successful validation; Writing board", "board", export.Board)
+
+	err = uc.writeImport(ctx, export)
+
+	if err != nil {
+		info := "Failed to import board"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"Board successfully imported", "id", export.Board.ID, "summary", summary)
+
+	return summary, nil
+}
+
+// fromTrello turns a Trello board into an export of this service. Labels
+// that end up with the same name are merged, since names are unique on a
+// board here.
+func fromTrello(trello *entity.TrelloBoard, now time.Time) (*entity.BoardExport, entity.ImportSkipped, error) {
+	skipped := entity.ImportSkipped{
+		Members:  trello.Members,
+		Comments: trello.Comments,
+	}
+
+	export := &entity.BoardExport{
+		Version: entity.BoardExportVersion,
+		Board:   entity.Board{Title: trello.Name},
+	}
+
+	if trello.Closed {
+		export.Board.ArchivedAt = &now
+	}
+
+	labelIDs := make(map[string]uuid.UUID, len(trello.Labels))
+	labelsByName := make(map[string]uuid.UUID, len(trello.Labels))
+
+	for _, trelloLabel := range trello.Labels {
+		name := trelloLabelName(trelloLabel)
+
+		if id, ok := labelsByName[strings.ToLower(name)]; ok {
+			labelIDs[trelloLabel.ID] = id
+			continue
+		}
+
+		label := entity.Label{
+			ID:    uuid.New(),
+			Name:  name,
+			Color: trelloLabelColor(trelloLabel.Color),
+		}
+
+		labelIDs[trelloLabel.ID] = label.ID
+		labelsByName[strings.ToLower(name)] = label.ID
+		export.Labels = append(export.Labels, label)
+	}
+
+	columns := make(map[string]int, len(trello.Lists))
+
+	for _, list := range trello.Lists {
+		if _, ok := columns[list.ID]; ok {
+			return nil, skipped, fmt.Errorf("%w: list %s appears twice", ErrInvalidTrelloExport, list.ID)
+		}
+
+		column := entity.Column{
+			Title:    list.Name,
+			Position: list.Pos,
+		}
+
+		if list.Closed {
+			column.ArchivedAt = &now
+		}
+
+		columns[list.ID] = len(export.Columns)
+		export.Columns = append(export.Columns, entity.ColumnTree{Column: column})
+	}
+
+	for _, trelloCard := range trello.Cards {
+		// Cards cannot be archived here, so archived cards are left out
+		// with everything on them
+		if trelloCard.Closed {
+			skipped.ArchivedCards++
+			continue
+		}
+
+		i, ok := columns[trelloCard.ListID]
+		if !ok {
+			return nil, skipped, fmt.Errorf("%w: card %s is on an unknown list", ErrInvalidTrelloExport, trelloCard.ID)
+		}
+
+		card := entity.Card{
+			Title:       trelloCard.Name,
+			Description: trelloCard.Desc,
+			Position:    trelloCard.Pos,
+			StartDate:   trelloCard.Start,
+			DueDate:     trelloCard.Due,
+		}
+
+		attached := make(map[uuid.UUID]bool, len(trelloCard.LabelIDs))
+
+		for _, trelloLabelID := range trelloCard.LabelIDs {
+			id, ok := labelIDs[trelloLabelID]
+			if !ok {
+				return nil, skipped, fmt.Errorf("%w: card %s has an unknown label", ErrInvalidTrelloExport, trelloCard.ID)
+			}
+
+			if !attached[id] {
+				attached[id] = true
+				card.Labels = append(card.Labels, entity.Label{ID: id})
+			}
+		}
+
+		skipped.Attachments += trelloCard.Attachments
+		skipped.CardMembers += len(trelloCard.MemberIDs)
+		skipped.Checklists += trelloCard.Checklists
+
+		export.Columns[i].Cards = append(export.Columns[i].Cards, card)
+	}
+
+	return export, skipped, nil
+}
+
+// trelloLabelName names the labels Trello leaves unnamed after their color
+// and cuts long names to the length allowed here
+func trelloLabelName(label entity.TrelloLabel) string {
+	name := strings.TrimSpace(label.Name)
+
+	if name == "" {
+		name = label.Color
+	}
+
+	if name == "" {
+		name = "no color"
+	}
+
+	if runes := []rune(name); len(runes) > maxLabelNameLength {
+		name = string(runes[:maxLabelNameLength])
+	}
+
+	return name
+}
+
+func trelloLabelColor(color string) string {
+	color = strings.TrimSuffix(strings.TrimSuffix(color, "_dark"), "_light")
+
+	if hex, ok := trelloColors[color]; ok {
+		return hex
+	}
+
+	return trelloNoColor
+}
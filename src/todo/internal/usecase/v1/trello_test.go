@@ -0,0 +1,156 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+	"todo/internal/entity"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func trelloBoard() *entity.TrelloBoard {
+	due := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	return &entity.TrelloBoard{
+		Name: "Roadmap",
+		Labels: []entity.TrelloLabel{
+			{ID: "l1", Name: "Bug", Color: "red"},
+			{ID: "l2", Name: "bug", Color: "orange"},
+			{ID: "l3", Color: "sky_dark"},
+			{ID: "l4", Name: "Later"},
+		},
+		Lists: []entity.TrelloList{
+			{ID: "todo", Name: "To do", Pos: 16384},
+			{ID: "old", Name: "Old", Pos: 32768, Closed: true},
+		},
+		Cards: []entity.TrelloCard{
+			{ID: "c1", ListID: "todo", Name: "Fix login", Desc: "Steps to reproduce", Pos: 65535, Due: &due, LabelIDs: []string{"l1", "l2", "l3"}, MemberIDs: []string{"m1"}, Checklists: 1, Attachments: 2},
+			{ID: "c2", ListID: "old", Name: "Ship v1", Pos: 16384},
+			{ID: "c3", ListID: "todo", Name: "Archived", Pos: 1024, Closed: true, Attachments: 5},
+		},
+		Members:  3,
+		Comments: 4,
+	}
+}
+
+// ImportTrelloBoard(ctx context.Context, trello *entity.TrelloBoard, dryRun bool) (*entity.ImportSummary, error)
+func TestImportTrelloBoard(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ts := setup()
+
+		var labels []*entity.Label
+		var columns []*entity.Column
+		var cards []*entity.Card
+		ts.mockBoardRepo.On("CreateBoard", ts.ctx, mock.Anything).Return(nil)
+		ts.mockLabelRepo.On("CreateLabel", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { labels = append(labels, args.Get(1).(*entity.Label)) }).Return(nil)
+		ts.mockColumnRepo.On("CreateColumn", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { columns = append(columns, args.Get(1).(*entity.Column)) }).Return(nil)
+		ts.mockColumnRepo.On("UpdateColumnArchived", ts.ctx, mock.Anything).Return(nil)
+		ts.mockCardRepo.On("CreateCard", ts.ctx, mock.Anything).Run(func(args mock.Arguments) { cards = append(cards, args.Get(1).(*entity.Card)) }).Return(nil)
+		ts.mockLabelRepo.On("AttachLabel", ts.ctx, mock.Anything, mock.Anything).Return(nil)
+
+		summary, err := ts.todoUseCase.ImportTrelloBoard(ts.ctx, trelloBoard(), false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Roadmap", summary.Board.Title)
+		assert.False(t, summary.DryRun)
+		assert.Equal(t, 3, summary.Labels)
+		assert.Equal(t, 2, summary.Columns)
+		assert.Equal(t, 2, summary.Cards)
+		assert.Equal(t, entity.ImportSkipped{ArchivedCards: 1, Attachments: 2, Members: 3, CardMembers: 1, Checklists: 1, Comments: 4}, summary.Skipped)
+
+		// Labels named alike are merged, unnamed ones are named after their color
+		if assert.Len(t, labels, 3) {
+			assert.Equal(t, "Bug", labels[0].Name)
+			assert.Equal(t, "#eb5a46", labels[0].Color)
+			assert.Equal(t, "sky_dark", labels[1].Name)
+			assert.Equal(t, "#00c2e0", labels[1].Color)
+			assert.Equal(t, "Later", labels[2].Name)
+			assert.Equal(t, "#b3bac5", labels[2].Color)
+		}
+
+		if assert.Len(t, columns, 2) {
+			assert.Equal(t, "To do", columns[0].Title)
+			assert.Equal(t, float64(16384), columns[0].Position)
+			assert.Nil(t, columns[0].ArchivedAt)
+			assert.NotNil(t, columns[1].ArchivedAt)
+		}
+		ts.mockColumnRepo.AssertNumberOfCalls(t, "UpdateColumnArchived", 1)
+
+		if assert.Len(t, cards, 2) {
+			assert.Equal(t, columns[0].ID, cards[0].ColumnID)
+			assert.Equal(t, "Steps to reproduce", cards[0].Description)
+			assert.Equal(t, float64(65535), cards[0].Position)
+			assert.NotNil(t, cards[0].DueDate)
+			assert.Equal(t, columns[1].ID, cards[1].ColumnID)
+		}
+
+		ts.mockLabelRepo.AssertNumberOfCalls(t, "AttachLabel", 2)
+		ts.mockLabelRepo.AssertCalled(t, "AttachLabel", ts.ctx, cards[0].ID, labels[0].ID)
+		ts.mockLabelRepo.AssertCalled(t, "AttachLabel", ts.ctx, cards[0].ID, labels[1].ID)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ts := setup()
+
+		summary, err := ts.todoUseCase.ImportTrelloBoard(ts.ctx, trelloBoard(), true)
+
+		assert.Nil(t, err)
+		assert.True(t, summary.DryRun)
+		assert.Equal(t, 2, summary.Cards)
+		assert.Equal(t, 1, summary.Skipped.ArchivedCards)
+		ts.mockBoardRepo.AssertNotCalled(t, "CreateBoard", mock.Anything, mock.Anything)
+	})
+
+	invalid := []struct {
+		name   string
+		change func(trello *entity.TrelloBoard)
+		err    error
+	}{
+		{
+			name:   "card on an unknown list",
+			change: func(trello *entity.TrelloBoard) { trello.Cards[0].ListID = "gone" },
+			err:    v1.ErrInvalidTrelloExport,
+		},
+		{
+			name:   "card with an unknown label",
+			change: func(trello *entity.TrelloBoard) { trello.Cards[0].LabelIDs = []string{"gone"} },
+			err:    v1.ErrInvalidTrelloExport,
+		},
+		{
+			name:   "list appears twice",
+			change: func(trello *entity.TrelloBoard) { trello.Lists[1].ID = "todo" },
+			err:    v1.ErrInvalidTrelloExport,
+		},
+		{
+			name:   "list without a name",
+			change: func(trello *entity.TrelloBoard) { trello.Lists[0].Name = "" },
+			err:    v1.ErrColumnEmptyTitle,
+		},
+		{
+			name: "card starting after it is due",
+			change: func(trello *entity.TrelloBoard) {
+				start := trello.Cards[0].Due.Add(time.Hour)
+				trello.Cards[0].Start = &start
+			},
+			err: v1.ErrCardStartAfterDue,
+		},
+	}
+
+	for _, tt := range invalid {
+		for _, dryRun := range []bool{false, true} {
+			t.Run(tt.name, func(t *testing.T) {
+				ts := setup()
+				trello := trelloBoard()
+				tt.change(trello)
+
+				summary, err := ts.todoUseCase.ImportTrelloBoard(ts.ctx, trello, dryRun)
+
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, summary)
+				ts.mockBoardRepo.AssertNotCalled(t, "CreateBoard", mock.Anything, mock.Anything)
+			})
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"todo/internal/repository"
+)
+
+var ErrVersionConflict = fmt.Errorf("item was changed in the meantime: %w", repository.ErrVersionConflict)
+
+// checkVersion compares the version a change was based on with the current
+// one. Zero means that the change was not based on any version and is made
+// on top of the current one.
+func checkVersion(expected, current int) error {
+	if expected != 0 && expected != current {
+		return ErrVersionConflict
+	}
+
+	return nil
+}
+
+// versionConflict turns a conditional update that found the row at another
+// version into ErrVersionConflict
+func versionConflict(err error) error {
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return ErrVersionConflict
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+package v1_test
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateBoardVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		repoErr error
+		wantErr error
+	}{
+		{name: "current version", version: 3},
+		{name: "no version", version: 0},
+		{name: "outdated version", version: 2, wantErr: v1.ErrVersionConflict},
+		{name: "changed during the update", version: 3, repoErr: repository.ErrVersionConflict, wantErr: v1.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := setup()
+			board := &entity.Board{ID: uuid.New(), Title: "Title", Version: tt.version}
+
+			ts.mockBoardRepo.On("GetBoardByID", ts.ctx, board.ID).Return(&entity.Board{ID: board.ID, Version: 3}, nil)
+			ts.mockBoardRepo.On("UpdateBoard", ts.ctx, mock.Anything).Return(tt.repoErr)
+
+			err := ts.todoUseCase.UpdateBoard(ts.ctx, board)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if tt.version != 3 && tt.version != 0 {
+				ts.mockBoardRepo.AssertNotCalled(t, "UpdateBoard", mock.Anything, mock.Anything)
+			} else {
+				// The update is made on top of the version checked
+				ts.mockBoardRepo.AssertCalled(t, "UpdateBoard", ts.ctx, mock.MatchedBy(func(b *entity.Board) bool { return b.Version == 3 }))
+			}
+		})
+	}
+}
+
+func TestUpdateColumnVersion(t *testing.T) {
+	ts := setup()
+	column := &entity.Column{ID: uuid.New(), Title: "Title", Version: 1}
+
+	boardID := uuid.New()
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, column.ID).Return(&entity.Column{ID: column.ID, BoardID: boardID, Version: 2}, nil)
+	ts.mockBoardAccess(boardID)
+
+	err := ts.todoUseCase.UpdateColumn(ts.ctx, column)
+
+	assert.ErrorIs(t, err, v1.ErrVersionConflict)
+	ts.mockColumnRepo.AssertNotCalled(t, "UpdateColumn", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCardVersion(t *testing.T) {
+	t.Run("outdated version", func(t *testing.T) {
+		ts := setup()
+		card := &entity.Card{ID: uuid.New(), Title: "Title", Version: 4}
+
+		columnID := uuid.New()
+		ts.mockCardRepo.On("GetCardByID", ts.ctx, card.ID).Return(&entity.Card{ID: card.ID, ColumnID: columnID, Version: 5}, nil)
+		ts.mockColumnAccess(columnID)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, card)
+
+		assert.ErrorIs(t, err, v1.ErrVersionConflict)
+		ts.mockCardRepo.AssertNotCalled(t, "UpdateCard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("changed during the update", func(t *testing.T) {
+		ts := setup()
+		card := &entity.Card{ID: uuid.New(), Title: "Title", Version: 5}
+
+		columnID := uuid.New()
+		ts.mockCardRepo.On("GetCardByID", ts.ctx, card.ID).Return(&entity.Card{ID: card.ID, ColumnID: columnID, Version: 5}, nil)
+		ts.mockColumnAccess(columnID)
+		ts.mockCardRepo.On("UpdateCard", ts.ctx, card).Return(repository.ErrVersionConflict)
+
+		err := ts.todoUseCase.UpdateCard(ts.ctx, card)
+
+		assert.ErrorIs(t, err, v1.ErrVersionConflict)
+	})
+}
@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWIPLimitNegative = errors.New("wip limit cannot be negative")
+	ErrWIPLimitReached  = errors.New("column is at its wip limit")
+)
+
+func validateWIPLimit(limit entity.WIPLimit) error {
+	if limit.Max < 0 {
+		return ErrWIPLimitNegative
+	}
+
+	return nil
+}
+
+// SetColumnWIPLimit replaces the WIP limit of the column; cards already in
+// the column stay even when there are more of them than the new limit
+func (uc *todoUseCase) SetColumnWIPLimit(ctx context.Context, id uuid.UUID, limit entity.WIPLimit) (*entity.Column, error) {
+	header := "SetColumnWIPLimit: "
+
+	uc.log.Info(ctx, header+"Usecase called; Validating limit", "id", id, "limit", limit)
+
+	err := validateWIPLimit(limit)
+
+	if err != nil {
+		info := "Validation failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	column, err := uc.authorizeColumn(ctx, id, entity.RoleEditor)
+
+	if err != nil {
+		info := "Access check failed"
+		uc.log.Info(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	before := columnFields(column)
+
+	column.WIPLimit = limit
+	column.UpdatedAt = time.Now()
+
+	uc.log.Info(ctx, header+"Making request to column repo (SetColumnWIPLimit)", "column", column)
+
+	err = uc.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.columnRepo.SetColumnWIPLimit(ctx, column); err != nil {
+			return err
+		}
+
+		return uc.recordActivity(ctx, entity.ActivityUpdate, entity.ActivityTargetColumn, column.ID, []uuid.UUID{column.BoardID}, fieldChanges(before, columnFields(column)))
+	})
+
+	if err != nil {
+		info := "Failed to set wip limit"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	columns := []entity.Column{*column}
+	err = uc.withCardCounts(ctx, columns)
+
+	if err != nil {
+		info := "Failed to count cards"
+		uc.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	uc.log.Info(ctx, header+"WIP limit successfully set")
+
+	return &columns[0], nil
+}
+
+// checkWIPLimit tells whether one more card would take the column past its
+// WIP limit. It fails with ErrWIPLimitReached when the limit is strict. The
+// column has to be locked by the caller so that concurrent cards are counted.
+func (uc *todoUseCase) checkWIPLimit(ctx context.Context, column *entity.Column) (bool, error) {
+	if column.WIPLimit.Max == 0 {
+		return false, nil
+	}
+
+	counts, err := uc.cardRepo.CountCardsByColumns(ctx, []uuid.UUID{column.ID})
+	if err != nil {
+		return false, err
+	}
+
+	if counts[column.ID] < column.WIPLimit.Max {
+		return false, nil
+	}
+
+	if column.WIPLimit.Strict {
+		return false, fmt.Errorf("%w of %d cards", ErrWIPLimitReached, column.WIPLimit.Max)
+	}
+
+	return true, nil
+}
+
+// withCardCounts fills in the number of cards of the columns
+func (uc *todoUseCase) withCardCounts(ctx context.Context, columns []entity.Column) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(columns))
+	for i, column := range columns {
+		ids[i] = column.ID
+	}
+
+	counts, err := uc.cardRepo.CountCardsByColumns(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range columns {
+		columns[i].CardCount = counts[columns[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package v1_test
+
+import (
+	"testing"
+	"todo/internal/entity"
+	"todo/internal/repository"
+	v1 "todo/internal/usecase/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockLimitedColumn lets the access check pass for a column with the given
+// limit that holds count cards
+func (ts *testSetup) mockLimitedColumn(columnID uuid.UUID, limit entity.WIPLimit, count int) {
+	boardID := uuid.New()
+	ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(&entity.Column{ID: columnID, BoardID: boardID, WIPLimit: limit}, nil)
+	ts.mockBoardAccess(boardID)
+	ts.mockColumnRepo.On("LockColumn", ts.ctx, columnID).Return(nil)
+	ts.mockCardRepo.On("CountCardsByColumns", ts.ctx, []uuid.UUID{columnID}).Return(map[uuid.UUID]int{columnID: count}, nil)
+}
+
+// SetColumnWIPLimit(ctx context.Context, id uuid.UUID, limit entity.WIPLimit) (*entity.Column, error)
+func TestSetColumnWIPLimit(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name       string
+		limit      entity.WIPLimit
+		mockRepoFn func(columnID uuid.UUID)
+		wantErr    bool
+		err        error
+	}{
+		{
+			name:  "success",
+			limit: entity.WIPLimit{Max: 3, Strict: true},
+			mockRepoFn: func(columnID uuid.UUID) {
+				ts.mockLimitedColumn(columnID, entity.WIPLimit{}, 5)
+				ts.mockColumnRepo.On("SetColumnWIPLimit", ts.ctx, mock.MatchedBy(func(c *entity.Column) bool {
+					return c.ID == columnID
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "lift the limit",
+			limit: entity.WIPLimit{},
+			mockRepoFn: func(columnID uuid.UUID) {
+				ts.mockLimitedColumn(columnID, entity.WIPLimit{Max: 2}, 5)
+				ts.mockColumnRepo.On("SetColumnWIPLimit", ts.ctx, mock.MatchedBy(func(c *entity.Column) bool {
+					return c.ID == columnID
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "negative limit",
+			limit:      entity.WIPLimit{Max: -1},
+			mockRepoFn: func(columnID uuid.UUID) {},
+			wantErr:    true,
+			err:        v1.ErrWIPLimitNegative,
+		},
+		{
+			name:  "column not found",
+			limit: entity.WIPLimit{Max: 3},
+			mockRepoFn: func(columnID uuid.UUID) {
+				ts.mockColumnRepo.On("GetColumnByID", ts.ctx, columnID).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: true,
+			err:     repository.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			columnID := uuid.New()
+			tt.mockRepoFn(columnID)
+
+			column, err := ts.todoUseCase.SetColumnWIPLimit(ts.ctx, columnID, tt.limit)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, column)
+				ts.mockColumnRepo.AssertNotCalled(t, "SetColumnWIPLimit", ts.ctx, mock.MatchedBy(func(c *entity.Column) bool {
+					return c.ID == columnID
+				}))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.limit, column.WIPLimit)
+			// Cards over the new limit stay where they are
+			assert.Equal(t, 5, column.CardCount)
+		})
+	}
+}
+
+func TestCreateCardWIPLimit(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name     string
+		limit    entity.WIPLimit
+		count    int
+		wantErr  bool
+		wantOver bool
+	}{
+		{
+			name:  "under the limit",
+			limit: entity.WIPLimit{Max: 3, Strict: true},
+			count: 2,
+		},
+		{
+			name:     "soft limit reached",
+			limit:    entity.WIPLimit{Max: 3},
+			count:    3,
+			wantOver: true,
+		},
+		{
+			name:    "strict limit reached",
+			limit:   entity.WIPLimit{Max: 3, Strict: true},
+			count:   3,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			columnID := uuid.New()
+			ts.mockLimitedColumn(columnID, tt.limit, tt.count)
+			ts.mockCardRepo.On("CreateCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+				return c.ColumnID == columnID
+			})).Return(nil)
+
+			card := &entity.Card{UserID: uuid.New(), ColumnID: columnID, Title: "Title"}
+			err := ts.todoUseCase.CreateCard(ts.ctx, card)
+
+			ts.mockColumnRepo.AssertCalled(t, "LockColumn", ts.ctx, columnID)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, v1.ErrWIPLimitReached)
+				ts.mockCardRepo.AssertNotCalled(t, "CreateCard", ts.ctx, card)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantOver, card.OverWIPLimit)
+		})
+	}
+}
+
+func TestMoveCardWIPLimit(t *testing.T) {
+	ts := setup()
+
+	tests := []struct {
+		name     string
+		limit    entity.WIPLimit
+		wantErr  bool
+		wantOver bool
+	}{
+		{
+			name:     "soft limit reached",
+			limit:    entity.WIPLimit{Max: 1},
+			wantOver: true,
+		},
+		{
+			name:    "strict limit reached",
+			limit:   entity.WIPLimit{Max: 1, Strict: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cardID, sourceID, targetID := uuid.New(), uuid.New(), uuid.New()
+			ts.mockCardRepo.On("GetCardByID", ts.ctx, cardID).Return(&entity.Card{ID: cardID, ColumnID: sourceID}, nil)
+			ts.mockColumnAccess(sourceID)
+			ts.mockColumnRepo.On("LockColumn", ts.ctx, sourceID).Return(nil)
+			ts.mockLimitedColumn(targetID, tt.limit, 1)
+			ts.mockCardRepo.On("GetCardPositions", ts.ctx, targetID).Return([]entity.Position{{ID: uuid.New(), Position: 1024}}, nil)
+			ts.mockCardRepo.On("MoveCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+				return c.ID == cardID
+			})).Return(nil)
+			ts.mockLabelRepo.On("DetachForeignLabels", ts.ctx, cardID, mock.Anything).Return(nil)
+
+			card, err := ts.todoUseCase.MoveCard(ts.ctx, cardID, entity.CardMove{ColumnID: targetID})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, v1.ErrWIPLimitReached)
+				assert.Nil(t, card)
+				ts.mockCardRepo.AssertNotCalled(t, "MoveCard", ts.ctx, mock.MatchedBy(func(c *entity.Card) bool {
+					return c.ID == cardID
+				}))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, targetID, card.ColumnID)
+			assert.Equal(t, tt.wantOver, card.OverWIPLimit)
+		})
+	}
+}
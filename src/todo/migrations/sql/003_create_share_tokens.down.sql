@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS share_tokens;
+
+ALTER TABLE boards DROP COLUMN IF EXISTS is_public;
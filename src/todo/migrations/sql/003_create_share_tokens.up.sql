@@ -0,0 +1,9 @@
+ALTER TABLE boards ADD COLUMN is_public BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE share_tokens (
+    token VARCHAR(64) PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_share_tokens_board_id ON share_tokens(board_id);
@@ -0,0 +1,12 @@
+CREATE TABLE board_members (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    accepted BOOLEAN NOT NULL DEFAULT FALSE,
+    invited_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (board_id, user_id)
+);
+
+CREATE INDEX idx_board_members_user_id ON board_members(user_id);
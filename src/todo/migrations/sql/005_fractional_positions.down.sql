@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_cards_column_position;
+DROP INDEX IF EXISTS idx_columns_board_position;
+
+ALTER TABLE cards ALTER COLUMN position TYPE REAL;
+ALTER TABLE columns ALTER COLUMN position TYPE REAL;
@@ -0,0 +1,5 @@
+ALTER TABLE columns ALTER COLUMN position TYPE DOUBLE PRECISION;
+ALTER TABLE cards ALTER COLUMN position TYPE DOUBLE PRECISION;
+
+CREATE INDEX idx_columns_board_position ON columns(board_id, position);
+CREATE INDEX idx_cards_column_position ON cards(column_id, position);
@@ -0,0 +1,18 @@
+CREATE TABLE labels (
+    id UUID PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    color CHAR(7) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_labels_board_id_name ON labels(board_id, LOWER(name));
+
+CREATE TABLE card_labels (
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    label_id UUID NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    PRIMARY KEY (card_id, label_id)
+);
+
+CREATE INDEX idx_card_labels_label_id ON card_labels(label_id);
@@ -0,0 +1,22 @@
+CREATE TABLE checklists (
+    id UUID PRIMARY KEY,
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    title VARCHAR(128) NOT NULL,
+    position DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_checklists_card_position ON checklists(card_id, position);
+
+CREATE TABLE checklist_items (
+    id UUID PRIMARY KEY,
+    checklist_id UUID NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    text VARCHAR(512) NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    position DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_checklist_items_checklist_position ON checklist_items(checklist_id, position);
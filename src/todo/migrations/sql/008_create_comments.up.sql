@@ -0,0 +1,10 @@
+CREATE TABLE comments (
+    id UUID PRIMARY KEY,
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP
+);
+
+CREATE INDEX idx_comments_card_created_at ON comments(card_id, created_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_cards_due_date;
+
+ALTER TABLE cards DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS due_date;
+ALTER TABLE cards DROP COLUMN IF EXISTS start_date;
@@ -0,0 +1,5 @@
+ALTER TABLE cards ADD COLUMN start_date TIMESTAMP;
+ALTER TABLE cards ADD COLUMN due_date TIMESTAMP;
+ALTER TABLE cards ADD COLUMN reminded_at TIMESTAMP;
+
+CREATE INDEX idx_cards_due_date ON cards(due_date) WHERE due_date IS NOT NULL;
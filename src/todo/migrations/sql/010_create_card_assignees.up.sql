@@ -0,0 +1,8 @@
+CREATE TABLE card_assignees (
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (card_id, user_id)
+);
+
+CREATE INDEX idx_card_assignees_user_id ON card_assignees(user_id);
@@ -0,0 +1,12 @@
+CREATE TABLE attachments (
+    id UUID PRIMARY KEY,
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    uploader_id UUID NOT NULL,
+    filename TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_attachments_card_created_at ON attachments(card_id, created_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS activities;
+DROP FUNCTION IF EXISTS reject_activity_change();
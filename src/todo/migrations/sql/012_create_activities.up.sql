@@ -0,0 +1,24 @@
+CREATE TABLE activities (
+    id UUID PRIMARY KEY,
+    -- no foreign keys: entries outlive the boards, columns and cards they describe
+    board_ids UUID[] NOT NULL,
+    target_type VARCHAR(16) NOT NULL,
+    target_id UUID NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    actor_id UUID NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_activities_board_ids ON activities USING GIN (board_ids);
+CREATE INDEX idx_activities_target_created_at ON activities(target_id, created_at);
+
+CREATE FUNCTION reject_activity_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'activities are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER activities_append_only
+    BEFORE UPDATE OR DELETE ON activities
+    FOR EACH ROW EXECUTE FUNCTION reject_activity_change();
@@ -0,0 +1,19 @@
+DELETE FROM cards WHERE deleted_at IS NOT NULL;
+DELETE FROM columns WHERE deleted_at IS NOT NULL;
+DELETE FROM boards WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_cards_deleted_with;
+DROP INDEX IF EXISTS idx_columns_deleted_with;
+DROP INDEX IF EXISTS idx_boards_deleted_with;
+
+ALTER TABLE cards DROP COLUMN IF EXISTS deleted_with;
+ALTER TABLE cards DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE cards DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE columns DROP COLUMN IF EXISTS deleted_with;
+ALTER TABLE columns DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE columns DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE boards DROP COLUMN IF EXISTS deleted_with;
+ALTER TABLE boards DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE boards DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,18 @@
+-- Deleted boards, columns and cards stay in the trash until they are
+-- restored or purged. Everything deleted in one go shares deleted_with, the
+-- id of the item that was deleted, so that it can be restored together.
+ALTER TABLE boards ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE boards ADD COLUMN deleted_by UUID;
+ALTER TABLE boards ADD COLUMN deleted_with UUID;
+
+ALTER TABLE columns ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE columns ADD COLUMN deleted_by UUID;
+ALTER TABLE columns ADD COLUMN deleted_with UUID;
+
+ALTER TABLE cards ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE cards ADD COLUMN deleted_by UUID;
+ALTER TABLE cards ADD COLUMN deleted_with UUID;
+
+CREATE INDEX idx_boards_deleted_with ON boards(deleted_with) WHERE deleted_with IS NOT NULL;
+CREATE INDEX idx_columns_deleted_with ON columns(deleted_with) WHERE deleted_with IS NOT NULL;
+CREATE INDEX idx_cards_deleted_with ON cards(deleted_with) WHERE deleted_with IS NOT NULL;
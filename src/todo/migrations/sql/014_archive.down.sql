@@ -0,0 +1,2 @@
+ALTER TABLE columns DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE boards DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,4 @@
+-- Archived boards and columns are hidden from the default listings and
+-- read-only until they are unarchived
+ALTER TABLE boards ADD COLUMN archived_at TIMESTAMP;
+ALTER TABLE columns ADD COLUMN archived_at TIMESTAMP;
@@ -0,0 +1,3 @@
+-- Templates are boards that new boards are cloned from; they are kept out of
+-- the board listings and the card views
+ALTER TABLE boards ADD COLUMN is_template BOOLEAN NOT NULL DEFAULT FALSE;
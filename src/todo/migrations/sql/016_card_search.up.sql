@@ -0,0 +1,8 @@
+-- Full-text search over the titles and descriptions of cards. Titles weigh
+-- more than descriptions when results are ranked. The 'simple' configuration
+-- does no stemming, so the search works the same for any language. The
+-- search queries must use the very same expression to hit the index.
+CREATE INDEX idx_cards_search ON cards USING GIN ((
+    setweight(to_tsvector('simple', title), 'A') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+));
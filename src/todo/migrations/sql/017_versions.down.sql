@@ -0,0 +1,3 @@
+ALTER TABLE cards DROP COLUMN IF EXISTS version;
+ALTER TABLE columns DROP COLUMN IF EXISTS version;
+ALTER TABLE boards DROP COLUMN IF EXISTS version;
@@ -0,0 +1,5 @@
+-- Every change to a board, column or card bumps its version so that clients
+-- can make their updates conditional on the version they read
+ALTER TABLE boards ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE columns ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE cards ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
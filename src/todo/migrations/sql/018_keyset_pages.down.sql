@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_boards_user_created_at;
+DROP INDEX IF EXISTS idx_cards_column_position;
+DROP INDEX IF EXISTS idx_columns_board_position;
+CREATE INDEX idx_columns_board_position ON columns(board_id, position);
+CREATE INDEX idx_cards_column_position ON cards(column_id, position);
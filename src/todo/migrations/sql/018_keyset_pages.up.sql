@@ -0,0 +1,7 @@
+-- Pages of the listings start after the last item of the previous page, so
+-- the indexes cover the whole order of each listing
+DROP INDEX IF EXISTS idx_columns_board_position;
+DROP INDEX IF EXISTS idx_cards_column_position;
+CREATE INDEX idx_columns_board_position ON columns(board_id, position, created_at, id);
+CREATE INDEX idx_cards_column_position ON cards(column_id, position, created_at, id);
+CREATE INDEX idx_boards_user_created_at ON boards(user_id, created_at, id);
@@ -0,0 +1,4 @@
+-- A column can cap the number of cards in it; zero means no limit. A strict
+-- limit turns cards away, a soft one only warns about them.
+ALTER TABLE columns ADD COLUMN wip_limit INTEGER NOT NULL DEFAULT 0 CHECK (wip_limit >= 0);
+ALTER TABLE columns ADD COLUMN wip_strict BOOLEAN NOT NULL DEFAULT FALSE;
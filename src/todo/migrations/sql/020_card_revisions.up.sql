@@ -0,0 +1,16 @@
+CREATE TABLE card_revisions (
+    id UUID PRIMARY KEY,
+    card_id UUID NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    author_id UUID NOT NULL,
+    -- reverted_from is the number of the revision this one was reverted to
+    reverted_from INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (card_id, number)
+);
+
+-- The cards that are already there start their history from what they hold now
+INSERT INTO card_revisions (id, card_id, number, title, description, author_id, created_at)
+SELECT uuid_generate_v4(), id, 1, title, COALESCE(description, ''), user_id, updated_at FROM cards;
@@ -0,0 +1,17 @@
+CREATE TABLE outbox_events (
+    id UUID PRIMARY KEY,
+    -- seq is the order the events were written in, which is the order the
+    -- events of an aggregate are delivered in
+    seq BIGSERIAL NOT NULL UNIQUE,
+    type VARCHAR(32) NOT NULL,
+    aggregate_type VARCHAR(16) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_id, seq);
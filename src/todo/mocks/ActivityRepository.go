@@ -0,0 +1,109 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ActivityRepository is an autogenerated mock type for the ActivityRepository type
+type ActivityRepository struct {
+	mock.Mock
+}
+
+// CreateActivity provides a mock function with given fields: ctx, activity
+func (_m *ActivityRepository) CreateActivity(ctx context.Context, activity *entity.Activity) error {
+	ret := _m.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Activity) error); ok {
+		r0 = rf(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActivityByBoard provides a mock function with given fields: ctx, boardID, limit, offset
+func (_m *ActivityRepository) GetActivityByBoard(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Activity, error) {
+	ret := _m.Called(ctx, boardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivityByBoard")
+	}
+
+	var r0 []entity.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Activity, error)); ok {
+		return rf(ctx, boardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Activity); ok {
+		r0 = rf(ctx, boardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, boardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActivityByTarget provides a mock function with given fields: ctx, targetID, limit, offset
+func (_m *ActivityRepository) GetActivityByTarget(ctx context.Context, targetID uuid.UUID, limit int, offset int) ([]entity.Activity, error) {
+	ret := _m.Called(ctx, targetID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivityByTarget")
+	}
+
+	var r0 []entity.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Activity, error)); ok {
+		return rf(ctx, targetID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Activity); ok {
+		r0 = rf(ctx, targetID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, targetID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewActivityRepository creates a new instance of ActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityRepository {
+	mock := &ActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AssigneeRepository is an autogenerated mock type for the AssigneeRepository type
+type AssigneeRepository struct {
+	mock.Mock
+}
+
+// AddAssignee provides a mock function with given fields: ctx, cardID, userID
+func (_m *AssigneeRepository) AddAssignee(ctx context.Context, cardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAssigneesByCards provides a mock function with given fields: ctx, cardIDs
+func (_m *AssigneeRepository) GetAssigneesByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	ret := _m.Called(ctx, cardIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssigneesByCards")
+	}
+
+	var r0 map[uuid.UUID][]uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)); ok {
+		return rf(ctx, cardIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID][]uuid.UUID); ok {
+		r0 = rf(ctx, cardIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, cardIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveAssignee provides a mock function with given fields: ctx, cardID, userID
+func (_m *AssigneeRepository) RemoveAssignee(ctx context.Context, cardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAssigneeRepository creates a new instance of AssigneeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAssigneeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AssigneeRepository {
+	mock := &AssigneeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
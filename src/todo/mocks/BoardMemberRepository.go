@@ -0,0 +1,175 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// BoardMemberRepository is an autogenerated mock type for the BoardMemberRepository type
+type BoardMemberRepository struct {
+	mock.Mock
+}
+
+// AddMember provides a mock function with given fields: ctx, member
+func (_m *BoardMemberRepository) AddMember(ctx context.Context, member *entity.BoardMember) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BoardMember) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteMember provides a mock function with given fields: ctx, boardID, userID
+func (_m *BoardMemberRepository) DeleteMember(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, boardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, boardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetInvitationsByUser provides a mock function with given fields: ctx, userID
+func (_m *BoardMemberRepository) GetInvitationsByUser(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitationsByUser")
+	}
+
+	var r0 []entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.BoardMember, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.BoardMember); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMember provides a mock function with given fields: ctx, boardID, userID
+func (_m *BoardMemberRepository) GetMember(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) (*entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMember")
+	}
+
+	var r0 *entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.BoardMember, error)); ok {
+		return rf(ctx, boardID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.BoardMember); ok {
+		r0 = rf(ctx, boardID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMembersByBoard provides a mock function with given fields: ctx, boardID
+func (_m *BoardMemberRepository) GetMembersByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembersByBoard")
+	}
+
+	var r0 []entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.BoardMember, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.BoardMember); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMember provides a mock function with given fields: ctx, member
+func (_m *BoardMemberRepository) UpdateMember(ctx context.Context, member *entity.BoardMember) error {
+	ret := _m.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BoardMember) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBoardMemberRepository creates a new instance of BoardMemberRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBoardMemberRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BoardMemberRepository {
+	mock := &BoardMemberRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
@@ -34,17 +34,17 @@ func (_m *BoardRepository) CreateBoard(ctx context.Context, board *entity.Board)
 	return r0
 }
 
-// DeleteBoard provides a mock function with given fields: ctx, id
-func (_m *BoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteBoard provides a mock function with given fields: ctx, id, userID
+func (_m *BoardRepository) DeleteBoard(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, id, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBoard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -82,14 +82,74 @@ func (_m *BoardRepository) GetBoardByID(ctx context.Context, id uuid.UUID) (*ent
 	return r0, r1
 }
 
-// GetBoardsByUser provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// GetBoardsByUser provides a mock function with given fields: ctx, userID, archived, limit, offset
+func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit int, offset int) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, archived, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBoardsByUser")
 	}
 
+	var r0 []entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) ([]entity.Board, error)); ok {
+		return rf(ctx, userID, archived, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) []entity.Board); ok {
+		r0 = rf(ctx, userID, archived, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, int, int) error); ok {
+		r1 = rf(ctx, userID, archived, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardsPage provides a mock function with given fields: ctx, userID, archived, page
+func (_m *BoardRepository) GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardsPage")
+	}
+
+	var r0 []entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) ([]entity.Board, error)); ok {
+		return rf(ctx, userID, archived, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) []entity.Board); ok {
+		r0 = rf(ctx, userID, archived, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, entity.PageRequest) error); ok {
+		r1 = rf(ctx, userID, archived, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplatesByUser provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *BoardRepository) GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplatesByUser")
+	}
+
 	var r0 []entity.Board
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Board, error)); ok {
@@ -112,6 +172,24 @@ func (_m *BoardRepository) GetBoardsByUser(ctx context.Context, userID uuid.UUID
 	return r0, r1
 }
 
+// LockBoard provides a mock function with given fields: ctx, id
+func (_m *BoardRepository) LockBoard(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateBoard provides a mock function with given fields: ctx, board
 func (_m *BoardRepository) UpdateBoard(ctx context.Context, board *entity.Board) error {
 	ret := _m.Called(ctx, board)
@@ -130,6 +208,42 @@ func (_m *BoardRepository) UpdateBoard(ctx context.Context, board *entity.Board)
 	return r0
 }
 
+// UpdateBoardArchived provides a mock function with given fields: ctx, board
+func (_m *BoardRepository) UpdateBoardArchived(ctx context.Context, board *entity.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBoardArchived")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBoardVisibility provides a mock function with given fields: ctx, board
+func (_m *BoardRepository) UpdateBoardVisibility(ctx context.Context, board *entity.Board) error {
+	ret := _m.Called(ctx, board)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBoardVisibility")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBoardRepository creates a new instance of BoardRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBoardRepository(t interface {
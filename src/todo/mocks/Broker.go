@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Broker is an autogenerated mock type for the Broker type
+type Broker struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: ctx, events
+func (_m *Broker) Publish(ctx context.Context, events []entity.Event) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Event) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: ctx, boardID, lastEventID
+func (_m *Broker) Subscribe(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error) {
+	ret := _m.Called(ctx, boardID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan entity.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (<-chan entity.Event, error)); ok {
+		return rf(ctx, boardID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) <-chan entity.Event); ok {
+		r0 = rf(ctx, boardID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan entity.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, boardID, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
@@ -18,6 +18,36 @@ type CardRepository struct {
 	mock.Mock
 }
 
+// CountCardsByColumns provides a mock function with given fields: ctx, columnIDs
+func (_m *CardRepository) CountCardsByColumns(ctx context.Context, columnIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	ret := _m.Called(ctx, columnIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCardsByColumns")
+	}
+
+	var r0 map[uuid.UUID]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]int, error)); ok {
+		return rf(ctx, columnIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]int); ok {
+		r0 = rf(ctx, columnIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, columnIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCard provides a mock function with given fields: ctx, card
 func (_m *CardRepository) CreateCard(ctx context.Context, card *entity.Card) error {
 	ret := _m.Called(ctx, card)
@@ -36,17 +66,17 @@ func (_m *CardRepository) CreateCard(ctx context.Context, card *entity.Card) err
 	return r0
 }
 
-// DeleteCard provides a mock function with given fields: ctx, id
-func (_m *CardRepository) DeleteCard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteCard provides a mock function with given fields: ctx, id, userID
+func (_m *CardRepository) DeleteCard(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, id, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCard")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -84,21 +114,51 @@ func (_m *CardRepository) GetCardByID(ctx context.Context, id uuid.UUID) (*entit
 	return r0, r1
 }
 
-// GetCardsByColumn provides a mock function with given fields: ctx, columnID, limit, offset
-func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
-	ret := _m.Called(ctx, columnID, limit, offset)
+// GetCardPositions provides a mock function with given fields: ctx, columnID
+func (_m *CardRepository) GetCardPositions(ctx context.Context, columnID uuid.UUID) ([]entity.Position, error) {
+	ret := _m.Called(ctx, columnID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCardsByColumn")
+		panic("no return value specified for GetCardPositions")
+	}
+
+	var r0 []entity.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Position, error)); ok {
+		return rf(ctx, columnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Position); ok {
+		r0 = rf(ctx, columnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, columnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsByAssignee provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *CardRepository) GetCardsByAssignee(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsByAssignee")
 	}
 
 	var r0 []entity.Card
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
-		return rf(ctx, columnID, limit, offset)
+		return rf(ctx, userID, limit, offset)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
-		r0 = rf(ctx, columnID, limit, offset)
+		r0 = rf(ctx, userID, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Card)
@@ -106,7 +166,127 @@ func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UU
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, columnID, limit, offset)
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsByColumn provides a mock function with given fields: ctx, columnID, filter, limit, offset
+func (_m *CardRepository) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, columnID, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsByColumn")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, columnID, filter, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) []entity.Card); ok {
+		r0 = rf(ctx, columnID, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) error); ok {
+		r1 = rf(ctx, columnID, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsPage provides a mock function with given fields: ctx, columnID, filter, page
+func (_m *CardRepository) GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, error) {
+	ret := _m.Called(ctx, columnID, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsPage")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) ([]entity.Card, error)); ok {
+		return rf(ctx, columnID, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) []entity.Card); ok {
+		r0 = rf(ctx, columnID, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) error); ok {
+		r1 = rf(ctx, columnID, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsToRemind provides a mock function with given fields: ctx, from, to
+func (_m *CardRepository) GetCardsToRemind(ctx context.Context, from time.Time, to time.Time) ([]entity.Card, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsToRemind")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Card, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Card); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueCardsByUser provides a mock function with given fields: ctx, userID, from, to, limit, offset
+func (_m *CardRepository) GetDueCardsByUser(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, userID, from, to, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueCardsByUser")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, userID, from, to, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time, int, int) []entity.Card); ok {
+		r0 = rf(ctx, userID, from, to, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time, int, int) error); ok {
+		r1 = rf(ctx, userID, from, to, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,6 +324,108 @@ func (_m *CardRepository) GetNewCards(ctx context.Context, from time.Time, to ti
 	return r0, r1
 }
 
+// LockCard provides a mock function with given fields: ctx, id
+func (_m *CardRepository) LockCard(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkCardReminded provides a mock function with given fields: ctx, id, dueDate, at
+func (_m *CardRepository) MarkCardReminded(ctx context.Context, id uuid.UUID, dueDate time.Time, at time.Time) error {
+	ret := _m.Called(ctx, id, dueDate, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCardReminded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, id, dueDate, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MoveCard provides a mock function with given fields: ctx, card
+func (_m *CardRepository) MoveCard(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchCards provides a mock function with given fields: ctx, userID, search, limit, offset
+func (_m *CardRepository) SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit int, offset int) ([]entity.CardMatch, error) {
+	ret := _m.Called(ctx, userID, search, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCards")
+	}
+
+	var r0 []entity.CardMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) ([]entity.CardMatch, error)); ok {
+		return rf(ctx, userID, search, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) []entity.CardMatch); ok {
+		r0 = rf(ctx, userID, search, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CardMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) error); ok {
+		r1 = rf(ctx, userID, search, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCardDates provides a mock function with given fields: ctx, card
+func (_m *CardRepository) SetCardDates(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCardDates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCard provides a mock function with given fields: ctx, card
 func (_m *CardRepository) UpdateCard(ctx context.Context, card *entity.Card) error {
 	ret := _m.Called(ctx, card)
@@ -162,6 +444,24 @@ func (_m *CardRepository) UpdateCard(ctx context.Context, card *entity.Card) err
 	return r0
 }
 
+// UpdateCardPosition provides a mock function with given fields: ctx, id, position
+func (_m *CardRepository) UpdateCardPosition(ctx context.Context, id uuid.UUID, position float64) error {
+	ret := _m.Called(ctx, id, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCardPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64) error); ok {
+		r0 = rf(ctx, id, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewCardRepository creates a new instance of CardRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCardRepository(t interface {
@@ -0,0 +1,367 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ChecklistRepository is an autogenerated mock type for the ChecklistRepository type
+type ChecklistRepository struct {
+	mock.Mock
+}
+
+// CreateChecklist provides a mock function with given fields: ctx, checklist
+func (_m *ChecklistRepository) CreateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	ret := _m.Called(ctx, checklist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Checklist) error); ok {
+		r0 = rf(ctx, checklist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateItem provides a mock function with given fields: ctx, item
+func (_m *ChecklistRepository) CreateItem(ctx context.Context, item *entity.ChecklistItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChecklistItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteChecklist provides a mock function with given fields: ctx, id
+func (_m *ChecklistRepository) DeleteChecklist(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteItem provides a mock function with given fields: ctx, id
+func (_m *ChecklistRepository) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetChecklistByID provides a mock function with given fields: ctx, id
+func (_m *ChecklistRepository) GetChecklistByID(ctx context.Context, id uuid.UUID) (*entity.Checklist, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistByID")
+	}
+
+	var r0 *entity.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Checklist, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Checklist); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChecklistPositions provides a mock function with given fields: ctx, cardID
+func (_m *ChecklistRepository) GetChecklistPositions(ctx context.Context, cardID uuid.UUID) ([]entity.Position, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistPositions")
+	}
+
+	var r0 []entity.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Position, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Position); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChecklistsByCard provides a mock function with given fields: ctx, cardID
+func (_m *ChecklistRepository) GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistsByCard")
+	}
+
+	var r0 []entity.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Checklist, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Checklist); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemByID provides a mock function with given fields: ctx, id
+func (_m *ChecklistRepository) GetItemByID(ctx context.Context, id uuid.UUID) (*entity.ChecklistItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemByID")
+	}
+
+	var r0 *entity.ChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ChecklistItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ChecklistItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemPositions provides a mock function with given fields: ctx, checklistID
+func (_m *ChecklistRepository) GetItemPositions(ctx context.Context, checklistID uuid.UUID) ([]entity.Position, error) {
+	ret := _m.Called(ctx, checklistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemPositions")
+	}
+
+	var r0 []entity.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Position, error)); ok {
+		return rf(ctx, checklistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Position); ok {
+		r0 = rf(ctx, checklistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, checklistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemsByCard provides a mock function with given fields: ctx, cardID
+func (_m *ChecklistRepository) GetItemsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.ChecklistItem, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemsByCard")
+	}
+
+	var r0 []entity.ChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ChecklistItem, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ChecklistItem); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProgressByCards provides a mock function with given fields: ctx, cardIDs
+func (_m *ChecklistRepository) GetProgressByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID]entity.ChecklistProgress, error) {
+	ret := _m.Called(ctx, cardIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgressByCards")
+	}
+
+	var r0 map[uuid.UUID]entity.ChecklistProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]entity.ChecklistProgress, error)); ok {
+		return rf(ctx, cardIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]entity.ChecklistProgress); ok {
+		r0 = rf(ctx, cardIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]entity.ChecklistProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, cardIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockChecklist provides a mock function with given fields: ctx, id
+func (_m *ChecklistRepository) LockChecklist(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateChecklist provides a mock function with given fields: ctx, checklist
+func (_m *ChecklistRepository) UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	ret := _m.Called(ctx, checklist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Checklist) error); ok {
+		r0 = rf(ctx, checklist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateItem provides a mock function with given fields: ctx, item
+func (_m *ChecklistRepository) UpdateItem(ctx context.Context, item *entity.ChecklistItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChecklistItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewChecklistRepository creates a new instance of ChecklistRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChecklistRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChecklistRepository {
+	mock := &ChecklistRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
@@ -34,17 +34,17 @@ func (_m *ColumnRepository) CreateColumn(ctx context.Context, column *entity.Col
 	return r0
 }
 
-// DeleteColumn provides a mock function with given fields: ctx, id
-func (_m *ColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// DeleteColumn provides a mock function with given fields: ctx, id, userID
+func (_m *ColumnRepository) DeleteColumn(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, id, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteColumn")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -82,9 +82,39 @@ func (_m *ColumnRepository) GetColumnByID(ctx context.Context, id uuid.UUID) (*e
 	return r0, r1
 }
 
-// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, limit, offset
-func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Column, error) {
-	ret := _m.Called(ctx, boardID, limit, offset)
+// GetColumnPositions provides a mock function with given fields: ctx, boardID
+func (_m *ColumnRepository) GetColumnPositions(ctx context.Context, boardID uuid.UUID) ([]entity.Position, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnPositions")
+	}
+
+	var r0 []entity.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Position, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Position); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, archived, limit, offset
+func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit int, offset int) ([]entity.Column, error) {
+	ret := _m.Called(ctx, boardID, archived, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetColumnsByBoard")
@@ -92,19 +122,49 @@ func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.
 
 	var r0 []entity.Column
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Column, error)); ok {
-		return rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) ([]entity.Column, error)); ok {
+		return rf(ctx, boardID, archived, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) []entity.Column); ok {
+		r0 = rf(ctx, boardID, archived, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, int, int) error); ok {
+		r1 = rf(ctx, boardID, archived, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnsPage provides a mock function with given fields: ctx, boardID, archived, page
+func (_m *ColumnRepository) GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, error) {
+	ret := _m.Called(ctx, boardID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnsPage")
+	}
+
+	var r0 []entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) ([]entity.Column, error)); ok {
+		return rf(ctx, boardID, archived, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Column); ok {
-		r0 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) []entity.Column); ok {
+		r0 = rf(ctx, boardID, archived, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Column)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, entity.PageRequest) error); ok {
+		r1 = rf(ctx, boardID, archived, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,6 +172,42 @@ func (_m *ColumnRepository) GetColumnsByBoard(ctx context.Context, boardID uuid.
 	return r0, r1
 }
 
+// LockColumn provides a mock function with given fields: ctx, id
+func (_m *ColumnRepository) LockColumn(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetColumnWIPLimit provides a mock function with given fields: ctx, column
+func (_m *ColumnRepository) SetColumnWIPLimit(ctx context.Context, column *entity.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetColumnWIPLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateColumn provides a mock function with given fields: ctx, column
 func (_m *ColumnRepository) UpdateColumn(ctx context.Context, column *entity.Column) error {
 	ret := _m.Called(ctx, column)
@@ -130,6 +226,42 @@ func (_m *ColumnRepository) UpdateColumn(ctx context.Context, column *entity.Col
 	return r0
 }
 
+// UpdateColumnArchived provides a mock function with given fields: ctx, column
+func (_m *ColumnRepository) UpdateColumnArchived(ctx context.Context, column *entity.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumnArchived")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateColumnPosition provides a mock function with given fields: ctx, id, position
+func (_m *ColumnRepository) UpdateColumnPosition(ctx context.Context, id uuid.UUID, position float64) error {
+	ret := _m.Called(ctx, id, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateColumnPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64) error); ok {
+		r0 = rf(ctx, id, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewColumnRepository creates a new instance of ColumnRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewColumnRepository(t interface {
@@ -0,0 +1,229 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LabelRepository is an autogenerated mock type for the LabelRepository type
+type LabelRepository struct {
+	mock.Mock
+}
+
+// AttachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *LabelRepository) AttachLabel(ctx context.Context, cardID uuid.UUID, labelID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLabel provides a mock function with given fields: ctx, label
+func (_m *LabelRepository) CreateLabel(ctx context.Context, label *entity.Label) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteLabel provides a mock function with given fields: ctx, id
+func (_m *LabelRepository) DeleteLabel(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DetachForeignLabels provides a mock function with given fields: ctx, cardID, boardID
+func (_m *LabelRepository) DetachForeignLabels(ctx context.Context, cardID uuid.UUID, boardID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachForeignLabels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, boardID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DetachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *LabelRepository) DetachLabel(ctx context.Context, cardID uuid.UUID, labelID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLabelByID provides a mock function with given fields: ctx, id
+func (_m *LabelRepository) GetLabelByID(ctx context.Context, id uuid.UUID) (*entity.Label, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabelByID")
+	}
+
+	var r0 *entity.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Label, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Label); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLabelsByBoard provides a mock function with given fields: ctx, boardID
+func (_m *LabelRepository) GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabelsByBoard")
+	}
+
+	var r0 []entity.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Label, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Label); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLabelsByCards provides a mock function with given fields: ctx, cardIDs
+func (_m *LabelRepository) GetLabelsByCards(ctx context.Context, cardIDs []uuid.UUID) (map[uuid.UUID][]entity.Label, error) {
+	ret := _m.Called(ctx, cardIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabelsByCards")
+	}
+
+	var r0 map[uuid.UUID][]entity.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID][]entity.Label, error)); ok {
+		return rf(ctx, cardIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID][]entity.Label); ok {
+		r0 = rf(ctx, cardIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]entity.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, cardIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, label
+func (_m *LabelRepository) UpdateLabel(ctx context.Context, label *entity.Label) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLabelRepository creates a new instance of LabelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLabelRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LabelRepository {
+	mock := &LabelRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
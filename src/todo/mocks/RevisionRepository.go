@@ -0,0 +1,109 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RevisionRepository is an autogenerated mock type for the RevisionRepository type
+type RevisionRepository struct {
+	mock.Mock
+}
+
+// CreateRevision provides a mock function with given fields: ctx, revision
+func (_m *RevisionRepository) CreateRevision(ctx context.Context, revision *entity.CardRevision) error {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.CardRevision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRevision provides a mock function with given fields: ctx, cardID, number
+func (_m *RevisionRepository) GetRevision(ctx context.Context, cardID uuid.UUID, number int) (*entity.CardRevision, error) {
+	ret := _m.Called(ctx, cardID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 *entity.CardRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*entity.CardRevision, error)); ok {
+		return rf(ctx, cardID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *entity.CardRevision); ok {
+		r0 = rf(ctx, cardID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CardRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, cardID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevisionsByCard provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *RevisionRepository) GetRevisionsByCard(ctx context.Context, cardID uuid.UUID, limit int, offset int) ([]entity.CardRevision, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionsByCard")
+	}
+
+	var r0 []entity.CardRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.CardRevision, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.CardRevision); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CardRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRevisionRepository creates a new instance of RevisionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevisionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevisionRepository {
+	mock := &RevisionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
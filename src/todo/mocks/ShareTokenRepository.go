@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "todo/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ShareTokenRepository is an autogenerated mock type for the ShareTokenRepository type
+type ShareTokenRepository struct {
+	mock.Mock
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, token
+func (_m *ShareTokenRepository) CreateShareToken(ctx context.Context, token *entity.ShareToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ShareToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShareToken provides a mock function with given fields: ctx, token
+func (_m *ShareTokenRepository) DeleteShareToken(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteShareToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetShareToken provides a mock function with given fields: ctx, token
+func (_m *ShareTokenRepository) GetShareToken(ctx context.Context, token string) (*entity.ShareToken, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareToken")
+	}
+
+	var r0 *entity.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.ShareToken, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.ShareToken); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShareTokensByBoard provides a mock function with given fields: ctx, boardID
+func (_m *ShareTokenRepository) GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareTokensByBoard")
+	}
+
+	var r0 []entity.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ShareToken, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ShareToken); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewShareTokenRepository creates a new instance of ShareTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShareTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShareTokenRepository {
+	mock := &ShareTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
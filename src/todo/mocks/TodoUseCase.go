@@ -1,4 +1,4 @@
-// Code generated by mockery v2.46.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,8 @@ import (
 	context "context"
 	entity "todo/internal/entity"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -18,6 +20,162 @@ type TodoUseCase struct {
 	mock.Mock
 }
 
+// AcceptInvitation provides a mock function with given fields: ctx, boardID
+func (_m *TodoUseCase) AcceptInvitation(ctx context.Context, boardID uuid.UUID) (*entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 *entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.BoardMember, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.BoardMember); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveBoard provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) ArchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveBoard")
+	}
+
+	var r0 *entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Board, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Board); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArchiveColumn provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) ArchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveColumn")
+	}
+
+	var r0 *entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Column, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Column); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignCard provides a mock function with given fields: ctx, cardID, userID
+func (_m *TodoUseCase) AssignCard(ctx context.Context, cardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *TodoUseCase) AttachLabel(ctx context.Context, cardID uuid.UUID, labelID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloneBoard provides a mock function with given fields: ctx, id, opts
+func (_m *TodoUseCase) CloneBoard(ctx context.Context, id uuid.UUID, opts entity.CloneOptions) (*entity.Board, error) {
+	ret := _m.Called(ctx, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneBoard")
+	}
+
+	var r0 *entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CloneOptions) (*entity.Board, error)); ok {
+		return rf(ctx, id, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CloneOptions) *entity.Board); ok {
+		r0 = rf(ctx, id, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CloneOptions) error); ok {
+		r1 = rf(ctx, id, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBoard provides a mock function with given fields: ctx, board
 func (_m *TodoUseCase) CreateBoard(ctx context.Context, board *entity.Board) error {
 	ret := _m.Called(ctx, board)
@@ -26,45 +184,1491 @@ func (_m *TodoUseCase) CreateBoard(ctx context.Context, board *entity.Board) err
 		panic("no return value specified for CreateBoard")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Board) error); ok {
-		r0 = rf(ctx, board)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Board) error); ok {
+		r0 = rf(ctx, board)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCard provides a mock function with given fields: ctx, card
+func (_m *TodoUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
+	ret := _m.Called(ctx, card)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
+		r0 = rf(ctx, card)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChecklist provides a mock function with given fields: ctx, checklist
+func (_m *TodoUseCase) CreateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	ret := _m.Called(ctx, checklist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Checklist) error); ok {
+		r0 = rf(ctx, checklist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChecklistItem provides a mock function with given fields: ctx, item
+func (_m *TodoUseCase) CreateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChecklistItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateColumn provides a mock function with given fields: ctx, column
+func (_m *TodoUseCase) CreateColumn(ctx context.Context, column *entity.Column) error {
+	ret := _m.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column) error); ok {
+		r0 = rf(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateComment provides a mock function with given fields: ctx, comment
+func (_m *TodoUseCase) CreateComment(ctx context.Context, comment *entity.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLabel provides a mock function with given fields: ctx, label
+func (_m *TodoUseCase) CreateLabel(ctx context.Context, label *entity.Label) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, boardID
+func (_m *TodoUseCase) CreateShareToken(ctx context.Context, boardID uuid.UUID) (*entity.ShareToken, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareToken")
+	}
+
+	var r0 *entity.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ShareToken, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ShareToken); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAttachment provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBoard provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBoard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCard provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteChecklist provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteChecklist(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteChecklistItem provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteChecklistItem(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteColumn provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComment provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteLabel provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) DeleteLabel(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DetachLabel provides a mock function with given fields: ctx, cardID, labelID
+func (_m *TodoUseCase) DetachLabel(ctx context.Context, cardID uuid.UUID, labelID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiffCardRevisions provides a mock function with given fields: ctx, cardID, from, to
+func (_m *TodoUseCase) DiffCardRevisions(ctx context.Context, cardID uuid.UUID, from int, to int) (*entity.RevisionDiff, error) {
+	ret := _m.Called(ctx, cardID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffCardRevisions")
+	}
+
+	var r0 *entity.RevisionDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) (*entity.RevisionDiff, error)); ok {
+		return rf(ctx, cardID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) *entity.RevisionDiff); ok {
+		r0 = rf(ctx, cardID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.RevisionDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, cardID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportBoard provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) ExportBoard(ctx context.Context, id uuid.UUID) (*entity.BoardExport, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBoard")
+	}
+
+	var r0 *entity.BoardExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.BoardExport, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.BoardExport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignedCards provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *TodoUseCase) GetAssignedCards(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignedCards")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttachment provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetAttachment(ctx context.Context, id uuid.UUID) (*entity.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *entity.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Attachment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) io.ReadCloser); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = rf(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBoardActivity provides a mock function with given fields: ctx, boardID, limit, offset
+func (_m *TodoUseCase) GetBoardActivity(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Activity, error) {
+	ret := _m.Called(ctx, boardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardActivity")
+	}
+
+	var r0 []entity.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Activity, error)); ok {
+		return rf(ctx, boardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Activity); ok {
+		r0 = rf(ctx, boardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, boardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardByID provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardByID")
+	}
+
+	var r0 *entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Board, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Board); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardMembers provides a mock function with given fields: ctx, boardID
+func (_m *TodoUseCase) GetBoardMembers(ctx context.Context, boardID uuid.UUID) ([]entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardMembers")
+	}
+
+	var r0 []entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.BoardMember, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.BoardMember); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardsByUser provides a mock function with given fields: ctx, userID, archived, limit, offset
+func (_m *TodoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, archived bool, limit int, offset int) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, archived, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardsByUser")
+	}
+
+	var r0 []entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) ([]entity.Board, error)); ok {
+		return rf(ctx, userID, archived, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) []entity.Board); ok {
+		r0 = rf(ctx, userID, archived, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, int, int) error); ok {
+		r1 = rf(ctx, userID, archived, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoardsPage provides a mock function with given fields: ctx, userID, archived, page
+func (_m *TodoUseCase) GetBoardsPage(ctx context.Context, userID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Board, *entity.Cursor, error) {
+	ret := _m.Called(ctx, userID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardsPage")
+	}
+
+	var r0 []entity.Board
+	var r1 *entity.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) ([]entity.Board, *entity.Cursor, error)); ok {
+		return rf(ctx, userID, archived, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) []entity.Board); ok {
+		r0 = rf(ctx, userID, archived, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, entity.PageRequest) *entity.Cursor); ok {
+		r1 = rf(ctx, userID, archived, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entity.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, entity.PageRequest) error); ok {
+		r2 = rf(ctx, userID, archived, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCardActivity provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoUseCase) GetCardActivity(ctx context.Context, cardID uuid.UUID, limit int, offset int) ([]entity.Activity, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardActivity")
+	}
+
+	var r0 []entity.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Activity, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Activity); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardByID provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardByID")
+	}
+
+	var r0 *entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Card, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Card); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardRevisions provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoUseCase) GetCardRevisions(ctx context.Context, cardID uuid.UUID, limit int, offset int) ([]entity.CardRevision, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardRevisions")
+	}
+
+	var r0 []entity.CardRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.CardRevision, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.CardRevision); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CardRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsByColumn provides a mock function with given fields: ctx, columnID, filter, limit, offset
+func (_m *TodoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, columnID, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsByColumn")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, columnID, filter, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) []entity.Card); ok {
+		r0 = rf(ctx, columnID, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardFilter, int, int) error); ok {
+		r1 = rf(ctx, columnID, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCardsPage provides a mock function with given fields: ctx, columnID, filter, page
+func (_m *TodoUseCase) GetCardsPage(ctx context.Context, columnID uuid.UUID, filter entity.CardFilter, page entity.PageRequest) ([]entity.Card, *entity.Cursor, error) {
+	ret := _m.Called(ctx, columnID, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardsPage")
+	}
+
+	var r0 []entity.Card
+	var r1 *entity.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) ([]entity.Card, *entity.Cursor, error)); ok {
+		return rf(ctx, columnID, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) []entity.Card); ok {
+		r0 = rf(ctx, columnID, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) *entity.Cursor); ok {
+		r1 = rf(ctx, columnID, filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entity.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, entity.CardFilter, entity.PageRequest) error); ok {
+		r2 = rf(ctx, columnID, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetChecklistsByCard provides a mock function with given fields: ctx, cardID
+func (_m *TodoUseCase) GetChecklistsByCard(ctx context.Context, cardID uuid.UUID) ([]entity.Checklist, error) {
+	ret := _m.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklistsByCard")
+	}
+
+	var r0 []entity.Checklist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Checklist, error)); ok {
+		return rf(ctx, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Checklist); ok {
+		r0 = rf(ctx, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Checklist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnByID provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnByID")
+	}
+
+	var r0 *entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Column, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Column); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, archived, limit, offset
+func (_m *TodoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, archived bool, limit int, offset int) ([]entity.Column, error) {
+	ret := _m.Called(ctx, boardID, archived, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnsByBoard")
+	}
+
+	var r0 []entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) ([]entity.Column, error)); ok {
+		return rf(ctx, boardID, archived, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int, int) []entity.Column); ok {
+		r0 = rf(ctx, boardID, archived, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, int, int) error); ok {
+		r1 = rf(ctx, boardID, archived, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetColumnsPage provides a mock function with given fields: ctx, boardID, archived, page
+func (_m *TodoUseCase) GetColumnsPage(ctx context.Context, boardID uuid.UUID, archived bool, page entity.PageRequest) ([]entity.Column, *entity.Cursor, error) {
+	ret := _m.Called(ctx, boardID, archived, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnsPage")
+	}
+
+	var r0 []entity.Column
+	var r1 *entity.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) ([]entity.Column, *entity.Cursor, error)); ok {
+		return rf(ctx, boardID, archived, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, entity.PageRequest) []entity.Column); ok {
+		r0 = rf(ctx, boardID, archived, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, entity.PageRequest) *entity.Cursor); ok {
+		r1 = rf(ctx, boardID, archived, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entity.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bool, entity.PageRequest) error); ok {
+		r2 = rf(ctx, boardID, archived, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCommentsByCard provides a mock function with given fields: ctx, cardID, limit, offset
+func (_m *TodoUseCase) GetCommentsByCard(ctx context.Context, cardID uuid.UUID, limit int, offset int) ([]entity.Comment, error) {
+	ret := _m.Called(ctx, cardID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentsByCard")
+	}
+
+	var r0 []entity.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Comment, error)); ok {
+		return rf(ctx, cardID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Comment); ok {
+		r0 = rf(ctx, cardID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, cardID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueCards provides a mock function with given fields: ctx, userID, days, limit, offset
+func (_m *TodoUseCase) GetDueCards(ctx context.Context, userID uuid.UUID, days int, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, userID, days, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueCards")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, userID, days, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int, int) []entity.Card); ok {
+		r0 = rf(ctx, userID, days, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int, int) error); ok {
+		r1 = rf(ctx, userID, days, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvitations provides a mock function with given fields: ctx, userID
+func (_m *TodoUseCase) GetInvitations(ctx context.Context, userID uuid.UUID) ([]entity.BoardMember, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitations")
+	}
+
+	var r0 []entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.BoardMember, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.BoardMember); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLabelsByBoard provides a mock function with given fields: ctx, boardID
+func (_m *TodoUseCase) GetLabelsByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.Label, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabelsByBoard")
+	}
+
+	var r0 []entity.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Label, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Label); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNewCards provides a mock function with given fields: ctx, from, to
+func (_m *TodoUseCase) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]entity.Card, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewCards")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Card, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Card); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverdueCards provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *TodoUseCase) GetOverdueCards(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueCards")
+	}
+
+	var r0 []entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShareTokensByBoard provides a mock function with given fields: ctx, boardID
+func (_m *TodoUseCase) GetShareTokensByBoard(ctx context.Context, boardID uuid.UUID) ([]entity.ShareToken, error) {
+	ret := _m.Called(ctx, boardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareTokensByBoard")
+	}
+
+	var r0 []entity.ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.ShareToken, error)); ok {
+		return rf(ctx, boardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.ShareToken); ok {
+		r0 = rf(ctx, boardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, boardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSharedBoard provides a mock function with given fields: ctx, token
+func (_m *TodoUseCase) GetSharedBoard(ctx context.Context, token string) (*entity.BoardTree, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedBoard")
+	}
+
+	var r0 *entity.BoardTree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.BoardTree, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.BoardTree); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardTree)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSharedCard provides a mock function with given fields: ctx, token, cardID
+func (_m *TodoUseCase) GetSharedCard(ctx context.Context, token string, cardID uuid.UUID) (*entity.Card, error) {
+	ret := _m.Called(ctx, token, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedCard")
+	}
+
+	var r0 *entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*entity.Card, error)); ok {
+		return rf(ctx, token, cardID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *entity.Card); ok {
+		r0 = rf(ctx, token, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, token, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplatesByUser provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *TodoUseCase) GetTemplatesByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplatesByUser")
+	}
+
+	var r0 []entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Board, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Board); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrash provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *TodoUseCase) GetTrash(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.TrashItem, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 []entity.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.TrashItem, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.TrashItem); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportBoard provides a mock function with given fields: ctx, export
+func (_m *TodoUseCase) ImportBoard(ctx context.Context, export *entity.BoardExport) (*entity.Board, error) {
+	ret := _m.Called(ctx, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportBoard")
+	}
+
+	var r0 *entity.Board
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BoardExport) (*entity.Board, error)); ok {
+		return rf(ctx, export)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BoardExport) *entity.Board); ok {
+		r0 = rf(ctx, export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Board)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.BoardExport) error); ok {
+		r1 = rf(ctx, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTrelloBoard provides a mock function with given fields: ctx, trello, dryRun
+func (_m *TodoUseCase) ImportTrelloBoard(ctx context.Context, trello *entity.TrelloBoard, dryRun bool) (*entity.ImportSummary, error) {
+	ret := _m.Called(ctx, trello, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTrelloBoard")
+	}
+
+	var r0 *entity.ImportSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.TrelloBoard, bool) (*entity.ImportSummary, error)); ok {
+		return rf(ctx, trello, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.TrelloBoard, bool) *entity.ImportSummary); ok {
+		r0 = rf(ctx, trello, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ImportSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.TrelloBoard, bool) error); ok {
+		r1 = rf(ctx, trello, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InviteMember provides a mock function with given fields: ctx, boardID, userID, role
+func (_m *TodoUseCase) InviteMember(ctx context.Context, boardID uuid.UUID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteMember")
+	}
+
+	var r0 *entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) (*entity.BoardMember, error)); ok {
+		return rf(ctx, boardID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) *entity.BoardMember); ok {
+		r0 = rf(ctx, boardID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) error); ok {
+		r1 = rf(ctx, boardID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCard provides a mock function with given fields: ctx, id, move
+func (_m *TodoUseCase) MoveCard(ctx context.Context, id uuid.UUID, move entity.CardMove) (*entity.Card, error) {
+	ret := _m.Called(ctx, id, move)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCard")
+	}
+
+	var r0 *entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardMove) (*entity.Card, error)); ok {
+		return rf(ctx, id, move)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardMove) *entity.Card); ok {
+		r0 = rf(ctx, id, move)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardMove) error); ok {
+		r1 = rf(ctx, id, move)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: ctx, boardID, userID
+func (_m *TodoUseCase) RemoveMember(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, boardID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, boardID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositionCard provides a mock function with given fields: ctx, id, placement
+func (_m *TodoUseCase) RepositionCard(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Card, error) {
+	ret := _m.Called(ctx, id, placement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositionCard")
+	}
+
+	var r0 *entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.Placement) (*entity.Card, error)); ok {
+		return rf(ctx, id, placement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.Placement) *entity.Card); ok {
+		r0 = rf(ctx, id, placement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Card)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.Placement) error); ok {
+		r1 = rf(ctx, id, placement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositionColumn provides a mock function with given fields: ctx, id, placement
+func (_m *TodoUseCase) RepositionColumn(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Column, error) {
+	ret := _m.Called(ctx, id, placement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositionColumn")
+	}
+
+	var r0 *entity.Column
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.Placement) (*entity.Column, error)); ok {
+		return rf(ctx, id, placement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.Placement) *entity.Column); ok {
+		r0 = rf(ctx, id, placement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Column)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.Placement) error); ok {
+		r1 = rf(ctx, id, placement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreItem provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) RestoreItem(ctx context.Context, id uuid.UUID) (*entity.TrashItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreItem")
+	}
+
+	var r0 *entity.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.TrashItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.TrashItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// CreateCard provides a mock function with given fields: ctx, card
-func (_m *TodoUseCase) CreateCard(ctx context.Context, card *entity.Card) error {
-	ret := _m.Called(ctx, card)
+// RevertCard provides a mock function with given fields: ctx, cardID, number
+func (_m *TodoUseCase) RevertCard(ctx context.Context, cardID uuid.UUID, number int) (*entity.Card, error) {
+	ret := _m.Called(ctx, cardID, number)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCard")
+		panic("no return value specified for RevertCard")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Card) error); ok {
-		r0 = rf(ctx, card)
+	var r0 *entity.Card
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*entity.Card, error)); ok {
+		return rf(ctx, cardID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *entity.Card); ok {
+		r0 = rf(ctx, cardID, number)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Card)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, cardID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// CreateColumn provides a mock function with given fields: ctx, column
-func (_m *TodoUseCase) CreateColumn(ctx context.Context, column *entity.Column) error {
-	ret := _m.Called(ctx, column)
+// RevokeShareToken provides a mock function with given fields: ctx, boardID, token
+func (_m *TodoUseCase) RevokeShareToken(ctx context.Context, boardID uuid.UUID, token string) error {
+	ret := _m.Called(ctx, boardID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateColumn")
+		panic("no return value specified for RevokeShareToken")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Column) error); ok {
-		r0 = rf(ctx, column)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, boardID, token)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -72,53 +1676,77 @@ func (_m *TodoUseCase) CreateColumn(ctx context.Context, column *entity.Column)
 	return r0
 }
 
-// DeleteBoard provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteBoard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// RunBatch provides a mock function with given fields: ctx, mode, ops
+func (_m *TodoUseCase) RunBatch(ctx context.Context, mode entity.BatchMode, ops []entity.BatchOp) ([]entity.BatchResult, error) {
+	ret := _m.Called(ctx, mode, ops)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteBoard")
+		panic("no return value specified for RunBatch")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []entity.BatchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.BatchMode, []entity.BatchOp) ([]entity.BatchResult, error)); ok {
+		return rf(ctx, mode, ops)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.BatchMode, []entity.BatchOp) []entity.BatchResult); ok {
+		r0 = rf(ctx, mode, ops)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BatchResult)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, entity.BatchMode, []entity.BatchOp) error); ok {
+		r1 = rf(ctx, mode, ops)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteCard provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteCard(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// SearchCards provides a mock function with given fields: ctx, userID, search, limit, offset
+func (_m *TodoUseCase) SearchCards(ctx context.Context, userID uuid.UUID, search entity.CardSearch, limit int, offset int) ([]entity.CardMatch, error) {
+	ret := _m.Called(ctx, userID, search, limit, offset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCard")
+		panic("no return value specified for SearchCards")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []entity.CardMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) ([]entity.CardMatch, error)); ok {
+		return rf(ctx, userID, search, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) []entity.CardMatch); ok {
+		r0 = rf(ctx, userID, search, limit, offset)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CardMatch)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardSearch, int, int) error); ok {
+		r1 = rf(ctx, userID, search, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteColumn provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// SetBoardPublic provides a mock function with given fields: ctx, id, isPublic
+func (_m *TodoUseCase) SetBoardPublic(ctx context.Context, id uuid.UUID, isPublic bool) error {
+	ret := _m.Called(ctx, id, isPublic)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteColumn")
+		panic("no return value specified for SetBoardPublic")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, id, isPublic)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -126,29 +1754,29 @@ func (_m *TodoUseCase) DeleteColumn(ctx context.Context, id uuid.UUID) error {
 	return r0
 }
 
-// GetBoardByID provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
-	ret := _m.Called(ctx, id)
+// SetCardDates provides a mock function with given fields: ctx, id, dates
+func (_m *TodoUseCase) SetCardDates(ctx context.Context, id uuid.UUID, dates entity.CardDates) (*entity.Card, error) {
+	ret := _m.Called(ctx, id, dates)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBoardByID")
+		panic("no return value specified for SetCardDates")
 	}
 
-	var r0 *entity.Board
+	var r0 *entity.Card
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Board, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardDates) (*entity.Card, error)); ok {
+		return rf(ctx, id, dates)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Board); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.CardDates) *entity.Card); ok {
+		r0 = rf(ctx, id, dates)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Board)
+			r0 = ret.Get(0).(*entity.Card)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.CardDates) error); ok {
+		r1 = rf(ctx, id, dates)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -156,29 +1784,29 @@ func (_m *TodoUseCase) GetBoardByID(ctx context.Context, id uuid.UUID) (*entity.
 	return r0, r1
 }
 
-// GetBoardsByUser provides a mock function with given fields: ctx, userID, limit, offset
-func (_m *TodoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]entity.Board, error) {
-	ret := _m.Called(ctx, userID, limit, offset)
+// SetChecklistItemDone provides a mock function with given fields: ctx, id, done
+func (_m *TodoUseCase) SetChecklistItemDone(ctx context.Context, id uuid.UUID, done bool) (*entity.ChecklistItem, error) {
+	ret := _m.Called(ctx, id, done)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBoardsByUser")
+		panic("no return value specified for SetChecklistItemDone")
 	}
 
-	var r0 []entity.Board
+	var r0 *entity.ChecklistItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Board, error)); ok {
-		return rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*entity.ChecklistItem, error)); ok {
+		return rf(ctx, id, done)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Board); ok {
-		r0 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *entity.ChecklistItem); ok {
+		r0 = rf(ctx, id, done)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Board)
+			r0 = ret.Get(0).(*entity.ChecklistItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, userID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, id, done)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -186,29 +1814,29 @@ func (_m *TodoUseCase) GetBoardsByUser(ctx context.Context, userID uuid.UUID, li
 	return r0, r1
 }
 
-// GetCardByID provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.Card, error) {
-	ret := _m.Called(ctx, id)
+// SetColumnWIPLimit provides a mock function with given fields: ctx, id, limit
+func (_m *TodoUseCase) SetColumnWIPLimit(ctx context.Context, id uuid.UUID, limit entity.WIPLimit) (*entity.Column, error) {
+	ret := _m.Called(ctx, id, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCardByID")
+		panic("no return value specified for SetColumnWIPLimit")
 	}
 
-	var r0 *entity.Card
+	var r0 *entity.Column
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Card, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WIPLimit) (*entity.Column, error)); ok {
+		return rf(ctx, id, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Card); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WIPLimit) *entity.Column); ok {
+		r0 = rf(ctx, id, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Card)
+			r0 = ret.Get(0).(*entity.Column)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.WIPLimit) error); ok {
+		r1 = rf(ctx, id, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,29 +1844,29 @@ func (_m *TodoUseCase) GetCardByID(ctx context.Context, id uuid.UUID) (*entity.C
 	return r0, r1
 }
 
-// GetCardsByColumn provides a mock function with given fields: ctx, columnID, limit, offset
-func (_m *TodoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID, limit int, offset int) ([]entity.Card, error) {
-	ret := _m.Called(ctx, columnID, limit, offset)
+// SubscribeBoard provides a mock function with given fields: ctx, boardID, lastEventID
+func (_m *TodoUseCase) SubscribeBoard(ctx context.Context, boardID uuid.UUID, lastEventID string) (<-chan entity.Event, error) {
+	ret := _m.Called(ctx, boardID, lastEventID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCardsByColumn")
+		panic("no return value specified for SubscribeBoard")
 	}
 
-	var r0 []entity.Card
+	var r0 <-chan entity.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Card, error)); ok {
-		return rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (<-chan entity.Event, error)); ok {
+		return rf(ctx, boardID, lastEventID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Card); ok {
-		r0 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) <-chan entity.Event); ok {
+		r0 = rf(ctx, boardID, lastEventID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Card)
+			r0 = ret.Get(0).(<-chan entity.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, columnID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, boardID, lastEventID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,24 +1874,24 @@ func (_m *TodoUseCase) GetCardsByColumn(ctx context.Context, columnID uuid.UUID,
 	return r0, r1
 }
 
-// GetColumnByID provides a mock function with given fields: ctx, id
-func (_m *TodoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+// UnarchiveBoard provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) UnarchiveBoard(ctx context.Context, id uuid.UUID) (*entity.Board, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetColumnByID")
+		panic("no return value specified for UnarchiveBoard")
 	}
 
-	var r0 *entity.Column
+	var r0 *entity.Board
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Column, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Board, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Column); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Board); ok {
 		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Column)
+			r0 = ret.Get(0).(*entity.Board)
 		}
 	}
 
@@ -276,29 +1904,29 @@ func (_m *TodoUseCase) GetColumnByID(ctx context.Context, id uuid.UUID) (*entity
 	return r0, r1
 }
 
-// GetColumnsByBoard provides a mock function with given fields: ctx, boardID, limit, offset
-func (_m *TodoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID, limit int, offset int) ([]entity.Column, error) {
-	ret := _m.Called(ctx, boardID, limit, offset)
+// UnarchiveColumn provides a mock function with given fields: ctx, id
+func (_m *TodoUseCase) UnarchiveColumn(ctx context.Context, id uuid.UUID) (*entity.Column, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetColumnsByBoard")
+		panic("no return value specified for UnarchiveColumn")
 	}
 
-	var r0 []entity.Column
+	var r0 *entity.Column
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]entity.Column, error)); ok {
-		return rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Column, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []entity.Column); ok {
-		r0 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Column); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Column)
+			r0 = ret.Get(0).(*entity.Column)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r1 = rf(ctx, boardID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -306,34 +1934,22 @@ func (_m *TodoUseCase) GetColumnsByBoard(ctx context.Context, boardID uuid.UUID,
 	return r0, r1
 }
 
-// GetNewCards provides a mock function with given fields: ctx, from, to
-func (_m *TodoUseCase) GetNewCards(ctx context.Context, from time.Time, to time.Time) ([]entity.Card, error) {
-	ret := _m.Called(ctx, from, to)
+// UnassignCard provides a mock function with given fields: ctx, cardID, userID
+func (_m *TodoUseCase) UnassignCard(ctx context.Context, cardID uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, cardID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNewCards")
-	}
-
-	var r0 []entity.Card
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Card, error)); ok {
-		return rf(ctx, from, to)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Card); ok {
-		r0 = rf(ctx, from, to)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Card)
-		}
+		panic("no return value specified for UnassignCard")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, from, to)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, cardID, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
 // UpdateBoard provides a mock function with given fields: ctx, board
@@ -372,6 +1988,42 @@ func (_m *TodoUseCase) UpdateCard(ctx context.Context, card *entity.Card) error
 	return r0
 }
 
+// UpdateChecklist provides a mock function with given fields: ctx, checklist
+func (_m *TodoUseCase) UpdateChecklist(ctx context.Context, checklist *entity.Checklist) error {
+	ret := _m.Called(ctx, checklist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Checklist) error); ok {
+		r0 = rf(ctx, checklist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateChecklistItem provides a mock function with given fields: ctx, item
+func (_m *TodoUseCase) UpdateChecklistItem(ctx context.Context, item *entity.ChecklistItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChecklistItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateColumn provides a mock function with given fields: ctx, column
 func (_m *TodoUseCase) UpdateColumn(ctx context.Context, column *entity.Column) error {
 	ret := _m.Called(ctx, column)
@@ -390,6 +2042,90 @@ func (_m *TodoUseCase) UpdateColumn(ctx context.Context, column *entity.Column)
 	return r0
 }
 
+// UpdateComment provides a mock function with given fields: ctx, comment
+func (_m *TodoUseCase) UpdateComment(ctx context.Context, comment *entity.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, label
+func (_m *TodoUseCase) UpdateLabel(ctx context.Context, label *entity.Label) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMemberRole provides a mock function with given fields: ctx, boardID, userID, role
+func (_m *TodoUseCase) UpdateMemberRole(ctx context.Context, boardID uuid.UUID, userID uuid.UUID, role entity.MemberRole) (*entity.BoardMember, error) {
+	ret := _m.Called(ctx, boardID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberRole")
+	}
+
+	var r0 *entity.BoardMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) (*entity.BoardMember, error)); ok {
+		return rf(ctx, boardID, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) *entity.BoardMember); ok {
+		r0 = rf(ctx, boardID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BoardMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, entity.MemberRole) error); ok {
+		r1 = rf(ctx, boardID, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, attachment, content
+func (_m *TodoUseCase) UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) error {
+	ret := _m.Called(ctx, attachment, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Attachment, io.Reader) error); ok {
+		r0 = rf(ctx, attachment, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTodoUseCase creates a new instance of TodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTodoUseCase(t interface {
@@ -5,9 +5,20 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+	"todo/internal/adapter/blob"
+	feedBroker "todo/internal/adapter/feed"
+	zapLogger "todo/internal/adapter/logger"
 	sqlxRepository "todo/internal/adapter/repository/sqlx"
+	"todo/internal/common/identity"
+	"todo/internal/common/logger"
+	"todo/internal/config"
 	"todo/internal/entity"
+	"todo/internal/outbox"
 	"todo/internal/repository"
 	"todo/internal/usecase"
 	v1 "todo/internal/usecase/v1"
@@ -31,21 +42,53 @@ type testSetup struct {
 	boardRepo  repository.BoardRepository
 	columnRepo repository.ColumnRepository
 	cardRepo   repository.CardRepository
+	trashRepo  repository.TrashRepository
+	outboxRepo repository.OutboxRepository
+	transactor repository.Transactor
+	relay      *outbox.Relay
+	log        logger.Logger
 	uc         usecase.TodoUseCase
 }
 
 func sqlxSetup() *testSetup {
-	ctx := context.TODO()
+	ctx := identity.WithCaller(context.TODO(), identity.Caller{
+		UserID: uuid.New(),
+		Role:   identity.RoleAdmin,
+	})
 	boardRepo := sqlxRepository.NewSQLXBoardRepository(db)
 	columnRepo := sqlxRepository.NewSQLXColumnRepository(db)
 	cardRepo := sqlxRepository.NewSQLXCardRepository(db)
-	uc := v1.NewTodoUseCase(boardRepo, columnRepo, cardRepo)
+	shareTokenRepo := sqlxRepository.NewSQLXShareTokenRepository(db)
+	memberRepo := sqlxRepository.NewSQLXBoardMemberRepository(db)
+	labelRepo := sqlxRepository.NewSQLXLabelRepository(db)
+	checklistRepo := sqlxRepository.NewSQLXChecklistRepository(db)
+	commentRepo := sqlxRepository.NewSQLXCommentRepository(db)
+	assigneeRepo := sqlxRepository.NewSQLXAssigneeRepository(db)
+	attachmentRepo := sqlxRepository.NewSQLXAttachmentRepository(db)
+	activityRepo := sqlxRepository.NewSQLXActivityRepository(db)
+	trashRepo := sqlxRepository.NewSQLXTrashRepository(db)
+	revisionRepo := sqlxRepository.NewSQLXRevisionRepository(db)
+	outboxRepo := sqlxRepository.NewSQLXOutboxRepository(db)
+	blobStore := blob.NewLocalBlobStore(filepath.Join(os.TempDir(), "todo_integration_test_attachments"))
+	log := zapLogger.NewZapLogger(config.LogConfig{
+		Path:  filepath.Join(os.TempDir(), "todo_integration_test.log"),
+		Level: "error",
+	})
+	broker := feedBroker.NewMemoryBroker(100)
+	transactor := sqlxRepository.NewSQLXTransactor(db)
+	relay := outbox.NewRelay(outboxRepo, broker, transactor, time.Second, 100, time.Second, time.Minute, log)
+	uc := v1.NewTodoUseCase(boardRepo, columnRepo, cardRepo, shareTokenRepo, memberRepo, labelRepo, checklistRepo, commentRepo, assigneeRepo, attachmentRepo, blobStore, activityRepo, trashRepo, revisionRepo, outboxRepo, broker, transactor, log)
 
 	return &testSetup{
 		ctx:        ctx,
 		boardRepo:  boardRepo,
 		columnRepo: columnRepo,
 		cardRepo:   cardRepo,
+		trashRepo:  trashRepo,
+		outboxRepo: outboxRepo,
+		transactor: transactor,
+		relay:      relay,
+		log:        log,
 		uc:         uc,
 	}
 }
@@ -142,6 +185,11 @@ func resetDatabase() error {
 		TRUNCATE TABLE cards RESTART IDENTITY CASCADE
 		`)
 	}
+	if err == nil {
+		_, err = db.Exec(`
+		TRUNCATE TABLE outbox_events RESTART IDENTITY
+		`)
+	}
 	return err
 }
 
@@ -310,6 +358,69 @@ func TestUpdate(t *testing.T) {
 	// TODO: Columns, cards
 }
 
+func TestUpdateVersions(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	assert.Equal(t, 1, card.Version)
+
+	// Two clients read the card and both change it
+	first := entity.Card{ID: card.ID, Title: "First", Version: card.Version}
+	second := entity.Card{ID: card.ID, Title: "Second", Version: card.Version}
+
+	err := ts.uc.UpdateCard(ts.ctx, &first)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, first.Version)
+
+	err = ts.uc.UpdateCard(ts.ctx, &second)
+	assert.ErrorIs(t, err, v1.ErrVersionConflict)
+
+	current, err := ts.uc.GetCardByID(ts.ctx, card.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "First", current.Title)
+	assert.Equal(t, 2, current.Version)
+
+	// The repository refuses a stale version even without the usecase check
+	err = ts.cardRepo.UpdateCard(ts.ctx, &entity.Card{ID: card.ID, Title: "Stale", Version: 1})
+	assert.ErrorIs(t, err, repository.ErrVersionConflict)
+
+	// Other changes move the version on as well
+	_, err = ts.uc.SetCardDates(ts.ctx, card.ID, entity.CardDates{})
+	assert.Nil(t, err)
+
+	current, err = ts.uc.GetCardByID(ts.ctx, card.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, current.Version)
+
+	renamed := entity.Board{ID: board.ID, Title: "New Board Title", Version: board.Version}
+	assert.Nil(t, ts.uc.UpdateBoard(ts.ctx, &renamed))
+
+	renamed.Title = "Stale Board Title"
+	renamed.Version = board.Version
+	assert.ErrorIs(t, ts.uc.UpdateBoard(ts.ctx, &renamed), v1.ErrVersionConflict)
+
+	column.Title = "New Column Title"
+	assert.Nil(t, ts.uc.UpdateColumn(ts.ctx, &column))
+	assert.Equal(t, 2, column.Version)
+}
+
 // DeleteBoard(ctx context.Context, id uuid.UUID) error
 func TestDelete(t *testing.T) {
 	ts := sqlxSetup()
@@ -337,12 +448,855 @@ func TestDelete(t *testing.T) {
 		log.Fatalf("Failed to execute DeleteBoard usecase: %v", err)
 	}
 
+	_, err = ts.boardRepo.GetBoardByID(ts.ctx, boardID)
+
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	// The board stays in the trash until it is purged
 	var tmp repository.Board
 	err = db.GetContext(ts.ctx, &tmp, `
 		SELECT * FROM boards WHERE id = $1
 	`, boardID)
 
-	assert.NotNil(t, err)
+	assert.Nil(t, err)
+	assert.NotNil(t, tmp.DeletedAt)
+}
 
-	// TODO: Columns, cards
+func TestDeleteRestoreAndPurge(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	kept := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Kept"}
+	deleted := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Deleted"}
+	for _, card := range []*entity.Card{&kept, &deleted} {
+		if err := ts.uc.CreateCard(ts.ctx, card); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+	}
+
+	// The card deleted on its own does not come back with the board
+	if err := ts.uc.DeleteCard(ts.ctx, deleted.ID); err != nil {
+		log.Fatalf("Failed to execute DeleteCard usecase: %v", err)
+	}
+	if err := ts.uc.DeleteBoard(ts.ctx, board.ID); err != nil {
+		log.Fatalf("Failed to execute DeleteBoard usecase: %v", err)
+	}
+
+	_, err := ts.cardRepo.GetCardByID(ts.ctx, kept.ID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	items, err := ts.uc.GetTrash(ts.ctx, userID, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+
+	_, err = ts.uc.RestoreItem(ts.ctx, deleted.ID)
+	assert.ErrorIs(t, err, v1.ErrTrashParentDeleted)
+
+	_, err = ts.uc.RestoreItem(ts.ctx, board.ID)
+	assert.Nil(t, err)
+
+	cards, err := ts.cardRepo.GetCardPositions(ts.ctx, column.ID)
+	assert.Nil(t, err)
+	assert.Len(t, cards, 1)
+	assert.Equal(t, kept.ID, cards[0].ID)
+
+	if _, err := ts.trashRepo.PurgeItems(ts.ctx, time.Now()); err != nil {
+		log.Fatalf("Failed to purge trash: %v", err)
+	}
+
+	var count int
+	err = db.GetContext(ts.ctx, &count, `SELECT COUNT(*) FROM cards WHERE id = $1`, deleted.ID)
+	assert.Nil(t, err)
+	assert.Zero(t, count)
+
+	_, err = ts.cardRepo.GetCardByID(ts.ctx, kept.ID)
+	assert.Nil(t, err)
+}
+
+func TestArchive(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	if _, err := ts.uc.ArchiveColumn(ts.ctx, column.ID); err != nil {
+		log.Fatalf("Failed to execute ArchiveColumn usecase: %v", err)
+	}
+
+	columns, err := ts.uc.GetColumnsByBoard(ts.ctx, board.ID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, columns)
+
+	columns, err = ts.uc.GetColumnsByBoard(ts.ctx, board.ID, true, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, columns, 1)
+
+	err = ts.uc.CreateCard(ts.ctx, &entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"})
+	assert.ErrorIs(t, err, v1.ErrArchived)
+
+	if _, err := ts.uc.ArchiveBoard(ts.ctx, board.ID); err != nil {
+		log.Fatalf("Failed to execute ArchiveBoard usecase: %v", err)
+	}
+
+	boards, err := ts.uc.GetBoardsByUser(ts.ctx, userID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, boards)
+
+	boards, err = ts.uc.GetBoardsByUser(ts.ctx, userID, true, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, boards, 1)
+
+	// The column cannot come back while its board is archived
+	_, err = ts.uc.UnarchiveColumn(ts.ctx, column.ID)
+	assert.ErrorIs(t, err, v1.ErrArchived)
+
+	if _, err := ts.uc.UnarchiveBoard(ts.ctx, board.ID); err != nil {
+		log.Fatalf("Failed to execute UnarchiveBoard usecase: %v", err)
+	}
+	if _, err := ts.uc.UnarchiveColumn(ts.ctx, column.ID); err != nil {
+		log.Fatalf("Failed to execute UnarchiveColumn usecase: %v", err)
+	}
+
+	err = ts.uc.CreateCard(ts.ctx, &entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"})
+	assert.Nil(t, err)
+}
+
+func TestCloneBoard(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+	caller, _ := identity.FromContext(ts.ctx)
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card Title"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	label := entity.Label{BoardID: board.ID, Name: "Bug", Color: "#d73a4a"}
+	if err := ts.uc.CreateLabel(ts.ctx, &label); err != nil {
+		log.Fatalf("Failed to execute CreateLabel usecase: %v", err)
+	}
+	if err := ts.uc.AttachLabel(ts.ctx, card.ID, label.ID); err != nil {
+		log.Fatalf("Failed to execute AttachLabel usecase: %v", err)
+	}
+
+	template, err := ts.uc.CloneBoard(ts.ctx, board.ID, entity.CloneOptions{Title: "Template", ExcludeCards: true, Template: true})
+	if err != nil {
+		log.Fatalf("Failed to execute CloneBoard usecase: %v", err)
+	}
+
+	// Templates are listed apart from boards
+	boards, err := ts.uc.GetBoardsByUser(ts.ctx, caller.UserID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, boards)
+
+	templates, err := ts.uc.GetTemplatesByUser(ts.ctx, caller.UserID, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+	assert.Equal(t, template.ID, templates[0].ID)
+
+	columns, err := ts.uc.GetColumnsByBoard(ts.ctx, template.ID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, columns, 1)
+
+	cards, err := ts.cardRepo.GetCardPositions(ts.ctx, columns[0].ID)
+	assert.Nil(t, err)
+	assert.Empty(t, cards)
+
+	clone, err := ts.uc.CloneBoard(ts.ctx, board.ID, entity.CloneOptions{})
+	if err != nil {
+		log.Fatalf("Failed to execute CloneBoard usecase: %v", err)
+	}
+	assert.Equal(t, "Board Title", clone.Title)
+
+	columns, err = ts.uc.GetColumnsByBoard(ts.ctx, clone.ID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, columns, 1)
+
+	copies, err := ts.uc.GetCardsByColumn(ts.ctx, columns[0].ID, entity.CardFilter{}, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, copies, 1)
+	assert.NotEqual(t, card.ID, copies[0].ID)
+	assert.Len(t, copies[0].Labels, 1)
+	assert.NotEqual(t, label.ID, copies[0].Labels[0].ID)
+}
+
+func TestExportImportBoard(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+	caller, _ := identity.FromContext(ts.ctx)
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	columns := []entity.Column{
+		{UserID: userID, BoardID: board.ID, Title: "Doing"},
+		{UserID: userID, BoardID: board.ID, Title: "Done"},
+	}
+	for i := range columns {
+		if err := ts.uc.CreateColumn(ts.ctx, &columns[i]); err != nil {
+			log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+		}
+	}
+	if _, err := ts.uc.ArchiveColumn(ts.ctx, columns[1].ID); err != nil {
+		log.Fatalf("Failed to execute ArchiveColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: columns[0].ID, Title: "Card Title", Description: "Text"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	label := entity.Label{BoardID: board.ID, Name: "Bug", Color: "#d73a4a"}
+	if err := ts.uc.CreateLabel(ts.ctx, &label); err != nil {
+		log.Fatalf("Failed to execute CreateLabel usecase: %v", err)
+	}
+	if err := ts.uc.AttachLabel(ts.ctx, card.ID, label.ID); err != nil {
+		log.Fatalf("Failed to execute AttachLabel usecase: %v", err)
+	}
+
+	export, err := ts.uc.ExportBoard(ts.ctx, board.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute ExportBoard usecase: %v", err)
+	}
+	assert.Len(t, export.Columns, 2)
+
+	imported, err := ts.uc.ImportBoard(ts.ctx, export)
+	if err != nil {
+		log.Fatalf("Failed to execute ImportBoard usecase: %v", err)
+	}
+	assert.NotEqual(t, board.ID, imported.ID)
+	assert.Equal(t, caller.UserID, imported.UserID)
+
+	active, err := ts.uc.GetColumnsByBoard(ts.ctx, imported.ID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, active, 1)
+
+	archived, err := ts.uc.GetColumnsByBoard(ts.ctx, imported.ID, true, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, archived, 1)
+
+	cards, err := ts.uc.GetCardsByColumn(ts.ctx, active[0].ID, entity.CardFilter{}, 10, 0)
+	assert.Nil(t, err)
+	if assert.Len(t, cards, 1) {
+		assert.Equal(t, "Text", cards[0].Description)
+		assert.Equal(t, card.Position, cards[0].Position)
+		assert.True(t, export.Columns[0].Cards[0].CreatedAt.Equal(cards[0].CreatedAt))
+		assert.Len(t, cards[0].Labels, 1)
+		assert.NotEqual(t, label.ID, cards[0].Labels[0].ID)
+	}
+
+	// The card title does not fit the column, so nothing of the board is kept
+	export, err = ts.uc.ExportBoard(ts.ctx, board.ID)
+	if err != nil {
+		log.Fatalf("Failed to execute ExportBoard usecase: %v", err)
+	}
+	export.Columns[0].Cards[0].Title = strings.Repeat("a", 300)
+
+	_, err = ts.uc.ImportBoard(ts.ctx, export)
+	assert.Error(t, err)
+
+	boards, err := ts.uc.GetBoardsByUser(ts.ctx, caller.UserID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, boards, 1)
+}
+
+func TestImportTrelloBoard(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	caller, _ := identity.FromContext(ts.ctx)
+
+	trello := &entity.TrelloBoard{
+		Name:   "Roadmap",
+		Labels: []entity.TrelloLabel{{ID: "l1", Color: "green"}, {ID: "l2", Name: "Green", Color: "lime"}},
+		Lists:  []entity.TrelloList{{ID: "todo", Name: "To do", Pos: 16384}, {ID: "done", Name: "Done", Pos: 32768, Closed: true}},
+		Cards: []entity.TrelloCard{
+			{ID: "c1", ListID: "todo", Name: "Second", Pos: 131072, LabelIDs: []string{"l1", "l2"}},
+			{ID: "c2", ListID: "todo", Name: "First", Desc: "Text", Pos: 65536},
+			{ID: "c3", ListID: "done", Name: "Archived", Closed: true},
+		},
+	}
+
+	summary, err := ts.uc.ImportTrelloBoard(ts.ctx, trello, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, summary.Cards)
+
+	boards, err := ts.uc.GetBoardsByUser(ts.ctx, caller.UserID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, boards)
+
+	summary, err = ts.uc.ImportTrelloBoard(ts.ctx, trello, false)
+	if err != nil {
+		log.Fatalf("Failed to execute ImportTrelloBoard usecase: %v", err)
+	}
+
+	columns, err := ts.uc.GetColumnsByBoard(ts.ctx, summary.Board.ID, false, 10, 0)
+	assert.Nil(t, err)
+	if assert.Len(t, columns, 1) {
+		assert.Equal(t, "To do", columns[0].Title)
+	}
+
+	archived, err := ts.uc.GetColumnsByBoard(ts.ctx, summary.Board.ID, true, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, archived, 1)
+
+	cards, err := ts.uc.GetCardsByColumn(ts.ctx, columns[0].ID, entity.CardFilter{}, 10, 0)
+	assert.Nil(t, err)
+	if assert.Len(t, cards, 2) {
+		assert.Equal(t, "First", cards[0].Title)
+		assert.Equal(t, "Text", cards[0].Description)
+		assert.Equal(t, "Second", cards[1].Title)
+		assert.Len(t, cards[1].Labels, 1)
+	}
+}
+
+func TestSearchCards(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+	strangerID := uuid.New()
+
+	boards := []entity.Board{{UserID: userID, Title: "Mine"}, {UserID: strangerID, Title: "Theirs"}}
+	columns := make([]entity.Column, len(boards))
+	for i := range boards {
+		if err := ts.uc.CreateBoard(ts.ctx, &boards[i]); err != nil {
+			log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+		}
+
+		columns[i] = entity.Column{UserID: boards[i].UserID, BoardID: boards[i].ID, Title: "Column Title"}
+		if err := ts.uc.CreateColumn(ts.ctx, &columns[i]); err != nil {
+			log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+		}
+	}
+
+	inTitle := entity.Card{UserID: userID, ColumnID: columns[0].ID, Title: "Write release notes", Description: "For the spring update"}
+	inDescription := entity.Card{UserID: userID, ColumnID: columns[0].ID, Title: "Publish", Description: "Publish the release notes on the site"}
+	deleted := entity.Card{UserID: userID, ColumnID: columns[0].ID, Title: "Old release notes"}
+	theirs := entity.Card{UserID: strangerID, ColumnID: columns[1].ID, Title: "Their release notes"}
+	for _, card := range []*entity.Card{&inTitle, &inDescription, &deleted, &theirs} {
+		if err := ts.uc.CreateCard(ts.ctx, card); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+	}
+
+	if err := ts.uc.DeleteCard(ts.ctx, deleted.ID); err != nil {
+		log.Fatalf("Failed to execute DeleteCard usecase: %v", err)
+	}
+
+	matches, err := ts.uc.SearchCards(ts.ctx, userID, entity.CardSearch{Query: "release notes"}, 10, 0)
+	assert.Nil(t, err)
+	if assert.Len(t, matches, 2) {
+		// Titles weigh more than descriptions
+		assert.Equal(t, inTitle.ID, matches[0].Card.ID)
+		assert.Equal(t, inDescription.ID, matches[1].Card.ID)
+		assert.Greater(t, matches[0].Rank, matches[1].Rank)
+		assert.Equal(t, boards[0].ID, matches[0].BoardID)
+		assert.Equal(t, "Write <b>release</b> <b>notes</b>", matches[0].TitleHeadline)
+		assert.Contains(t, matches[1].DescriptionHeadline, "<b>release</b>")
+	}
+
+	matches, err = ts.uc.SearchCards(ts.ctx, userID, entity.CardSearch{Query: "release -publish"}, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+
+	matches, err = ts.uc.SearchCards(ts.ctx, userID, entity.CardSearch{Query: "notes", BoardID: boards[1].ID}, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, matches)
+
+	matches, err = ts.uc.SearchCards(ts.ctx, userID, entity.CardSearch{Query: "notes", To: time.Now().AddDate(0, 0, -1)}, 10, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, matches)
+}
+
+// RepositionCard(ctx context.Context, id uuid.UUID, placement entity.Placement) (*entity.Card, error)
+func TestRepositionCardConcurrently(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	// Every card starts at the same position, so the first move has to rebalance
+	cards := make([]entity.Card, 8)
+	for i := range cards {
+		cards[i] = entity.Card{UserID: userID, ColumnID: column.ID, Title: fmt.Sprintf("Card %d", i)}
+		if err := ts.uc.CreateCard(ts.ctx, &cards[i]); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+	}
+
+	anchor := cards[0].ID
+
+	var wg sync.WaitGroup
+	for _, card := range cards[1:] {
+		wg.Add(1)
+		go func(id uuid.UUID) {
+			defer wg.Done()
+			_, err := ts.uc.RepositionCard(ts.ctx, id, entity.Placement{BeforeID: anchor})
+			assert.Nil(t, err)
+		}(card.ID)
+	}
+	wg.Wait()
+
+	positions, err := ts.cardRepo.GetCardPositions(ts.ctx, column.ID)
+	if err != nil {
+		log.Fatalf("Failed to get card positions: %v", err)
+	}
+
+	assert.Len(t, positions, len(cards))
+	assert.Equal(t, anchor, positions[len(positions)-1].ID)
+
+	for i := 1; i < len(positions); i++ {
+		assert.Less(t, positions[i-1].Position, positions[i].Position)
+	}
+}
+
+func TestMoveCardsConcurrently(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	boards := make([]entity.Board, 2)
+	columns := make([]entity.Column, 2)
+	for i := range columns {
+		boards[i] = entity.Board{UserID: userID, Title: fmt.Sprintf("Board %d", i)}
+		if err := ts.uc.CreateBoard(ts.ctx, &boards[i]); err != nil {
+			log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+		}
+
+		columns[i] = entity.Column{UserID: userID, BoardID: boards[i].ID, Title: fmt.Sprintf("Column %d", i)}
+		if err := ts.uc.CreateColumn(ts.ctx, &columns[i]); err != nil {
+			log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+		}
+	}
+
+	// Cards travel in both directions at once, so the column locks must not
+	// deadlock and no two cards may end up at the same position
+	cards := make([]entity.Card, 8)
+	for i := range cards {
+		cards[i] = entity.Card{UserID: userID, ColumnID: columns[i%2].ID, Title: fmt.Sprintf("Card %d", i)}
+		if err := ts.uc.CreateCard(ts.ctx, &cards[i]); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, card := range cards {
+		wg.Add(1)
+		go func(id, target uuid.UUID) {
+			defer wg.Done()
+			moved, err := ts.uc.MoveCard(ts.ctx, id, entity.CardMove{ColumnID: target})
+			if assert.Nil(t, err) {
+				assert.Equal(t, target, moved.ColumnID)
+			}
+		}(card.ID, columns[(i+1)%2].ID)
+	}
+	wg.Wait()
+
+	for _, column := range columns {
+		positions, err := ts.cardRepo.GetCardPositions(ts.ctx, column.ID)
+		if err != nil {
+			log.Fatalf("Failed to get card positions: %v", err)
+		}
+
+		assert.Len(t, positions, len(cards)/2)
+
+		for i := 1; i < len(positions); i++ {
+			assert.Less(t, positions[i-1].Position, positions[i].Position)
+		}
+	}
+}
+
+func TestCardPages(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	// Cards at the same position are told apart by creation time and id
+	for i, position := range []float64{1, 2, 2, 2, 3} {
+		card := entity.Card{UserID: userID, ColumnID: column.ID, Title: fmt.Sprintf("Card %d", i), Position: position}
+		if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+			log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	page := entity.PageRequest{Limit: 2}
+
+	for pages := 1; ; pages++ {
+		cards, next, err := ts.uc.GetCardsPage(ts.ctx, column.ID, entity.CardFilter{}, page)
+		assert.Nil(t, err)
+
+		for _, card := range cards {
+			assert.False(t, seen[card.ID], "card %s listed twice", card.Title)
+			seen[card.ID] = true
+		}
+
+		if pages == 1 {
+			// A card added in front of the listing does not shift later pages
+			card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Front", Position: 0}
+			if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+				log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+			}
+		}
+
+		if next == nil {
+			assert.Equal(t, 3, pages)
+			break
+		}
+
+		page.After = next
+	}
+
+	assert.Len(t, seen, 5)
+}
+
+func TestRunBatch(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	ops := func() []entity.BatchOp {
+		return []entity.BatchOp{
+			{Action: entity.ActivityUpdate, Target: entity.ActivityTargetCard, Card: &entity.Card{ID: card.ID, Title: "Renamed"}},
+			{Action: entity.ActivityUpdate, Target: entity.ActivityTargetColumn, Column: &entity.Column{ID: column.ID, Title: "Stale", Version: 9}},
+			{Action: entity.ActivityCreate, Target: entity.ActivityTargetCard, Card: &entity.Card{UserID: userID, ColumnID: column.ID, Title: "Added"}},
+		}
+	}
+
+	// The failed operation of an atomic batch undoes the ones before it
+	results, err := ts.uc.RunBatch(ts.ctx, entity.BatchAtomic, ops())
+	assert.Nil(t, err)
+	assert.Equal(t, entity.BatchRolledBack, results[0].Status)
+	assert.Equal(t, entity.BatchFailed, results[1].Status)
+	assert.Equal(t, entity.BatchSkipped, results[2].Status)
+
+	current, err := ts.uc.GetCardByID(ts.ctx, card.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Card", current.Title)
+
+	// A best-effort batch keeps what went through around the failure
+	results, err = ts.uc.RunBatch(ts.ctx, entity.BatchBestEffort, ops())
+	assert.Nil(t, err)
+	assert.Equal(t, entity.BatchApplied, results[0].Status)
+	assert.Equal(t, entity.BatchFailed, results[1].Status)
+	assert.ErrorIs(t, results[1].Err, v1.ErrVersionConflict)
+	assert.Equal(t, entity.BatchApplied, results[2].Status)
+
+	current, err = ts.uc.GetCardByID(ts.ctx, card.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed", current.Title)
+	assert.Equal(t, results[0].Version, current.Version)
+
+	cards, err := ts.uc.GetCardsByColumn(ts.ctx, column.ID, entity.CardFilter{}, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, cards, 2)
+
+	currentColumn, err := ts.uc.GetColumnByID(ts.ctx, column.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Column Title", currentColumn.Title)
+}
+
+func TestWIPLimit(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	todo := entity.Column{UserID: userID, BoardID: board.ID, Title: "To do"}
+	if err := ts.uc.CreateColumn(ts.ctx, &todo); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	doing := entity.Column{UserID: userID, BoardID: board.ID, Title: "Doing", WIPLimit: entity.WIPLimit{Max: 1, Strict: true}}
+	if err := ts.uc.CreateColumn(ts.ctx, &doing); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	first := entity.Card{UserID: userID, ColumnID: doing.ID, Title: "First"}
+	if err := ts.uc.CreateCard(ts.ctx, &first); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	waiting := entity.Card{UserID: userID, ColumnID: todo.ID, Title: "Waiting"}
+	if err := ts.uc.CreateCard(ts.ctx, &waiting); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	// A strict limit turns both new and moved cards away
+	err := ts.uc.CreateCard(ts.ctx, &entity.Card{UserID: userID, ColumnID: doing.ID, Title: "Second"})
+	assert.ErrorIs(t, err, v1.ErrWIPLimitReached)
+
+	_, err = ts.uc.MoveCard(ts.ctx, waiting.ID, entity.CardMove{ColumnID: doing.ID})
+	assert.ErrorIs(t, err, v1.ErrWIPLimitReached)
+
+	// A soft one lets them in with a warning
+	column, err := ts.uc.SetColumnWIPLimit(ts.ctx, doing.ID, entity.WIPLimit{Max: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, column.CardCount)
+
+	moved, err := ts.uc.MoveCard(ts.ctx, waiting.ID, entity.CardMove{ColumnID: doing.ID})
+	assert.Nil(t, err)
+	assert.True(t, moved.OverWIPLimit)
+
+	columns, err := ts.uc.GetColumnsByBoard(ts.ctx, board.ID, false, 10, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, columns[0].CardCount)
+	assert.Equal(t, 2, columns[1].CardCount)
+	assert.Equal(t, entity.WIPLimit{Max: 1}, columns[1].WIPLimit)
+}
+
+func TestCardRevisions(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card", Description: "First line\nSecond line"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	card.Description = "First line\nSecond line, edited"
+	if err := ts.uc.UpdateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	// Moving the card within its column is not a revision
+	card.Position = 4096
+	if err := ts.uc.UpdateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute UpdateCard usecase: %v", err)
+	}
+
+	diff, err := ts.uc.DiffCardRevisions(ts.ctx, card.ID, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []entity.DiffLine{
+		{Op: entity.DiffEqual, Text: "First line"},
+		{Op: entity.DiffDelete, Text: "Second line"},
+		{Op: entity.DiffInsert, Text: "Second line, edited"},
+	}, diff.Description)
+
+	reverted, err := ts.uc.RevertCard(ts.ctx, card.ID, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "First line\nSecond line", reverted.Description)
+
+	revisions, err := ts.uc.GetCardRevisions(ts.ctx, card.ID, 10, 0)
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 3)
+	assert.Equal(t, 3, revisions[0].Number)
+	assert.Equal(t, 1, *revisions[0].RevertedFrom)
+	caller, _ := identity.FromContext(ts.ctx)
+	assert.Equal(t, caller.UserID, revisions[2].AuthorID)
+
+	_, err = ts.uc.DiffCardRevisions(ts.ctx, card.ID, 1, 4)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestBoardEvents(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	ts.relay.Tick(ts.ctx, time.Now())
+
+	ctx, cancel := context.WithCancel(ts.ctx)
+	defer cancel()
+
+	events, err := ts.uc.SubscribeBoard(ctx, board.ID, "")
+	assert.Nil(t, err)
+
+	column := entity.Column{UserID: userID, BoardID: board.ID, Title: "Column Title"}
+	if err := ts.uc.CreateColumn(ts.ctx, &column); err != nil {
+		log.Fatalf("Failed to execute CreateColumn usecase: %v", err)
+	}
+
+	// A rolled back batch publishes nothing
+	_, err = ts.uc.RunBatch(ts.ctx, entity.BatchAtomic, []entity.BatchOp{
+		{Action: entity.ActivityCreate, Target: entity.ActivityTargetCard, Card: &entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"}},
+		{Action: entity.ActivityDelete, Target: entity.ActivityTargetColumn, ID: uuid.New()},
+	})
+	assert.Nil(t, err)
+
+	card := entity.Card{UserID: userID, ColumnID: column.ID, Title: "Card"}
+	if err := ts.uc.CreateCard(ts.ctx, &card); err != nil {
+		log.Fatalf("Failed to execute CreateCard usecase: %v", err)
+	}
+
+	assert.Empty(t, events)
+	ts.relay.Tick(ts.ctx, time.Now())
+
+	first := <-events
+	second := <-events
+	assert.Equal(t, column.ID, first.Change.TargetID)
+	assert.Equal(t, card.ID, second.Change.TargetID)
+	assert.Empty(t, events)
+
+	resumed, err := ts.uc.SubscribeBoard(ctx, board.ID, first.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, second.ID, (<-resumed).ID)
+}
+
+// recordingPublisher keeps the changes of the events it is given
+type recordingPublisher struct {
+	mu        sync.Mutex
+	delivered []uuid.UUID
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events []entity.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, event := range events {
+		p.delivered = append(p.delivered, event.Change.ID)
+	}
+
+	return nil
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ts := sqlxSetup()
+	resetDatabase()
+
+	userID := uuid.New()
+
+	board := entity.Board{UserID: userID, Title: "Board Title"}
+	if err := ts.uc.CreateBoard(ts.ctx, &board); err != nil {
+		log.Fatalf("Failed to execute CreateBoard usecase: %v", err)
+	}
+
+	// The event is committed along with the board
+	pending, err := ts.outboxRepo.GetDueOutboxEvents(ts.ctx, time.Now(), 10)
+	assert.Nil(t, err)
+	if assert.Len(t, pending, 1) {
+		assert.Equal(t, "BoardCreated", pending[0].Type)
+		assert.Equal(t, board.ID, pending[0].AggregateID)
+		assert.Equal(t, board.ID, pending[0].Change.TargetID)
+	}
+
+	// A rolled back batch leaves no event behind
+	_, err = ts.uc.RunBatch(ts.ctx, entity.BatchAtomic, []entity.BatchOp{
+		{Action: entity.ActivityCreate, Target: entity.ActivityTargetBoard, Board: &entity.Board{UserID: userID, Title: "Board"}},
+		{Action: entity.ActivityDelete, Target: entity.ActivityTargetColumn, ID: uuid.New()},
+	})
+	assert.Nil(t, err)
+
+	pending, _ = ts.outboxRepo.GetDueOutboxEvents(ts.ctx, time.Now(), 10)
+	assert.Len(t, pending, 1)
+
+	publisher := &recordingPublisher{}
+	relay := outbox.NewRelay(ts.outboxRepo, publisher, ts.transactor, time.Second, 100, time.Second, time.Minute, ts.log)
+
+	// The relay dies after delivering, before its transaction commits
+	ts.transactor.WithinTransaction(ts.ctx, func(ctx context.Context) error {
+		relay.Tick(ctx, time.Now())
+		return fmt.Errorf("crashed")
+	})
+
+	pending, _ = ts.outboxRepo.GetDueOutboxEvents(ts.ctx, time.Now(), 10)
+	assert.Len(t, pending, 1)
+
+	// The next relay delivers the event again
+	relay.Tick(ts.ctx, time.Now())
+
+	assert.Equal(t, []uuid.UUID{pending[0].Change.ID, pending[0].Change.ID}, publisher.delivered)
+
+	pending, _ = ts.outboxRepo.GetDueOutboxEvents(ts.ctx, time.Now(), 10)
+	assert.Empty(t, pending)
 }
@@ -110,6 +110,30 @@ func (r *MongoUserRepository) GetUsersBatch(ctx context.Context, limit, offset i
 	return users, nil
 }
 
+func (r *MongoUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var repoUsers []repository.User
+	for cursor.Next(ctx) {
+		var repoUser repository.User
+		if err := cursor.Decode(&repoUser); err != nil {
+			return nil, err
+		}
+		repoUsers = append(repoUsers, repoUser)
+	}
+
+	users := make([]entity.User, len(repoUsers))
+	for i, u := range repoUsers {
+		users[i] = repository.UserToEntity(u)
+	}
+
+	return users, nil
+}
+
 func (r *MongoUserRepository) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error) {
 	query := bson.M{
 		"created_at": bson.M{
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type SQLXUserRepository struct {
@@ -105,6 +106,27 @@ func (r *SQLXUserRepository) GetUsersBatch(ctx context.Context, limit, offset in
 	return users, nil
 }
 
+func (r *SQLXUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.User, error) {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+
+	var repoUsers []repository.User
+
+	err := r.db.SelectContext(ctx, &repoUsers, "SELECT * FROM users WHERE id = ANY($1)", pq.Array(strIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]entity.User, len(repoUsers))
+	for i, u := range repoUsers {
+		users[i] = repository.UserToEntity(u)
+	}
+
+	return users, nil
+}
+
 func (r *SQLXUserRepository) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error) {
 	var repoUsers []repository.User
 
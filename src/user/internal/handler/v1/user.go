@@ -2,13 +2,16 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 	"user/internal/dto"
 	"user/internal/entity"
 	"user/internal/repository"
 	"user/internal/usecase"
+	v1 "user/internal/usecase/v1"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -145,6 +148,35 @@ func (h *UserHandler) GetUsersBatch(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(userDTOs)
 }
 
+// GetUsersByIDs looks up users by a comma-separated list of ids. Ids that
+// belong to no user are skipped, so the response may be shorter than the list.
+func (h *UserHandler) GetUsersByIDs(w http.ResponseWriter, r *http.Request) {
+	idsParam := r.URL.Query().Get("ids")
+
+	var ids []uuid.UUID
+	for _, idStr := range strings.Split(idsParam, ",") {
+		id, err := uuid.Parse(strings.TrimSpace(idStr))
+		if err != nil {
+			http.Error(w, "Invalid user ID", http.StatusBadRequest)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	users, err := h.userUseCase.GetUsersByIDs(r.Context(), ids)
+	if errors.Is(err, v1.ErrTooManyIDs) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		http.Error(w, "Users not found", http.StatusNotFound)
+		return
+	}
+
+	userDTOs := dto.ToUserDTOs(users)
+
+	json.NewEncoder(w).Encode(userDTOs)
+}
+
 func (h *UserHandler) GetNewUsers(w http.ResponseWriter, r *http.Request) {
 	layout := "02-01-2006" // DD-MM-YYYY
 
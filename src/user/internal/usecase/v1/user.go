@@ -15,8 +15,12 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const maxUsersByIDs = 100
+
 var (
 	ErrInvalidFromTo = errors.New("<<to>> date should be not less than <<from>>")
+	ErrNoUserIDs     = errors.New("at least one user id should be given")
+	ErrTooManyIDs    = fmt.Errorf("cannot look up more than %d users at once", maxUsersByIDs)
 )
 
 type userUseCase struct {
@@ -215,6 +219,36 @@ func (u *userUseCase) GetUsersBatch(ctx context.Context, limit, offset int) ([]e
 	return users, nil
 }
 
+func (u *userUseCase) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.User, error) {
+	header := "GetUsersByIDs: "
+
+	u.log.Info(ctx, header+"Usecase called; Validating ids", "ids", ids)
+
+	if len(ids) == 0 {
+		err := ErrNoUserIDs
+		u.log.Info(ctx, header+"Bad ids", "err", err.Error())
+		return nil, err
+	} else if len(ids) > maxUsersByIDs {
+		err := ErrTooManyIDs
+		u.log.Info(ctx, header+"Bad ids", "err", err.Error())
+		return nil, err
+	}
+
+	u.log.Info(ctx, header+"Successful validation; Making request to repo", "ids", ids)
+
+	users, err := u.repo.GetUsersByIDs(ctx, ids)
+
+	if err != nil {
+		info := "Failed to get users by ids"
+		u.log.Error(ctx, header+info, "err", err.Error())
+		return nil, fmt.Errorf(header+info+": %w", err)
+	}
+
+	u.log.Info(ctx, header+"Got users", "users", users)
+
+	return users, nil
+}
+
 func (u *userUseCase) GetNewUsers(ctx context.Context, from time.Time, to time.Time) ([]entity.User, error) {
 	header := "GetNewUsers: "
 